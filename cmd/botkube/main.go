@@ -24,6 +24,7 @@ import (
 	"k8s.io/utils/strings"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
+	"github.com/kubeshop/botkube/internal/ack"
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/audit"
 	"github.com/kubeshop/botkube/internal/command"
@@ -34,6 +35,7 @@ import (
 	"github.com/kubeshop/botkube/internal/insights"
 	"github.com/kubeshop/botkube/internal/lifecycle"
 	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/mute"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/internal/source"
 	"github.com/kubeshop/botkube/internal/status"
@@ -177,6 +179,12 @@ func run(ctx context.Context) error {
 	}
 	// Create executor factory
 	cfgManager := config.NewManager(remoteCfgEnabled, logger.WithField(componentLogFieldKey, "Config manager"), conf.Settings.PersistentConfig, cfgVersion, k8sCli, gqlClient, deployClient)
+	muteStore := mute.NewStore(storage.NewForMutes(conf.Settings.SystemConfigMap.Namespace, conf.Settings.SystemConfigMap.Name, k8sCli))
+	if err := muteStore.Load(ctx); err != nil {
+		// mutes are optional, so notifications are still sent without them
+		logger.Errorf("while loading muted notifications, starting without them: %s", err.Error())
+	}
+	ackStore := ack.NewStore(ack.DefaultTTL)
 	executorFactory, err := execute.NewExecutorFactory(
 		execute.DefaultExecutorFactoryParams{
 			Log:               logger.WithField(componentLogFieldKey, "Executor"),
@@ -188,6 +196,8 @@ func run(ctx context.Context) error {
 			BotKubeVersion:    botkubeVersion,
 			RestCfg:           kubeConfig,
 			AuditReporter:     auditReporter,
+			MuteStore:         muteStore,
+			AckStore:          ackStore,
 		},
 	)
 
@@ -217,7 +227,7 @@ func run(ctx context.Context) error {
 
 		// Run bots
 		if commGroupCfg.Slack.Enabled {
-			sb, err := bot.NewSlack(commGroupLogger.WithField(botLogFieldKey, "Slack"), commGroupName, commGroupCfg.Slack, executorFactory, reporter)
+			sb, err := bot.NewSlack(commGroupLogger.WithField(botLogFieldKey, "Slack"), commGroupName, commGroupCfg.Slack, executorFactory, reporter, muteStore)
 			if err != nil {
 				return reportFatalError("while creating Slack bot", err)
 			}
@@ -225,7 +235,7 @@ func run(ctx context.Context) error {
 		}
 
 		if commGroupCfg.SocketSlack.Enabled {
-			sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, executorFactory, reporter)
+			sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, executorFactory, reporter, muteStore, ackStore)
 			if err != nil {
 				return reportFatalError("while creating SocketSlack bot", err)
 			}
@@ -233,7 +243,7 @@ func run(ctx context.Context) error {
 		}
 
 		if commGroupCfg.Mattermost.Enabled {
-			mb, err := bot.NewMattermost(commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, executorFactory, reporter)
+			mb, err := bot.NewMattermost(commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, executorFactory, reporter, muteStore)
 			if err != nil {
 				return reportFatalError("while creating Mattermost bot", err)
 			}
@@ -249,7 +259,7 @@ func run(ctx context.Context) error {
 		}
 
 		if commGroupCfg.Discord.Enabled {
-			db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, executorFactory, reporter)
+			db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, executorFactory, reporter, muteStore)
 			if err != nil {
 				return reportFatalError("while creating Discord bot", err)
 			}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/kubeshop/botkube/internal/source/audit"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// version is set via ldflags by GoReleaser.
+var version = "dev"
+
+func main() {
+	source.Serve(map[string]plugin.Plugin{
+		audit.PluginName: &source.Plugin{
+			Source: audit.NewSource(version),
+		},
+	})
+}
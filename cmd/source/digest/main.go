@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/kubeshop/botkube/internal/source/digest"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// version is set via ldflags by GoReleaser.
+var version = "dev"
+
+func main() {
+	source.Serve(map[string]plugin.Plugin{
+		digest.PluginName: &source.Plugin{
+			Source: digest.NewSource(version),
+		},
+	})
+}
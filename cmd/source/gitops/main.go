@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/kubeshop/botkube/internal/source/gitops"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// version is set via ldflags by GoReleaser.
+var version = "dev"
+
+func main() {
+	source.Serve(map[string]plugin.Plugin{
+		gitops.PluginName: &source.Plugin{
+			Source: gitops.NewSource(version),
+		},
+	})
+}
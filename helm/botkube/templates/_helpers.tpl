@@ -58,6 +58,36 @@ Create the name of the service account to use
 {{- end -}}
 {{- end -}}
 
+{{- define "botkube.prometheus.receiver.enabled" -}}
+{{- range $key, $val := .Values.sources -}}
+{{- with (index $val "botkube/prometheus") -}}
+{{- if and .enabled (eq (.config.mode | default "") "receiver") -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "botkube.webhook.source.enabled" -}}
+{{- range $key, $val := .Values.sources -}}
+{{- with (index $val "botkube/webhook") -}}
+{{- if .enabled -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "botkube.audit.webhook.enabled" -}}
+{{- range $key, $val := .Values.sources -}}
+{{- with (index $val "botkube/audit") -}}
+{{- if and .enabled (eq (.config.mode | default "webhook") "webhook") -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "botkube.remoteConfigEnabled" -}}
 {{ if .Values.config.provider.identifier }}
     {{- true -}}
@@ -0,0 +1,61 @@
+package ack
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+const timeFormat = "2006-01-02 15:04:05 MST"
+
+// ButtonsSection returns section with buttons which acknowledge notification for a given object.
+func ButtonsSection(target mute.Target) api.Section {
+	btnBuilder := api.NewMessageButtonBuilder()
+	return api.Section{
+		Buttons: api.Buttons{
+			btnBuilder.ForCommandWithoutDesc("Acknowledge", fmt.Sprintf("ack object %s", target), api.ButtonStylePrimary),
+			btnBuilder.ForCommandWithoutDesc("Assign to me", fmt.Sprintf("assign object %s", target)),
+		},
+	}
+}
+
+// Note returns a human-readable acknowledgement description, such as "Acknowledged by Jane Doe at 2023-03-01 12:00:00 UTC".
+func Note(entry Entry) string {
+	action := "Acknowledged by"
+	if entry.Assigned {
+		action = "Assigned to"
+	}
+	return fmt.Sprintf("%s %s at %s", action, entry.AckedBy, entry.AckedAt.UTC().Format(timeFormat))
+}
+
+// AcknowledgedMessage returns the tracked notification with the acknowledgement note, which replaces the original message.
+// It should be used only if the entry tracks the message which the acknowledgement was requested for.
+func AcknowledgedMessage(entry Entry) interactive.CoreMessage {
+	msg := entry.Message
+	msg.ReplaceOriginal = true
+	msg.Sections = append(cloneSections(msg.Sections), noteSection("✅", Note(entry)))
+	return msg
+}
+
+// RecurringMessage returns notification for an object which was already acknowledged.
+func RecurringMessage(msg interactive.CoreMessage, entry Entry) interactive.CoreMessage {
+	msg.Sections = append(cloneSections(msg.Sections), noteSection("🔁", fmt.Sprintf("Occurred again. %s", Note(entry))))
+	return msg
+}
+
+func noteSection(emoji, text string) api.Section {
+	return api.Section{
+		Context: api.ContextItems{
+			{Text: fmt.Sprintf("%s %s", emoji, text)},
+		},
+	}
+}
+
+// cloneSections ensures the sections of the tracked message are not modified.
+func cloneSections(in []api.Section) []api.Section {
+	out := make([]api.Section, len(in), len(in)+1)
+	copy(out, in)
+	return out
+}
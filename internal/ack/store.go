@@ -0,0 +1,139 @@
+package ack
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// DefaultTTL defines how long notifications are tracked after they were sent or acknowledged.
+const DefaultTTL = 24 * time.Hour
+
+// Entry describes a notification sent to a given channel for a given object.
+type Entry struct {
+	Channel mute.Channel
+	Target  mute.Target
+	// MessageID is a platform-specific ID of the sent message, such as Slack message timestamp.
+	MessageID string
+	// Message is the sent notification, without acknowledgement buttons.
+	Message interactive.CoreMessage
+
+	// AckedBy is empty if the notification wasn't acknowledged yet.
+	AckedBy string
+	AckedAt time.Time
+	// Assigned is true if the user assigned the notification to themselves.
+	Assigned bool
+
+	updatedAt time.Time
+}
+
+// IsAcknowledged returns true if the notification was acknowledged or assigned.
+func (e Entry) IsAcknowledged() bool {
+	return e.AckedBy != ""
+}
+
+type entryKey struct {
+	channel   mute.Channel
+	kind      string
+	namespace string
+	name      string
+}
+
+func keyFor(channel mute.Channel, target mute.Target) entryKey {
+	return entryKey{
+		channel:   channel,
+		kind:      strings.ToLower(target.Kind),
+		namespace: target.Namespace,
+		name:      target.Name,
+	}
+}
+
+// Store keeps track of sent notifications and their acknowledgements in memory.
+// Entries are removed once they are not updated for a given TTL.
+type Store struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[entryKey]Entry
+}
+
+// NewStore returns a new Store instance.
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[entryKey]Entry{},
+	}
+}
+
+// Track records a sent notification. Acknowledged notifications are not overridden.
+func (s *Store) Track(channel mute.Channel, target mute.Target, messageID string, msg interactive.CoreMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneExpired()
+
+	key := keyFor(channel, target)
+	if old, found := s.entries[key]; found && old.IsAcknowledged() {
+		return
+	}
+
+	s.entries[key] = Entry{
+		Channel:   channel,
+		Target:    target,
+		MessageID: messageID,
+		Message:   msg,
+		updatedAt: s.now(),
+	}
+}
+
+// GetAcknowledged returns acknowledged notification for a given object.
+func (s *Store) GetAcknowledged(channel mute.Channel, target mute.Target) (Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[keyFor(channel, target)]
+	if !found || !entry.IsAcknowledged() || s.isExpired(entry) {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// Acknowledge marks notification for a given object as acknowledged by a given user.
+// If the notification isn't tracked, e.g. after restart, a new entry without the message is created.
+func (s *Store) Acknowledge(channel mute.Channel, target mute.Target, user string, assign bool) Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := keyFor(channel, target)
+	entry, found := s.entries[key]
+	if !found || s.isExpired(entry) {
+		entry = Entry{
+			Channel: channel,
+			Target:  target,
+		}
+	}
+
+	entry.AckedBy = user
+	entry.AckedAt = s.now()
+	entry.Assigned = assign
+	entry.updatedAt = entry.AckedAt
+	s.entries[key] = entry
+	return entry
+}
+
+func (s *Store) isExpired(entry Entry) bool {
+	return s.now().Sub(entry.updatedAt) >= s.ttl
+}
+
+func (s *Store) pruneExpired() {
+	for key, entry := range s.entries {
+		if s.isExpired(entry) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package ack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestStore(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := NewStore(time.Hour)
+	store.now = func() time.Time { return now }
+
+	channel := mute.Channel{CommGroup: "default", Platform: config.SocketSlackCommPlatformIntegration, ID: "alerts"}
+	deploy := mute.Target{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	msg := fixMessage("Deployment updated")
+
+	// when
+	store.Track(channel, deploy, "1677672000.000100", msg)
+
+	// then
+	_, found := store.GetAcknowledged(channel, deploy)
+	assert.False(t, found)
+
+	// when
+	entry := store.Acknowledge(channel, mute.Target{Kind: "deployment", Namespace: "default", Name: "nginx"}, "<@U123>", true)
+
+	// then
+	assert.Equal(t, "1677672000.000100", entry.MessageID)
+	assert.Equal(t, msg, entry.Message)
+	assert.Equal(t, "Assigned to <@U123> at 2023-03-01 12:00:00 UTC", Note(entry))
+
+	acked, found := store.GetAcknowledged(channel, deploy)
+	require.True(t, found)
+	assert.Equal(t, "<@U123>", acked.AckedBy)
+
+	// when
+	store.Track(channel, deploy, "1677672060.000200", fixMessage("Deployment updated again"))
+
+	// then
+	acked, found = store.GetAcknowledged(channel, deploy)
+	require.True(t, found)
+	assert.Equal(t, "1677672000.000100", acked.MessageID)
+
+	// when
+	now = now.Add(time.Hour)
+
+	// then
+	_, found = store.GetAcknowledged(channel, deploy)
+	assert.False(t, found)
+}
+
+func TestStore_AcknowledgeNotTracked(t *testing.T) {
+	// given
+	store := NewStore(DefaultTTL)
+	store.now = func() time.Time { return time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC) }
+	channel := mute.Channel{CommGroup: "default", Platform: config.SocketSlackCommPlatformIntegration, ID: "alerts"}
+	node := mute.Target{Kind: "Node", Name: "node-1"}
+
+	// when
+	entry := store.Acknowledge(channel, node, "Jane Doe", false)
+
+	// then
+	assert.Empty(t, entry.MessageID)
+	assert.Empty(t, entry.Message.Sections)
+	assert.Equal(t, "Acknowledged by Jane Doe at 2023-03-01 12:00:00 UTC", Note(entry))
+}
+
+func TestAcknowledgedMessage(t *testing.T) {
+	// given
+	msg := fixMessage("Deployment updated")
+	entry := Entry{
+		Message: msg,
+		AckedBy: "<@U123>",
+		AckedAt: time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	// when
+	out := AcknowledgedMessage(entry)
+	recurring := RecurringMessage(msg, entry)
+
+	// then
+	assert.True(t, out.ReplaceOriginal)
+	require.Len(t, out.Sections, 2)
+	assert.Equal(t, api.ContextItems{{Text: "✅ Acknowledged by <@U123> at 2023-03-01 12:00:00 UTC"}}, out.Sections[1].Context)
+
+	assert.False(t, recurring.ReplaceOriginal)
+	require.Len(t, recurring.Sections, 2)
+	assert.Equal(t, api.ContextItems{{Text: "🔁 Occurred again. Acknowledged by <@U123> at 2023-03-01 12:00:00 UTC"}}, recurring.Sections[1].Context)
+
+	assert.Len(t, msg.Sections, 1, "original message should not be modified")
+}
+
+func fixMessage(title string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.Message{
+			Sections: []api.Section{
+				{Base: api.Base{Header: title}},
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Matcher matches alerts by a given label.
+type Matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// String returns matcher in the Alertmanager filter format, such as `alertname="KubePodCrashLooping"`.
+func (m Matcher) String() string {
+	op := "="
+	switch {
+	case m.IsRegex && m.IsEqual:
+		op = "=~"
+	case m.IsRegex:
+		op = "!~"
+	case !m.IsEqual:
+		op = "!="
+	}
+	return fmt.Sprintf("%s%s%q", m.Name, op, m.Value)
+}
+
+// Silence describes Alertmanager silence.
+type Silence struct {
+	ID        string        `json:"id,omitempty"`
+	Matchers  []Matcher     `json:"matchers"`
+	StartsAt  time.Time     `json:"startsAt"`
+	EndsAt    time.Time     `json:"endsAt"`
+	CreatedBy string        `json:"createdBy"`
+	Comment   string        `json:"comment"`
+	Status    SilenceStatus `json:"status,omitempty"`
+}
+
+// SilenceStatus describes Alertmanager silence status.
+type SilenceStatus struct {
+	State string `json:"state"`
+}
+
+// Alert describes Alertmanager alert.
+type Alert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	Fingerprint  string            `json:"fingerprint"`
+	GeneratorURL string            `json:"generatorURL"`
+	Status       AlertStatus       `json:"status"`
+}
+
+// AlertStatus describes Alertmanager alert status.
+type AlertStatus struct {
+	State       string   `json:"state"`
+	SilencedBy  []string `json:"silencedBy"`
+	InhibitedBy []string `json:"inhibitedBy"`
+}
+
+// Client is a minimal Alertmanager API v2 client.
+type Client struct {
+	baseURL string
+	http    *http.Client
+}
+
+// NewClient returns a new Client instance.
+func NewClient(baseURL string, timeout time.Duration) *Client {
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    &http.Client{Timeout: timeout},
+	}
+}
+
+// CreateSilence creates a given silence and returns its ID.
+func (c *Client) CreateSilence(ctx context.Context, silence Silence) (string, error) {
+	var out struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v2/silences", nil, silence, &out); err != nil {
+		return "", err
+	}
+	return out.SilenceID, nil
+}
+
+// ListSilences returns silences matching all given filters.
+func (c *Client) ListSilences(ctx context.Context, filters []string) ([]Silence, error) {
+	var out []Silence
+	if err := c.do(ctx, http.MethodGet, "/api/v2/silences", url.Values{"filter": filters}, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpireSilence expires a given silence.
+func (c *Client) ExpireSilence(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/silence/%s", url.PathEscape(id)), nil, nil, nil)
+}
+
+// ListAlerts returns alerts matching all given filters.
+func (c *Client) ListAlerts(ctx context.Context, filters []string) ([]Alert, error) {
+	var out []Alert
+	if err := c.do(ctx, http.MethodGet, "/api/v2/alerts", url.Values{"filter": filters}, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		raw, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("while marshaling request body: %w", err)
+		}
+		body = bytes.NewReader(raw)
+	}
+
+	endpoint := c.baseURL + path
+	if encoded := query.Encode(); encoded != "" {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling Alertmanager API: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected Alertmanager API response %s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("while decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package alertmanager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Commands defines all supported Alertmanager plugin commands and their flags.
+type Commands struct {
+	Silence *SilenceCommand `arg:"subcommand:silence"`
+	Alerts  *AlertsCommand  `arg:"subcommand:alerts"`
+}
+
+// SilenceCommand holds silence subcommands.
+type SilenceCommand struct {
+	Create *SilenceCreateCommand `arg:"subcommand:create"`
+	List   *SilenceListCommand   `arg:"subcommand:list"`
+	Expire *SilenceExpireCommand `arg:"subcommand:expire"`
+}
+
+// SilenceCreateCommand holds flags for the `silence create` command.
+type SilenceCreateCommand struct {
+	Matchers []string `arg:"positional"`
+	Duration string   `arg:"--duration,-d"`
+	Comment  string   `arg:"--comment,-c"`
+}
+
+// SilenceListCommand holds flags for the `silence list` command.
+type SilenceListCommand struct {
+	Filter  []string `arg:"--filter,-f,separate"`
+	Expired bool     `arg:"--expired"`
+}
+
+// SilenceExpireCommand holds flags for the `silence expire` command.
+type SilenceExpireCommand struct {
+	ID string `arg:"positional"`
+}
+
+// AlertsCommand holds alerts subcommands.
+type AlertsCommand struct {
+	List *AlertsListCommand `arg:"subcommand:list"`
+}
+
+// AlertsListCommand holds flags for the `alerts list` command.
+type AlertsListCommand struct {
+	Filter []string `arg:"--filter,-f,separate"`
+}
+
+var matcherRegex = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*(.*)$`)
+
+// ParseMatcher parses matcher in the Alertmanager filter format, such as `alertname="KubePodCrashLooping"` or `severity=~"warning|critical"`.
+func ParseMatcher(in string) (Matcher, error) {
+	groups := matcherRegex.FindStringSubmatch(in)
+	if groups == nil {
+		return Matcher{}, fmt.Errorf("invalid matcher %q: expected {label}{=|!=|=~|!~}{value} format", in)
+	}
+
+	value := strings.TrimSpace(groups[3])
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+
+	op := groups[2]
+	return Matcher{
+		Name:    groups[1],
+		Value:   value,
+		IsRegex: op == "=~" || op == "!~",
+		IsEqual: op == "=" || op == "=~",
+	}, nil
+}
+
+// ParseMatchers parses all given matchers.
+func ParseMatchers(in []string) ([]Matcher, error) {
+	var out []Matcher
+	for _, item := range in {
+		m, err := ParseMatcher(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
@@ -0,0 +1,49 @@
+package alertmanager
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds Alertmanager executor configuration.
+type Config struct {
+	// URL is the Alertmanager endpoint without API version and resource.
+	URL string `yaml:"url"`
+	// DefaultSilenceDuration is used when the duration is not specified in the `silence create` command.
+	DefaultSilenceDuration time.Duration `yaml:"defaultSilenceDuration"`
+	// Timeout is the timeout for Alertmanager API calls.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*executor.Config) (Config, error) {
+	defaults := Config{
+		DefaultSilenceDuration: time.Hour,
+		Timeout:                30 * time.Second,
+	}
+
+	var out Config
+	if err := pluginx.MergeExecutorConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	if err := out.Validate(); err != nil {
+		return Config{}, fmt.Errorf("while validating merged configuration: %w", err)
+	}
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("the url property is required")
+	}
+	if c.DefaultSilenceDuration <= 0 {
+		return fmt.Errorf("the default silence duration must be positive, got %s", c.DefaultSilenceDuration)
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/alexflint/go-arg"
+	"github.com/prometheus/common/model"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	// PluginName is the name of the Alertmanager Botkube plugin.
+	PluginName  = "alertmanager"
+	description = "Silence and inspect Prometheus alerts in Alertmanager directly from your favorite communication platform."
+
+	defaultSilenceCreator = "Botkube"
+	defaultSilenceComment = "Created with Botkube"
+	expiredSilenceState   = "expired"
+)
+
+var _ executor.Executor = &Executor{}
+
+// Executor provides functionality for managing Alertmanager silences and alerts.
+type Executor struct {
+	pluginVersion string
+	now           func() time.Time
+}
+
+// NewExecutor returns a new Executor instance.
+func NewExecutor(ver string) *Executor {
+	return &Executor{
+		pluginVersion: ver,
+		now:           time.Now,
+	}
+}
+
+// Metadata returns details about Alertmanager plugin.
+func (e *Executor) Metadata(context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     e.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+// Execute executes a given Alertmanager command.
+//
+// Supported commands:
+// - silence create
+// - silence list
+// - silence expire
+// - alerts list
+func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (executor.ExecuteOutput, error) {
+	cfg, err := MergeConfigs(in.Configs)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+
+	var cmd Commands
+	err = pluginx.ParseCommand(PluginName, in.Command, &cmd)
+	switch err {
+	case nil:
+	case arg.ErrHelp:
+		return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(help(), false)}, nil
+	default:
+		return executor.ExecuteOutput{}, fmt.Errorf("while parsing input command: %w", err)
+	}
+
+	cli := NewClient(cfg.URL, cfg.Timeout)
+	switch {
+	case cmd.Silence != nil && cmd.Silence.Create != nil:
+		return e.createSilence(ctx, cli, cfg, in, cmd.Silence.Create)
+	case cmd.Silence != nil && cmd.Silence.List != nil:
+		return e.listSilences(ctx, cli, cmd.Silence.List)
+	case cmd.Silence != nil && cmd.Silence.Expire != nil:
+		return e.expireSilence(ctx, cli, cmd.Silence.Expire)
+	case cmd.Alerts != nil && cmd.Alerts.List != nil:
+		return e.listAlerts(ctx, cli, cmd.Alerts.List)
+	default:
+		return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(help(), false)}, nil
+	}
+}
+
+// Help returns help message
+func (*Executor) Help(context.Context) (api.Message, error) {
+	return api.NewCodeBlockMessage(help(), true), nil
+}
+
+func (e *Executor) createSilence(ctx context.Context, cli *Client, cfg Config, in executor.ExecuteInput, cmd *SilenceCreateCommand) (executor.ExecuteOutput, error) {
+	if len(cmd.Matchers) == 0 {
+		return executor.ExecuteOutput{}, errors.New("at least one matcher is required, for example: alertmanager silence create alertname=\"KubePodCrashLooping\" --duration 1h")
+	}
+	matchers, err := ParseMatchers(cmd.Matchers)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	duration := cfg.DefaultSilenceDuration
+	if cmd.Duration != "" {
+		parsed, err := model.ParseDuration(cmd.Duration)
+		if err != nil || parsed <= 0 {
+			return executor.ExecuteOutput{}, fmt.Errorf("invalid duration %q: use a duration such as 30m, 4h or 2d", cmd.Duration)
+		}
+		duration = time.Duration(parsed)
+	}
+
+	comment := cmd.Comment
+	if comment == "" {
+		comment = defaultSilenceComment
+	}
+
+	startsAt := e.now().UTC()
+	silence := Silence{
+		Matchers:  matchers,
+		StartsAt:  startsAt,
+		EndsAt:    startsAt.Add(duration),
+		CreatedBy: silenceCreator(in.Context.User),
+		Comment:   comment,
+	}
+	id, err := cli.CreateSilence(ctx, silence)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while creating silence: %w", err)
+	}
+
+	text := fmt.Sprintf("Silence %s for %s created by %s. It expires at %s.", id, formatMatchers(matchers), silence.CreatedBy, silence.EndsAt.Format(time.RFC3339))
+	if !in.Context.IsInteractivitySupported {
+		return executor.ExecuteOutput{Message: api.NewPlaintextMessage(text, false)}, nil
+	}
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	return executor.ExecuteOutput{
+		Message: api.Message{
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Description: text,
+					},
+					Buttons: api.Buttons{
+						btnBuilder.ForCommandWithoutDesc("Expire silence", fmt.Sprintf("%s silence expire %s", PluginName, id), api.ButtonStyleDanger),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (e *Executor) listSilences(ctx context.Context, cli *Client, cmd *SilenceListCommand) (executor.ExecuteOutput, error) {
+	filters, err := normalizeFilters(cmd.Filter)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	silences, err := cli.ListSilences(ctx, filters)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while listing silences: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ID\tSTATE\tMATCHERS\tENDS AT\tCREATED BY\tCOMMENT\n")
+	var count int
+	for _, silence := range silences {
+		if silence.Status.State == expiredSilenceState && !cmd.Expired {
+			continue
+		}
+		count++
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", silence.ID, silence.Status.State, formatMatchers(silence.Matchers), silence.EndsAt.UTC().Format(time.RFC3339), silence.CreatedBy, silence.Comment)
+	}
+	w.Flush()
+
+	if count == 0 {
+		return executor.ExecuteOutput{Message: api.NewPlaintextMessage("No silences found.", false)}, nil
+	}
+	return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(buf.String(), true)}, nil
+}
+
+func (e *Executor) expireSilence(ctx context.Context, cli *Client, cmd *SilenceExpireCommand) (executor.ExecuteOutput, error) {
+	if cmd.ID == "" {
+		return executor.ExecuteOutput{}, errors.New("silence ID is required, for example: alertmanager silence expire {id}")
+	}
+
+	if err := cli.ExpireSilence(ctx, cmd.ID); err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while expiring silence: %w", err)
+	}
+	return executor.ExecuteOutput{Message: api.NewPlaintextMessage(fmt.Sprintf("Silence %s expired.", cmd.ID), false)}, nil
+}
+
+func (e *Executor) listAlerts(ctx context.Context, cli *Client, cmd *AlertsListCommand) (executor.ExecuteOutput, error) {
+	filters, err := normalizeFilters(cmd.Filter)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	alerts, err := cli.ListAlerts(ctx, filters)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while listing alerts: %w", err)
+	}
+	if len(alerts) == 0 {
+		return executor.ExecuteOutput{Message: api.NewPlaintextMessage("No alerts found.", false)}, nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ALERT\tSTATE\tSTARTS AT\tLABELS\n")
+	for _, alert := range alerts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", alert.Labels[model.AlertNameLabel], alert.Status.State, alert.StartsAt.UTC().Format(time.RFC3339), formatLabels(alert.Labels))
+	}
+	w.Flush()
+
+	return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(buf.String(), true)}, nil
+}
+
+func silenceCreator(user executor.UserInput) string {
+	switch {
+	case user.DisplayName != "":
+		return user.DisplayName
+	case user.Mention != "":
+		return user.Mention
+	default:
+		return defaultSilenceCreator
+	}
+}
+
+// normalizeFilters validates given filters and quotes their values, as quotes are stripped while parsing the command.
+func normalizeFilters(in []string) ([]string, error) {
+	matchers, err := ParseMatchers(in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		out = append(out, m.String())
+	}
+	return out, nil
+}
+
+func formatMatchers(matchers []Matcher) string {
+	out := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		out = append(out, m.String())
+	}
+	return strings.Join(out, ",")
+}
+
+func formatLabels(labels map[string]string) string {
+	var out []string
+	for key, val := range labels {
+		if key == model.AlertNameLabel {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s=%s", key, val))
+	}
+	sort.Strings(out)
+	return strings.Join(out, ",")
+}
+
+func help() string {
+	return heredoc.Doc(`
+		Silence and inspect Prometheus alerts in Alertmanager.
+
+		Usage:
+		  alertmanager [command]
+
+		Available Commands:
+		  silence create {matchers...} [--duration 1h] [--comment text]  # Creates a silence for alerts matching all matchers, e.g. alertname="KubePodCrashLooping" namespace="default".
+		  silence list [--filter matcher] [--expired]                    # Lists silences. Expired silences are listed only with the --expired flag.
+		  silence expire {id}                                            # Expires a given silence.
+		  alerts list [--filter matcher]                                 # Lists alerts matching all given filters, e.g. --filter severity=~"warning|critical".
+	`)
+}
+
+// jsonSchema returns JSON schema for the executor.
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"title": "Alertmanager",
+			"type": "object",
+			"description": "%s",
+			"properties": {
+				"url": {
+					"title": "Endpoint",
+					"description": "Alertmanager endpoint without API version and resource.",
+					"type": "string",
+					"format": "uri"
+				},
+				"defaultSilenceDuration": {
+					"title": "Default silence duration",
+					"description": "Duration of silences created without the --duration flag.",
+					"type": "string",
+					"default": "1h"
+				},
+				"timeout": {
+					"title": "Timeout",
+					"description": "Timeout for Alertmanager API calls.",
+					"type": "string",
+					"default": "30s"
+				}
+			},
+			"required": [
+				"url"
+			]
+		}`, description),
+	}
+}
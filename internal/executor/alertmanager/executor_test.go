@@ -0,0 +1,322 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api/executor"
+)
+
+var fixNow = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestExecutor_SilenceCreate(t *testing.T) {
+	tests := []struct {
+		name            string
+		command         string
+		user            executor.UserInput
+		expectedEndsAt  time.Time
+		expectedCreator string
+		expectedComment string
+	}{
+		{
+			name:            "Explicit duration",
+			command:         `alertmanager silence create alertname="KubePodCrashLooping" namespace="default" --duration 4h --comment "Under investigation"`,
+			user:            executor.UserInput{Mention: "<@U123>", DisplayName: "Jane"},
+			expectedEndsAt:  fixNow.Add(4 * time.Hour),
+			expectedCreator: "Jane",
+			expectedComment: "Under investigation",
+		},
+		{
+			name:            "Default duration and comment",
+			command:         `alertmanager silence create alertname="KubePodCrashLooping"`,
+			user:            executor.UserInput{Mention: "<@U123>"},
+			expectedEndsAt:  fixNow.Add(time.Hour),
+			expectedCreator: "<@U123>",
+			expectedComment: defaultSilenceComment,
+		},
+		{
+			name:            "Duration in days",
+			command:         `alertmanager silence create alertname="KubePodCrashLooping" -d 2d`,
+			expectedEndsAt:  fixNow.Add(48 * time.Hour),
+			expectedCreator: defaultSilenceCreator,
+			expectedComment: defaultSilenceComment,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			srv := newFakeAlertmanager(t)
+			exec := fixExecutor()
+
+			// when
+			out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.command,
+				Configs: fixConfigs(srv.URL()),
+				Context: executor.ExecuteInputContext{
+					IsInteractivitySupported: true,
+					User:                     tc.user,
+				},
+			})
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, srv.silences, 1)
+			silence := srv.silences[0]
+			assert.Equal(t, "KubePodCrashLooping", silence.Matchers[0].Value)
+			assert.True(t, silence.Matchers[0].IsEqual)
+			assert.Equal(t, fixNow, silence.StartsAt)
+			assert.Equal(t, tc.expectedEndsAt, silence.EndsAt)
+			assert.Equal(t, tc.expectedCreator, silence.CreatedBy)
+			assert.Equal(t, tc.expectedComment, silence.Comment)
+
+			require.Len(t, out.Message.Sections, 1)
+			require.Len(t, out.Message.Sections[0].Buttons, 1)
+			assert.Contains(t, out.Message.Sections[0].Buttons[0].Command, "alertmanager silence expire silence-1")
+		})
+	}
+}
+
+func TestExecutor_SilenceCreateErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     string
+		expectedErr string
+	}{
+		{
+			name:        "No matchers",
+			command:     "alertmanager silence create --duration 1h",
+			expectedErr: "at least one matcher is required",
+		},
+		{
+			name:        "Invalid matcher",
+			command:     "alertmanager silence create alertname",
+			expectedErr: `invalid matcher "alertname"`,
+		},
+		{
+			name:        "Invalid duration",
+			command:     `alertmanager silence create alertname="Foo" --duration soon`,
+			expectedErr: `invalid duration "soon"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			srv := newFakeAlertmanager(t)
+			exec := fixExecutor()
+
+			// when
+			_, err := exec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.command,
+				Configs: fixConfigs(srv.URL()),
+			})
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+			assert.Empty(t, srv.silences)
+		})
+	}
+}
+
+func TestExecutor_SilenceListAndExpire(t *testing.T) {
+	// given
+	srv := newFakeAlertmanager(t)
+	srv.silences = []Silence{
+		{ID: "active-1", Matchers: []Matcher{{Name: "alertname", Value: "Foo", IsEqual: true}}, CreatedBy: "Jane", Status: SilenceStatus{State: "active"}},
+		{ID: "expired-1", Matchers: []Matcher{{Name: "alertname", Value: "Bar", IsEqual: true}}, CreatedBy: "John", Status: SilenceStatus{State: expiredSilenceState}},
+	}
+	exec := fixExecutor()
+	in := executor.ExecuteInput{Configs: fixConfigs(srv.URL())}
+
+	// when
+	in.Command = "alertmanager silence list"
+	out, err := exec.Execute(context.Background(), in)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, out.Message.BaseBody.CodeBlock, "active-1")
+	assert.NotContains(t, out.Message.BaseBody.CodeBlock, "expired-1")
+
+	// when
+	in.Command = "alertmanager silence list --expired"
+	out, err = exec.Execute(context.Background(), in)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, out.Message.BaseBody.CodeBlock, "active-1")
+	assert.Contains(t, out.Message.BaseBody.CodeBlock, "expired-1")
+
+	// when
+	in.Command = "alertmanager silence expire active-1"
+	out, err = exec.Execute(context.Background(), in)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Silence active-1 expired.", out.Message.BaseBody.Plaintext)
+	assert.Equal(t, expiredSilenceState, srv.silences[0].Status.State)
+
+	// when
+	in.Command = "alertmanager silence expire unknown"
+	_, err = exec.Execute(context.Background(), in)
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404 Not Found")
+}
+
+func TestExecutor_AlertsList(t *testing.T) {
+	// given
+	srv := newFakeAlertmanager(t)
+	srv.alerts = []Alert{
+		{Labels: map[string]string{"alertname": "KubePodCrashLooping", "namespace": "default"}, StartsAt: fixNow, Status: AlertStatus{State: "active"}},
+	}
+	exec := fixExecutor()
+
+	// when
+	out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: `alertmanager alerts list --filter namespace="default" --filter severity=~"warning|critical"`,
+		Configs: fixConfigs(srv.URL()),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{`namespace="default"`, `severity=~"warning|critical"`}, srv.alertFilters)
+	assert.Contains(t, out.Message.BaseBody.CodeBlock, "KubePodCrashLooping")
+	assert.Contains(t, out.Message.BaseBody.CodeBlock, "namespace=default")
+}
+
+func TestParseMatcher(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected Matcher
+	}{
+		{in: `alertname="KubePodCrashLooping"`, expected: Matcher{Name: "alertname", Value: "KubePodCrashLooping", IsEqual: true}},
+		{in: `namespace!=kube-system`, expected: Matcher{Name: "namespace", Value: "kube-system"}},
+		{in: `severity=~"warning|critical"`, expected: Matcher{Name: "severity", Value: "warning|critical", IsRegex: true, IsEqual: true}},
+		{in: `pod!~"nginx-.*"`, expected: Matcher{Name: "pod", Value: "nginx-.*", IsRegex: true}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			// when
+			got, err := ParseMatcher(tc.in)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expected, mustParseMatcher(t, got.String()))
+		})
+	}
+}
+
+func mustParseMatcher(t *testing.T, in string) Matcher {
+	t.Helper()
+	m, err := ParseMatcher(in)
+	require.NoError(t, err)
+	return m
+}
+
+func fixExecutor() *Executor {
+	exec := NewExecutor("v1.0.0")
+	exec.now = func() time.Time {
+		return fixNow
+	}
+	return exec
+}
+
+func fixConfigs(url string) []*executor.Config {
+	return []*executor.Config{
+		{RawYAML: []byte(fmt.Sprintf("url: %s", url))},
+	}
+}
+
+// fakeAlertmanager is a minimal stand-in for the Alertmanager API v2.
+type fakeAlertmanager struct {
+	srv *httptest.Server
+
+	mu           sync.Mutex
+	silences     []Silence
+	alerts       []Alert
+	alertFilters []string
+}
+
+func newFakeAlertmanager(t *testing.T) *fakeAlertmanager {
+	t.Helper()
+
+	fake := &fakeAlertmanager{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/silences", fake.handleSilences)
+	mux.HandleFunc("/api/v2/silence/", fake.handleSilence)
+	mux.HandleFunc("/api/v2/alerts", fake.handleAlerts)
+	fake.srv = httptest.NewServer(mux)
+	t.Cleanup(fake.srv.Close)
+
+	return fake
+}
+
+func (f *fakeAlertmanager) URL() string {
+	return f.srv.URL
+}
+
+func (f *fakeAlertmanager) handleSilences(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPost:
+		var silence Silence
+		if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		silence.ID = fmt.Sprintf("silence-%d", len(f.silences)+1)
+		silence.Status.State = "active"
+		f.silences = append(f.silences, silence)
+		writeJSON(w, map[string]string{"silenceID": silence.ID})
+	case http.MethodGet:
+		writeJSON(w, f.silences)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeAlertmanager) handleSilence(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+	for i := range f.silences {
+		if f.silences[i].ID == id {
+			f.silences[i].Status.State = expiredSilenceState
+			return
+		}
+	}
+	http.Error(w, "silence not found", http.StatusNotFound)
+}
+
+func (f *fakeAlertmanager) handleAlerts(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.alertFilters = r.URL.Query()["filter"]
+	writeJSON(w, f.alerts)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,19 @@
+package promql
+
+// Commands defines all supported PromQL plugin commands and their flags.
+type Commands struct {
+	Query *QueryCommand `arg:"subcommand:query"`
+	Range *RangeCommand `arg:"subcommand:range"`
+}
+
+// QueryCommand holds flags for the `query` command.
+type QueryCommand struct {
+	Query []string `arg:"positional"`
+}
+
+// RangeCommand holds flags for the `range` command.
+type RangeCommand struct {
+	Query []string `arg:"positional"`
+	Since string   `arg:"--since,-s"`
+	Step  string   `arg:"--step"`
+}
@@ -0,0 +1,100 @@
+package promql
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/source/prometheus"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds PromQL executor configuration.
+type Config struct {
+	// URL is the Prometheus endpoint without API version and resource.
+	URL string `yaml:"url"`
+	// Auth contains credentials used for the Prometheus API calls.
+	Auth prometheus.Auth `yaml:"auth"`
+	// TLS contains TLS configuration of the Prometheus client.
+	TLS prometheus.TLS `yaml:"tls"`
+	// Timeout is the timeout for Prometheus API calls.
+	Timeout time.Duration `yaml:"timeout"`
+	// AllowedQueries holds regular expressions of queries that can be executed. Each query must fully match at least one of them.
+	// If not specified, all queries are allowed. Bind executors with different allowlists to restrict queries per channel.
+	AllowedQueries []string `yaml:"allowedQueries"`
+	// MaxRange is the maximum time range of range queries.
+	MaxRange time.Duration `yaml:"maxRange"`
+	// MaxSeries is the maximum number of series rendered in the response.
+	MaxSeries int `yaml:"maxSeries"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*executor.Config) (Config, error) {
+	defaults := Config{
+		Timeout:   30 * time.Second,
+		MaxRange:  24 * time.Hour,
+		MaxSeries: 20,
+	}
+
+	var out Config
+	if err := pluginx.MergeExecutorConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	if err := out.Validate(); err != nil {
+		return Config{}, fmt.Errorf("while validating merged configuration: %w", err)
+	}
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.URL == "" {
+		return errors.New("the url property is required")
+	}
+	if c.Auth.Username != "" && c.Auth.BearerToken != "" {
+		return errors.New("the basic auth and bearer token are mutually exclusive")
+	}
+	if c.MaxRange <= 0 {
+		return fmt.Errorf("the max range must be positive, got %s", c.MaxRange)
+	}
+	if c.MaxSeries <= 0 {
+		return fmt.Errorf("the max series must be positive, got %d", c.MaxSeries)
+	}
+	if _, err := c.allowlist(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsQueryAllowed returns true if a given query fully matches at least one of the allowed query patterns.
+func (c Config) IsQueryAllowed(query string) (bool, error) {
+	allowlist, err := c.allowlist()
+	if err != nil {
+		return false, err
+	}
+	if len(allowlist) == 0 {
+		return true, nil
+	}
+
+	for _, re := range allowlist {
+		if re.MatchString(query) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c Config) allowlist() ([]*regexp.Regexp, error) {
+	var out []*regexp.Regexp
+	for _, pattern := range c.AllowedQueries {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern))
+		if err != nil {
+			return nil, fmt.Errorf("while compiling allowed query %q: %w", pattern, err)
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
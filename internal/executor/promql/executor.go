@@ -0,0 +1,370 @@
+package promql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/alexflint/go-arg"
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/kubeshop/botkube/internal/source/prometheus"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	// PluginName is the name of the PromQL Botkube plugin.
+	PluginName  = "promql"
+	description = "Run PromQL queries against Prometheus and render results as tables and sparklines."
+
+	defaultRangeSince = time.Hour
+	// minRangeStep prevents too many points for short ranges.
+	minRangeStep = time.Second
+)
+
+var _ executor.Executor = &Executor{}
+
+// Executor runs PromQL queries.
+type Executor struct {
+	pluginVersion string
+	now           func() time.Time
+}
+
+// NewExecutor returns a new Executor instance.
+func NewExecutor(ver string) *Executor {
+	return &Executor{
+		pluginVersion: ver,
+		now:           time.Now,
+	}
+}
+
+// Metadata returns details about PromQL plugin.
+func (e *Executor) Metadata(context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     e.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+// Execute executes a given PromQL command.
+//
+// Supported commands:
+// - query
+// - range
+func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (executor.ExecuteOutput, error) {
+	cfg, err := MergeConfigs(in.Configs)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+
+	var cmd Commands
+	err = pluginx.ParseCommand(PluginName, in.Command, &cmd)
+	switch err {
+	case nil:
+	case arg.ErrHelp:
+		return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(help(), false)}, nil
+	default:
+		return executor.ExecuteOutput{}, fmt.Errorf("while parsing input command: %w", err)
+	}
+
+	cli, err := prometheus.NewClient(cfg.URL, cfg.Auth, cfg.TLS)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while creating Prometheus client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	switch {
+	case cmd.Query != nil:
+		return e.query(ctx, cli, cfg, cmd.Query)
+	case cmd.Range != nil:
+		return e.queryRange(ctx, cli, cfg, cmd.Range)
+	default:
+		return executor.ExecuteOutput{Message: api.NewCodeBlockMessage(help(), false)}, nil
+	}
+}
+
+// Help returns help message
+func (*Executor) Help(context.Context) (api.Message, error) {
+	return api.NewCodeBlockMessage(help(), true), nil
+}
+
+func (e *Executor) query(ctx context.Context, cli *prometheus.Client, cfg Config, cmd *QueryCommand) (executor.ExecuteOutput, error) {
+	query, err := allowedQuery(cfg, cmd.Query)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	val, warnings, err := cli.API.Query(ctx, query, e.now())
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while running query: %w", err)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: "Query result",
+		},
+	}
+	var total int
+	switch result := val.(type) {
+	case model.Vector:
+		total = len(result)
+		if total > cfg.MaxSeries {
+			result = result[:cfg.MaxSeries]
+		}
+		section.Body.CodeBlock = vectorTable(result)
+	case *model.Scalar:
+		section.Body.CodeBlock = formatValue(float64(result.Value))
+	case *model.String:
+		section.Body.CodeBlock = result.Value
+	case model.Matrix:
+		// range vector selectors, such as `up[5m]`, return a matrix also for instant queries
+		total = len(result)
+		if total > cfg.MaxSeries {
+			result = result[:cfg.MaxSeries]
+		}
+		section.Body.CodeBlock = matrixTable(result)
+	default:
+		return executor.ExecuteOutput{}, fmt.Errorf("unsupported query result type %q", val.Type())
+	}
+
+	if total == 0 && section.Body.CodeBlock == "" {
+		section.Body.CodeBlock = "No data."
+	}
+	section.Context = resultContext(query, total, cfg.MaxSeries, warnings)
+	return executor.ExecuteOutput{
+		Message: api.Message{
+			Sections: []api.Section{section},
+		},
+	}, nil
+}
+
+func (e *Executor) queryRange(ctx context.Context, cli *prometheus.Client, cfg Config, cmd *RangeCommand) (executor.ExecuteOutput, error) {
+	query, err := allowedQuery(cfg, cmd.Query)
+	if err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	since, err := parseDuration(cmd.Since, defaultRangeSince)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("invalid --since value: %w", err)
+	}
+	if since > cfg.MaxRange {
+		return executor.ExecuteOutput{}, fmt.Errorf("range %s exceeds the maximum allowed range %s", model.Duration(since), model.Duration(cfg.MaxRange))
+	}
+
+	defaultStep := since / sparklineWidth
+	if defaultStep < minRangeStep {
+		defaultStep = minRangeStep
+	}
+	step, err := parseDuration(cmd.Step, defaultStep)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("invalid --step value: %w", err)
+	}
+
+	end := e.now()
+	val, warnings, err := cli.API.QueryRange(ctx, query, promApi.Range{
+		Start: end.Add(-since),
+		End:   end,
+		Step:  step,
+	})
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while running range query: %w", err)
+	}
+
+	matrix, ok := val.(model.Matrix)
+	if !ok {
+		return executor.ExecuteOutput{}, fmt.Errorf("unsupported range query result type %q", val.Type())
+	}
+
+	total := len(matrix)
+	if total > cfg.MaxSeries {
+		matrix = matrix[:cfg.MaxSeries]
+	}
+
+	body := "No data."
+	if total > 0 {
+		body = matrixTable(matrix)
+	}
+	return executor.ExecuteOutput{
+		Message: api.Message{
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Header: fmt.Sprintf("Range query result (last %s)", model.Duration(since)),
+						Body: api.Body{
+							CodeBlock: body,
+						},
+					},
+					Context: resultContext(query, total, cfg.MaxSeries, warnings),
+				},
+			},
+		},
+	}, nil
+}
+
+// allowedQuery returns the query from a given command if it's allowed by the configuration.
+func allowedQuery(cfg Config, args []string) (string, error) {
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		return "", errors.New("query is required, for example: promql query 'sum(rate(http_requests_total[5m])) by (service)'")
+	}
+
+	allowed, err := cfg.IsQueryAllowed(query)
+	if err != nil {
+		return "", err
+	}
+	if !allowed {
+		return "", fmt.Errorf("query %q is not allowed in this channel", query)
+	}
+	return query, nil
+}
+
+func parseDuration(in string, def time.Duration) (time.Duration, error) {
+	if in == "" {
+		return def, nil
+	}
+	parsed, err := model.ParseDuration(in)
+	if err != nil || parsed <= 0 {
+		return 0, fmt.Errorf("use a duration such as 30m, 4h or 2d, got %q", in)
+	}
+	return time.Duration(parsed), nil
+}
+
+func resultContext(query string, total, maxSeries int, warnings promApi.Warnings) api.ContextItems {
+	items := api.ContextItems{
+		{Text: fmt.Sprintf("Query: %s", query)},
+	}
+	if total > maxSeries {
+		items = append(items, api.ContextItem{Text: fmt.Sprintf("Showing %d of %d series.", maxSeries, total)})
+	}
+	for _, warning := range warnings {
+		items = append(items, api.ContextItem{Text: fmt.Sprintf("Warning: %s", warning)})
+	}
+	return items
+}
+
+func help() string {
+	return heredoc.Doc(`
+		Run PromQL queries against Prometheus.
+
+		Usage:
+		  promql [command]
+
+		Available Commands:
+		  query {query}                               # Runs an instant query and renders the result as a table.
+		  range {query} [--since 1h] [--step 2m]      # Runs a range query and renders each series as a sparkline with min, max and last values.
+
+		Example:
+		  promql query 'sum(rate(http_requests_total[5m])) by (service)'
+		  promql range 'sum(rate(http_requests_total[5m])) by (service)' --since 6h
+	`)
+}
+
+// jsonSchema returns JSON schema for the executor.
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"title": "PromQL",
+			"type": "object",
+			"description": "%s",
+			"properties": {
+				"url": {
+					"title": "Endpoint",
+					"description": "Prometheus endpoint without API version and resource.",
+					"type": "string",
+					"format": "uri"
+				},
+				"auth": {
+					"title": "Authentication",
+					"description": "Credentials used for the Prometheus API calls. The basic auth and bearer token are mutually exclusive.",
+					"type": "object",
+					"properties": {
+						"username": {
+							"title": "Username",
+							"description": "Username for the basic auth.",
+							"type": "string"
+						},
+						"password": {
+							"title": "Password",
+							"description": "Password for the basic auth.",
+							"type": "string"
+						},
+						"bearerToken": {
+							"title": "Bearer token",
+							"description": "Token sent in the Authorization header.",
+							"type": "string"
+						}
+					}
+				},
+				"tls": {
+					"title": "TLS",
+					"description": "TLS configuration of the Prometheus client.",
+					"type": "object",
+					"properties": {
+						"caFile": {
+							"title": "CA file",
+							"description": "Path to the CA certificate used to verify the Prometheus server certificate.",
+							"type": "string"
+						},
+						"certFile": {
+							"title": "Certificate file",
+							"description": "Path to the client certificate.",
+							"type": "string"
+						},
+						"keyFile": {
+							"title": "Key file",
+							"description": "Path to the client certificate key.",
+							"type": "string"
+						},
+						"insecureSkipVerify": {
+							"title": "Skip TLS verification",
+							"description": "If set to true, the Prometheus server certificate is not verified.",
+							"type": "boolean",
+							"default": false
+						}
+					}
+				},
+				"timeout": {
+					"title": "Timeout",
+					"description": "Timeout for Prometheus API calls.",
+					"type": "string",
+					"default": "30s"
+				},
+				"allowedQueries": {
+					"title": "Allowed queries",
+					"description": "Regular expressions of queries that can be executed. Each query must fully match at least one of them. If not specified, all queries are allowed.",
+					"type": "array",
+					"items": {
+						"type": "string"
+					}
+				},
+				"maxRange": {
+					"title": "Max range",
+					"description": "Maximum time range of range queries.",
+					"type": "string",
+					"default": "24h"
+				},
+				"maxSeries": {
+					"title": "Max series",
+					"description": "Maximum number of series rendered in the response.",
+					"type": "integer",
+					"default": 20,
+					"minimum": 1
+				}
+			},
+			"required": [
+				"url"
+			]
+		}`, description),
+	}
+}
@@ -0,0 +1,249 @@
+package promql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api/executor"
+)
+
+var fixNow = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestExecutor_Query(t *testing.T) {
+	// given
+	srv := newFakePrometheus(t)
+	srv.response = `{"resultType":"vector","result":[
+		{"metric":{"service":"api"},"value":[1677672000,"12.5"]},
+		{"metric":{"service":"frontend"},"value":[1677672000,"3"]}
+	]}`
+	exec := fixExecutor()
+
+	// when
+	out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: `promql query 'sum(rate(http_requests_total[5m])) by (service)'`,
+		Configs: fixConfigs(srv.URL(), ""),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "sum(rate(http_requests_total[5m])) by (service)", srv.query)
+	assert.Equal(t, "/api/v1/query", srv.path)
+
+	require.Len(t, out.Message.Sections, 1)
+	section := out.Message.Sections[0]
+	assert.Equal(t, heredoc.Doc(`
+		SERVICE   VALUE
+		api       12.5
+		frontend  3
+	`), section.Body.CodeBlock)
+	require.Len(t, section.Context, 1)
+	assert.Equal(t, "Query: sum(rate(http_requests_total[5m])) by (service)", section.Context[0].Text)
+}
+
+func TestExecutor_QueryWithAuth(t *testing.T) {
+	// given
+	srv := newFakePrometheus(t)
+	srv.response = `{"resultType":"vector","result":[]}`
+	exec := fixExecutor()
+
+	// when
+	_, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: `promql query up`,
+		Configs: fixConfigs(srv.URL(), "auth: {bearerToken: secret}"),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer secret", srv.authorization)
+}
+
+func TestExecutor_QueryMaxSeries(t *testing.T) {
+	// given
+	srv := newFakePrometheus(t)
+	srv.response = `{"resultType":"vector","result":[
+		{"metric":{"pod":"a"},"value":[1677672000,"1"]},
+		{"metric":{"pod":"b"},"value":[1677672000,"2"]},
+		{"metric":{"pod":"c"},"value":[1677672000,"3"]}
+	]}`
+	exec := fixExecutor()
+
+	// when
+	out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: `promql query up`,
+		Configs: fixConfigs(srv.URL(), "maxSeries: 2"),
+	})
+
+	// then
+	require.NoError(t, err)
+	section := out.Message.Sections[0]
+	assert.NotContains(t, section.Body.CodeBlock, "c ")
+	require.Len(t, section.Context, 2)
+	assert.Equal(t, "Showing 2 of 3 series.", section.Context[1].Text)
+}
+
+func TestExecutor_Range(t *testing.T) {
+	// given
+	srv := newFakePrometheus(t)
+	srv.response = `{"resultType":"matrix","result":[
+		{"metric":{"__name__":"up","job":"api"},"values":[[1677668400,"0"],[1677670200,"1"],[1677672000,"2"]]}
+	]}`
+	exec := fixExecutor()
+
+	// when
+	out, err := exec.Execute(context.Background(), executor.ExecuteInput{
+		Command: `promql range up --since 2h`,
+		Configs: fixConfigs(srv.URL(), ""),
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/query_range", srv.path)
+	assert.Equal(t, fmt.Sprintf("%d", fixNow.Add(-2*time.Hour).Unix()), srv.form.Get("start"))
+	assert.Equal(t, fmt.Sprintf("%d", fixNow.Unix()), srv.form.Get("end"))
+	assert.Equal(t, "240", srv.form.Get("step"))
+
+	section := out.Message.Sections[0]
+	assert.Equal(t, "Range query result (last 2h)", section.Header)
+	assert.Equal(t, heredoc.Doc(`
+		SERIES         TREND  MIN  MAX  LAST
+		up{job="api"}  ▁▄█    0    2    2
+	`), section.Body.CodeBlock)
+}
+
+func TestExecutor_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     string
+		cfg         string
+		expectedErr string
+	}{
+		{
+			name:        "Missing query",
+			command:     "promql query",
+			expectedErr: "query is required",
+		},
+		{
+			name:        "Query not allowed",
+			command:     `promql query 'count(up)'`,
+			cfg:         "allowedQueries: ['sum\\(rate\\(http_requests_total\\[5m\\]\\)\\) by \\(service\\)', 'up']",
+			expectedErr: `query "count(up)" is not allowed in this channel`,
+		},
+		{
+			name:        "Range exceeds max range",
+			command:     "promql range up --since 2d",
+			expectedErr: "range 2d exceeds the maximum allowed range 1d",
+		},
+		{
+			name:        "Invalid since",
+			command:     "promql range up --since soon",
+			expectedErr: "invalid --since value",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			srv := newFakePrometheus(t)
+			exec := fixExecutor()
+
+			// when
+			_, err := exec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.command,
+				Configs: fixConfigs(srv.URL(), tc.cfg),
+			})
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+			assert.Empty(t, srv.path)
+		})
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		width    int
+		expected string
+	}{
+		{name: "Ascending", values: []float64{0, 1, 2, 3, 4, 5, 6, 7}, width: 10, expected: "▁▂▃▄▅▆▇█"},
+		{name: "Flat", values: []float64{3, 3, 3}, width: 10, expected: "▅▅▅"},
+		{name: "Downsampled", values: []float64{0, 0, 7, 7}, width: 2, expected: "▁█"},
+		{name: "Missing values", values: []float64{0, math.NaN(), 7}, width: 10, expected: "▁ █"},
+		{name: "Infinite values", values: []float64{math.Inf(-1), 0, 7, math.Inf(1)}, width: 10, expected: "▁▁██"},
+		{name: "Only infinite values", values: []float64{math.Inf(1), math.Inf(-1)}, width: 10, expected: "█▁"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, sparkline(tc.values, tc.width))
+		})
+	}
+}
+
+func fixExecutor() *Executor {
+	exec := NewExecutor("v1.0.0")
+	exec.now = func() time.Time {
+		return fixNow
+	}
+	return exec
+}
+
+func fixConfigs(url, extra string) []*executor.Config {
+	return []*executor.Config{
+		{RawYAML: []byte(fmt.Sprintf("url: %s\n%s", url, extra))},
+	}
+}
+
+// fakePrometheus is a minimal stand-in for the Prometheus HTTP API.
+type fakePrometheus struct {
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	response      string
+	path          string
+	query         string
+	form          url.Values
+	authorization string
+}
+
+func newFakePrometheus(t *testing.T) *fakePrometheus {
+	t.Helper()
+
+	fake := &fakePrometheus{}
+	fake.srv = httptest.NewServer(http.HandlerFunc(fake.handle))
+	t.Cleanup(fake.srv.Close)
+
+	return fake
+}
+
+func (f *fakePrometheus) URL() string {
+	return f.srv.URL
+}
+
+func (f *fakePrometheus) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.path = r.URL.Path
+	f.query = r.Form.Get("query")
+	f.form = r.Form
+	f.authorization = r.Header.Get("Authorization")
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"status":"success","data":%s}`, f.response)
+}
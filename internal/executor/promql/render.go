@@ -0,0 +1,166 @@
+package promql
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/prometheus/common/model"
+)
+
+const sparklineWidth = 30
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// vectorTable renders instant vector as a table with a column per label and the sample value in the last column.
+func vectorTable(vector model.Vector) string {
+	labelNames := map[model.LabelName]struct{}{}
+	for _, sample := range vector {
+		for name := range sample.Metric {
+			labelNames[name] = struct{}{}
+		}
+	}
+	var columns []model.LabelName
+	for name := range labelNames {
+		columns = append(columns, name)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		// the metric name goes first, as in the Prometheus UI
+		if columns[i] == model.MetricNameLabel || columns[j] == model.MetricNameLabel {
+			return columns[i] == model.MetricNameLabel
+		}
+		return columns[i] < columns[j]
+	})
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 2, ' ', 0)
+	for _, name := range columns {
+		fmt.Fprintf(w, "%s\t", strings.ToUpper(string(name)))
+	}
+	fmt.Fprintln(w, "VALUE")
+	for _, sample := range vector {
+		for _, name := range columns {
+			fmt.Fprintf(w, "%s\t", sample.Metric[name])
+		}
+		fmt.Fprintln(w, formatValue(float64(sample.Value)))
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// matrixTable renders range vector as a table with a sparkline and the min, max and last values per series.
+func matrixTable(matrix model.Matrix) string {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SERIES\tTREND\tMIN\tMAX\tLAST")
+	for _, stream := range matrix {
+		values := make([]float64, 0, len(stream.Values))
+		for _, pair := range stream.Values {
+			values = append(values, float64(pair.Value))
+		}
+		lo, hi, last := summary(values)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", seriesName(stream.Metric), sparkline(values, sparklineWidth), formatValue(lo), formatValue(hi), formatValue(last))
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// sparkline renders values as a line of Unicode block characters. Values are averaged in buckets if they don't fit in a given width.
+// Missing values are rendered as spaces. Infinite values are rendered as the highest or the lowest tick.
+func sparkline(values []float64, width int) string {
+	values = downsample(values, width)
+	lo, hi, _ := summary(values)
+
+	maxIdx := len(sparklineTicks) - 1
+	var sb strings.Builder
+	for _, val := range values {
+		switch {
+		case math.IsNaN(val):
+			sb.WriteRune(' ')
+		case math.IsInf(val, 1):
+			sb.WriteRune(sparklineTicks[maxIdx])
+		case math.IsInf(val, -1):
+			sb.WriteRune(sparklineTicks[0])
+		case hi == lo:
+			sb.WriteRune(sparklineTicks[len(sparklineTicks)/2])
+		default:
+			idx := int((val - lo) / (hi - lo) * float64(maxIdx))
+			if idx < 0 {
+				idx = 0
+			}
+			if idx > maxIdx {
+				idx = maxIdx
+			}
+			sb.WriteRune(sparklineTicks[idx])
+		}
+	}
+	return sb.String()
+}
+
+func downsample(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+
+	out := make([]float64, 0, width)
+	for i := 0; i < width; i++ {
+		start, end := i*len(values)/width, (i+1)*len(values)/width
+		var (
+			sum   float64
+			count int
+		)
+		for _, val := range values[start:end] {
+			if math.IsNaN(val) {
+				continue
+			}
+			sum += val
+			count++
+		}
+		if count == 0 {
+			out = append(out, math.NaN())
+			continue
+		}
+		out = append(out, sum/float64(count))
+	}
+	return out
+}
+
+// summary returns the min, max and last value, ignoring missing values. Infinite values are ignored for the min and max.
+func summary(values []float64) (lo, hi, last float64) {
+	lo, hi, last = math.NaN(), math.NaN(), math.NaN()
+	for _, val := range values {
+		if math.IsNaN(val) {
+			continue
+		}
+		last = val
+		if math.IsInf(val, 0) {
+			continue
+		}
+		if math.IsNaN(lo) || val < lo {
+			lo = val
+		}
+		if math.IsNaN(hi) || val > hi {
+			hi = val
+		}
+	}
+	return lo, hi, last
+}
+
+// seriesName returns series name in the PromQL format, such as `http_requests_total{service="api"}`.
+func seriesName(metric model.Metric) string {
+	if len(metric) == 0 {
+		return "{}"
+	}
+	return metric.String()
+}
+
+func formatValue(val float64) string {
+	if math.IsNaN(val) {
+		return "-"
+	}
+	return strconv.FormatFloat(val, 'g', 6, 64)
+}
@@ -0,0 +1,206 @@
+package mute
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// Channel identifies a communication platform channel where notifications are muted.
+type Channel struct {
+	CommGroup string                         `json:"commGroup"`
+	Platform  config.CommPlatformIntegration `json:"platform"`
+	ID        string                         `json:"id"`
+}
+
+// Entry describes a single mute.
+type Entry struct {
+	Channel Channel `json:"channel"`
+	Target  Target  `json:"target"`
+	// ExpiresAt is empty for mutes without expiry.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Suppressed is a number of notifications suppressed by the mute.
+	Suppressed int `json:"suppressed"`
+}
+
+// IsExpired returns true if entry expired at a given time.
+func (e Entry) IsExpired(now time.Time) bool {
+	return e.ExpiresAt != nil && !now.Before(*e.ExpiresAt)
+}
+
+// Persister persists mutes.
+type Persister interface {
+	GetMutes(ctx context.Context) ([]Entry, error)
+	SaveMutes(ctx context.Context, entries []Entry) error
+}
+
+// Store holds mutes for all channels. All changes are persisted, apart from the suppressed notifications counter,
+// which is persisted together with the next change.
+type Store struct {
+	persister Persister
+	now       func() time.Time
+
+	// saveMu serializes changes, so they are persisted in order. Entries are persisted without holding mu,
+	// so checking suppressed notifications doesn't wait for the persister.
+	saveMu sync.Mutex
+
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// NewStore returns a new Store instance.
+func NewStore(persister Persister) *Store {
+	return &Store{
+		persister: persister,
+		now:       time.Now,
+	}
+}
+
+// Load loads persisted mutes.
+func (s *Store) Load(ctx context.Context) error {
+	entries, err := s.persister.GetMutes(ctx)
+	if err != nil {
+		return fmt.Errorf("while getting persisted mutes: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = entries
+	return nil
+}
+
+// Mute mutes notifications for a given target in a given channel. Zero duration mutes notifications forever.
+// If the target is already muted, the expiry time is overridden.
+func (s *Store) Mute(ctx context.Context, channel Channel, target Target, duration time.Duration) (Entry, error) {
+	entry := Entry{
+		Channel: channel,
+		Target:  target,
+	}
+	if duration > 0 {
+		expiresAt := s.now().Add(duration)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	_, err := s.update(ctx, func(current []Entry) ([]Entry, bool) {
+		entries := make([]Entry, 0, len(current)+1)
+		for _, item := range current {
+			if item.Channel == channel && item.Target.Equal(target) {
+				entry.Suppressed = item.Suppressed
+				continue
+			}
+			entries = append(entries, item)
+		}
+		return append(entries, entry), true
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+// Unmute removes mute for a given target in a given channel. It returns false if the target wasn't muted.
+func (s *Store) Unmute(ctx context.Context, channel Channel, target Target) (Entry, bool, error) {
+	var removed Entry
+	found, err := s.update(ctx, func(current []Entry) ([]Entry, bool) {
+		entries := make([]Entry, 0, len(current))
+		found := false
+		for _, item := range current {
+			if item.Channel == channel && item.Target.Equal(target) {
+				removed, found = item, true
+				continue
+			}
+			entries = append(entries, item)
+		}
+		return entries, found
+	})
+	if err != nil || !found {
+		return Entry{}, false, err
+	}
+	return removed, true, nil
+}
+
+// List returns active mutes for a given channel.
+func (s *Store) List(channel Channel) []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var out []Entry
+	for _, item := range s.entries {
+		if item.Channel != channel || item.IsExpired(now) {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// ShouldSuppress returns true if notifications for a given target are muted in a given channel.
+// Each suppressed notification is counted.
+func (s *Store) ShouldSuppress(channel Channel, target *Target) bool {
+	if target == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for idx, item := range s.entries {
+		if item.Channel != channel || !item.Target.Equal(*target) || item.IsExpired(now) {
+			continue
+		}
+		s.entries[idx].Suppressed++
+		return true
+	}
+	return false
+}
+
+// PopExpired removes and returns expired mutes for a given communication platform.
+func (s *Store) PopExpired(ctx context.Context, commGroup string, platform config.CommPlatformIntegration) ([]Entry, error) {
+	now := s.now()
+	var expired []Entry
+	_, err := s.update(ctx, func(current []Entry) ([]Entry, bool) {
+		entries := make([]Entry, 0, len(current))
+		expired = nil
+		for _, item := range current {
+			if item.Channel.CommGroup == commGroup && item.Channel.Platform == platform && item.IsExpired(now) {
+				expired = append(expired, item)
+				continue
+			}
+			entries = append(entries, item)
+		}
+		return entries, len(expired) > 0
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expired, nil
+}
+
+// update persists entries changed by a given function and stores them. The function is called again on the current entries
+// after they are persisted, so suppressed notifications counted in the meantime are not lost.
+// It returns false if the function reported no changes.
+func (s *Store) update(ctx context.Context, change func(current []Entry) ([]Entry, bool)) (bool, error) {
+	s.saveMu.Lock()
+	defer s.saveMu.Unlock()
+
+	s.mu.Lock()
+	entries, changed := change(s.entries)
+	s.mu.Unlock()
+	if !changed {
+		return false, nil
+	}
+
+	if err := s.persister.SaveMutes(ctx, entries); err != nil {
+		return false, fmt.Errorf("while persisting mutes: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries, _ = change(s.entries)
+	return true, nil
+}
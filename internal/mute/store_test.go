@@ -0,0 +1,239 @@
+package mute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestStore(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	persister := &fakePersister{}
+	store := NewStore(persister)
+	store.now = func() time.Time { return now }
+
+	channel := Channel{CommGroup: "default", Platform: config.SocketSlackCommPlatformIntegration, ID: "alerts"}
+	otherChannel := Channel{CommGroup: "default", Platform: config.SocketSlackCommPlatformIntegration, ID: "general"}
+	deploy := Target{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	pod := Target{Kind: "Pod", Namespace: "default", Name: "nginx-abc"}
+
+	// when
+	_, err := store.Mute(ctx, channel, deploy, time.Hour)
+	require.NoError(t, err)
+	_, err = store.Mute(ctx, channel, pod, 0)
+	require.NoError(t, err)
+
+	// then
+	assert.True(t, store.ShouldSuppress(channel, &Target{Kind: "deployment", Namespace: "default", Name: "nginx"}))
+	assert.True(t, store.ShouldSuppress(channel, &deploy))
+	assert.False(t, store.ShouldSuppress(otherChannel, &deploy))
+	assert.False(t, store.ShouldSuppress(channel, &Target{Kind: "Deployment", Namespace: "prod", Name: "nginx"}))
+	assert.False(t, store.ShouldSuppress(channel, nil))
+	assert.Len(t, store.List(channel), 2)
+	assert.Empty(t, store.List(otherChannel))
+	assert.Len(t, persister.entries, 2)
+
+	// when
+	now = now.Add(time.Hour)
+	expired, err := store.PopExpired(ctx, "default", config.SocketSlackCommPlatformIntegration)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, deploy, expired[0].Target)
+	assert.Equal(t, 2, expired[0].Suppressed)
+	assert.False(t, store.ShouldSuppress(channel, &deploy))
+	assert.Len(t, persister.entries, 1)
+
+	// when
+	removed, found, err := store.Unmute(ctx, channel, pod)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, pod, removed.Target)
+	assert.Nil(t, removed.ExpiresAt)
+	assert.Empty(t, store.List(channel))
+	assert.Empty(t, persister.entries)
+
+	// when
+	_, found, err = store.Unmute(ctx, channel, pod)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_Load(t *testing.T) {
+	// given
+	channel := Channel{CommGroup: "default", Platform: config.DiscordCommPlatformIntegration, ID: "123"}
+	target := Target{Kind: "Node", Name: "node-1"}
+	store := NewStore(&fakePersister{
+		entries: []Entry{{Channel: channel, Target: target, Suppressed: 3}},
+	})
+
+	// when
+	err := store.Load(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, store.ShouldSuppress(channel, &target))
+	assert.Equal(t, []Entry{{Channel: channel, Target: target, Suppressed: 4}}, store.List(channel))
+}
+
+func TestStore_ShouldSuppressWhilePersisting(t *testing.T) {
+	// given
+	ctx := context.Background()
+	channel := Channel{CommGroup: "default", Platform: config.SocketSlackCommPlatformIntegration, ID: "alerts"}
+	deploy := Target{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	pod := Target{Kind: "Pod", Namespace: "default", Name: "nginx-abc"}
+
+	persister := &blockingPersister{saving: make(chan struct{}), release: make(chan struct{})}
+	store := NewStore(persister)
+	store.entries = []Entry{{Channel: channel, Target: deploy}}
+
+	// when
+	muted := make(chan error)
+	go func() {
+		_, err := store.Mute(ctx, channel, pod, 0)
+		muted <- err
+	}()
+	<-persister.saving
+
+	// then
+	assert.True(t, store.ShouldSuppress(channel, &deploy), "suppression check shouldn't wait for the persister")
+
+	// when
+	close(persister.release)
+
+	// then
+	require.NoError(t, <-muted)
+	assert.Equal(t, []Entry{
+		{Channel: channel, Target: deploy, Suppressed: 1},
+		{Channel: channel, Target: pod},
+	}, store.List(channel))
+}
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    Target
+		expectedErr string
+	}{
+		{
+			name:     "Namespaced object",
+			input:    "deployment/default/nginx",
+			expected: Target{Kind: "deployment", Namespace: "default", Name: "nginx"},
+		},
+		{
+			name:     "Cluster-scoped object",
+			input:    "Node/node-1",
+			expected: Target{Kind: "Node", Name: "node-1"},
+		},
+		{
+			name:        "Missing name",
+			input:       "deployment/default/",
+			expectedErr: `invalid object "deployment/default/": expected {kind}/{namespace}/{name} or {kind}/{name} format`,
+		},
+		{
+			name:        "Only kind",
+			input:       "deployment",
+			expectedErr: `invalid object "deployment": expected {kind}/{namespace}/{name} or {kind}/{name} format`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := ParseTarget(tc.input)
+
+			// then
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, tc.input, actual.String())
+		})
+	}
+}
+
+func TestTargetFromObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected *Target
+	}{
+		{
+			name: "Kubernetes event",
+			input: map[string]any{
+				"kind":      "Deployment",
+				"Name":      "nginx",
+				"Namespace": "default",
+				"Type":      "update",
+			},
+			expected: &Target{Kind: "Deployment", Namespace: "default", Name: "nginx"},
+		},
+		{
+			name:     "Missing name",
+			input:    map[string]any{"kind": "Deployment"},
+			expected: nil,
+		},
+		{
+			name:     "Not an object",
+			input:    "plaintext",
+			expected: nil,
+		},
+		{
+			name:     "Nil",
+			input:    nil,
+			expected: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := TargetFromObject(tc.input)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+type fakePersister struct {
+	entries []Entry
+}
+
+func (f *fakePersister) GetMutes(context.Context) ([]Entry, error) {
+	return f.entries, nil
+}
+
+func (f *fakePersister) SaveMutes(_ context.Context, entries []Entry) error {
+	f.entries = entries
+	return nil
+}
+
+// blockingPersister blocks saving mutes until released.
+type blockingPersister struct {
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingPersister) GetMutes(context.Context) ([]Entry, error) {
+	return nil, nil
+}
+
+func (f *blockingPersister) SaveMutes(context.Context, []Entry) error {
+	close(f.saving)
+	<-f.release
+	return nil
+}
@@ -0,0 +1,80 @@
+package mute
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Target identifies an object which notifications are muted.
+type Target struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// ParseTarget parses target in the "{kind}/{namespace}/{name}" format.
+// For cluster-scoped objects, the "{kind}/{name}" format is used.
+func ParseTarget(in string) (Target, error) {
+	parts := strings.Split(strings.TrimSpace(in), "/")
+	for _, part := range parts {
+		if part == "" {
+			return Target{}, fmt.Errorf("invalid object %q: expected {kind}/{namespace}/{name} or {kind}/{name} format", in)
+		}
+	}
+
+	switch len(parts) {
+	case 2:
+		return Target{Kind: parts[0], Name: parts[1]}, nil
+	case 3:
+		return Target{Kind: parts[0], Namespace: parts[1], Name: parts[2]}, nil
+	default:
+		return Target{}, fmt.Errorf("invalid object %q: expected {kind}/{namespace}/{name} or {kind}/{name} format", in)
+	}
+}
+
+// TargetFromObject returns target for a given source event raw object.
+// It returns nil if the object doesn't describe a single object, e.g. it doesn't have kind or name.
+func TargetFromObject(obj any) *Target {
+	if obj == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+
+	// JSON unmarshaling is case-insensitive, so both `name` and `Name` fields are supported.
+	var out Target
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	if out.Kind == "" || out.Name == "" {
+		return nil
+	}
+	return &out
+}
+
+// String returns target in the format accepted by ParseTarget.
+func (t Target) String() string {
+	if t.Namespace == "" {
+		return fmt.Sprintf("%s/%s", t.Kind, t.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", t.Kind, t.Namespace, t.Name)
+}
+
+// DisplayName returns a human-readable target name, such as "Deployment default/nginx".
+func (t Target) DisplayName() string {
+	if t.Namespace == "" {
+		return fmt.Sprintf("%s %s", t.Kind, t.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", t.Kind, t.Namespace, t.Name)
+}
+
+// Equal returns true if both targets describe the same object. Kind is compared case-insensitively.
+func (t Target) Equal(other Target) bool {
+	return strings.EqualFold(t.Kind, other.Kind) &&
+		t.Namespace == other.Namespace &&
+		t.Name == other.Name
+}
@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Mode defines how the audit events are received.
+type Mode string
+
+const (
+	// WebhookMode exposes an HTTP endpoint which accepts events from the API server audit webhook backend.
+	WebhookMode Mode = "webhook"
+	// FileMode tails the API server audit log file.
+	FileMode Mode = "file"
+)
+
+// Config holds Kubernetes audit log source configuration.
+type Config struct {
+	Mode    Mode          `yaml:"mode"`
+	Webhook WebhookConfig `yaml:"webhook"`
+	File    FileConfig    `yaml:"file"`
+	// Stages contains audit stages for which notifications are sent. By default, only the ResponseComplete stage is used to notify once per request.
+	Stages []string `yaml:"stages"`
+	// Verbs filters events by the request verb, such as `get`, `create` or `delete`.
+	Verbs k8sconfig.RegexConstraints `yaml:"verbs"`
+	// Users filters events by the username of the requester.
+	Users k8sconfig.RegexConstraints `yaml:"users"`
+	// Resources filters events by the resource and subresource, such as `secrets` or `pods/exec`.
+	Resources k8sconfig.RegexConstraints `yaml:"resources"`
+	// Namespaces filters events by the namespace of the involved object. Cluster-scoped objects have an empty namespace.
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// ResponseCodes filters events by the HTTP response code, such as `200` or `403`.
+	ResponseCodes k8sconfig.RegexConstraints `yaml:"responseCodes"`
+	Log           config.Logger              `yaml:"log"`
+}
+
+// WebhookConfig contains configuration of the audit webhook backend endpoint.
+// Requests must be authenticated with either the shared token or a client certificate signed by the configured CA.
+type WebhookConfig struct {
+	Port int    `yaml:"port"`
+	Path string `yaml:"path"`
+	// Token is a shared token that must be sent in the `Authorization: Bearer {token}` header.
+	Token string     `yaml:"token"`
+	TLS   WebhookTLS `yaml:"tls"`
+}
+
+// WebhookTLS contains TLS configuration of the audit webhook backend endpoint.
+type WebhookTLS struct {
+	// CertFile and KeyFile enable HTTPS for the endpoint.
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+	// ClientCAFile enables mutual TLS. The API server must present a client certificate signed by this CA.
+	ClientCAFile string `yaml:"clientCAFile"`
+}
+
+// FileConfig contains configuration of the audit log file tailing.
+type FileConfig struct {
+	Path         string        `yaml:"path"`
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Mode: WebhookMode,
+		Webhook: WebhookConfig{
+			Port: 2117,
+			Path: "/audit",
+		},
+		File: FileConfig{
+			Path:         "/var/log/kubernetes/audit/audit.log",
+			PollInterval: time.Second,
+		},
+		Stages: []string{stageResponseComplete},
+		Verbs: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+			Exclude: []string{"^watch$"},
+		},
+		Users: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+			Exclude: []string{
+				"^system:apiserver$",
+				"^system:kube-.*",
+				"^system:node:.*",
+				"^system:serviceaccount:kube-system:.*",
+			},
+		},
+		Resources: k8sconfig.RegexConstraints{
+			Include: []string{
+				"^pods/exec$",
+				"^pods/attach$",
+				"^pods/portforward$",
+				"^secrets$",
+				"^roles$",
+				"^rolebindings$",
+				"^clusterroles$",
+				"^clusterrolebindings$",
+			},
+		},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		ResponseCodes: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		Log: config.Logger{
+			Level: "info",
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	switch c.Mode {
+	case WebhookMode:
+		if c.Webhook.Port <= 0 {
+			return fmt.Errorf("invalid webhook port %d", c.Webhook.Port)
+		}
+		if !strings.HasPrefix(c.Webhook.Path, "/") {
+			return fmt.Errorf("the webhook path %q must start with a slash", c.Webhook.Path)
+		}
+		if err := c.Webhook.validateAuth(); err != nil {
+			return err
+		}
+	case FileMode:
+		if c.File.Path == "" {
+			return fmt.Errorf("the file path is required in %q mode", c.Mode)
+		}
+		if c.File.PollInterval <= 0 {
+			return fmt.Errorf("the poll interval must be positive, got %s", c.File.PollInterval)
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, allowed values: %q, %q", c.Mode, WebhookMode, FileMode)
+	}
+	return nil
+}
+
+func (c WebhookConfig) validateAuth() error {
+	hasServerCert := c.TLS.CertFile != "" || c.TLS.KeyFile != ""
+	if hasServerCert && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		return errors.New("the webhook TLS requires both certificate and key files")
+	}
+	if c.TLS.ClientCAFile != "" && !hasServerCert {
+		return errors.New("the webhook client CA file requires the TLS certificate and key files")
+	}
+	if c.Token == "" && c.TLS.ClientCAFile == "" {
+		return errors.New("the webhook requires either the token or the TLS client CA file")
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const stageResponseComplete = "ResponseComplete"
+
+// EventList is the payload sent by the API server audit webhook backend.
+// It's a subset of the audit.k8s.io/v1 EventList.
+type EventList struct {
+	Items []Event `json:"items"`
+}
+
+// Event describes a single audit event. It's a subset of the audit.k8s.io/v1 Event.
+// See https://kubernetes.io/docs/reference/config-api/apiserver-audit.v1/#audit-k8s-io-v1-Event.
+type Event struct {
+	Level                    string            `json:"level"`
+	AuditID                  string            `json:"auditID"`
+	Stage                    string            `json:"stage"`
+	RequestURI               string            `json:"requestURI"`
+	Verb                     string            `json:"verb"`
+	User                     UserInfo          `json:"user"`
+	ImpersonatedUser         *UserInfo         `json:"impersonatedUser,omitempty"`
+	SourceIPs                []string          `json:"sourceIPs,omitempty"`
+	UserAgent                string            `json:"userAgent,omitempty"`
+	ObjectRef                *ObjectReference  `json:"objectRef,omitempty"`
+	ResponseStatus           *ResponseStatus   `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp time.Time         `json:"requestReceivedTimestamp"`
+	StageTimestamp           time.Time         `json:"stageTimestamp"`
+	Annotations              map[string]string `json:"annotations,omitempty"`
+}
+
+// UserInfo holds information about the user who made the request.
+type UserInfo struct {
+	Username string   `json:"username"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// ObjectReference describes the object targeted by the request.
+type ObjectReference struct {
+	Resource    string `json:"resource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// ResponseStatus describes the outcome of the request.
+type ResponseStatus struct {
+	Code    int32  `json:"code,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Resource returns the resource with the subresource, such as `pods/exec`.
+func (e Event) Resource() string {
+	if e.ObjectRef == nil {
+		return ""
+	}
+	if e.ObjectRef.Subresource != "" {
+		return fmt.Sprintf("%s/%s", e.ObjectRef.Resource, e.ObjectRef.Subresource)
+	}
+	return e.ObjectRef.Resource
+}
+
+// Namespace returns the namespace of the involved object.
+func (e Event) Namespace() string {
+	if e.ObjectRef == nil {
+		return ""
+	}
+	return e.ObjectRef.Namespace
+}
+
+// ResponseCode returns the HTTP response code, or zero if the response is not known yet.
+func (e Event) ResponseCode() int32 {
+	if e.ResponseStatus == nil {
+		return 0
+	}
+	return e.ResponseStatus.Code
+}
+
+// IsSuccessful returns true if the request succeeded.
+func (e Event) IsSuccessful() bool {
+	code := e.ResponseCode()
+	return code > 0 && code < 400
+}
+
+// filter selects audit events based on the configured constraints.
+type filter struct {
+	cfg Config
+}
+
+// IsAllowed returns true if a given event matches all configured constraints.
+func (f filter) IsAllowed(e Event) (bool, error) {
+	if !f.isStageAllowed(e.Stage) {
+		return false, nil
+	}
+
+	checks := []struct {
+		name  string
+		value string
+		check func(string) (bool, error)
+	}{
+		{name: "verb", value: e.Verb, check: f.cfg.Verbs.IsAllowed},
+		{name: "user", value: e.User.Username, check: f.cfg.Users.IsAllowed},
+		{name: "resource", value: e.Resource(), check: f.cfg.Resources.IsAllowed},
+		{name: "namespace", value: e.Namespace(), check: f.cfg.Namespaces.IsAllowed},
+		{name: "response code", value: strconv.Itoa(int(e.ResponseCode())), check: f.cfg.ResponseCodes.IsAllowed},
+	}
+	for _, c := range checks {
+		allowed, err := c.check(c.value)
+		if err != nil {
+			return false, fmt.Errorf("while checking %s: %w", c.name, err)
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (f filter) isStageAllowed(stage string) bool {
+	if len(f.cfg.Stages) == 0 {
+		return true
+	}
+	for _, allowed := range f.cfg.Stages {
+		if allowed == stage {
+			return true
+		}
+	}
+	return false
+}
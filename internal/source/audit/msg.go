@@ -0,0 +1,108 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// messageForEvent renders a message describing who did what, from which source IP and with which outcome.
+func messageForEvent(e Event, cluster string) api.Message {
+	emoji := "🔐"
+	if !e.IsSuccessful() {
+		emoji = "⛔"
+	}
+
+	user := e.User.Username
+	if e.ImpersonatedUser != nil {
+		user = fmt.Sprintf("%s (as %s)", e.User.Username, e.ImpersonatedUser.Username)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s %s %s %s", emoji, user, e.Verb, objectName(e)),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "User", e.User.Username)
+	if e.ImpersonatedUser != nil {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Impersonated user", e.ImpersonatedUser.Username)
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Verb", e.Verb)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Resource", e.Resource())
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace())
+	if e.ObjectRef != nil {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Name", e.ObjectRef.Name)
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Source IP", strings.Join(e.SourceIPs, ", "))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Outcome", outcome(e))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", cluster)
+
+	if e.ResponseStatus != nil && e.ResponseStatus.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Response",
+			Items: []string{e.ResponseStatus.Message},
+		})
+	}
+
+	var contextItems api.ContextItems
+	if e.UserAgent != "" {
+		contextItems = append(contextItems, api.ContextItem{Text: fmt.Sprintf("User agent: %s", e.UserAgent)})
+	}
+	if e.AuditID != "" {
+		contextItems = append(contextItems, api.ContextItem{Text: fmt.Sprintf("Audit ID: %s", e.AuditID)})
+	}
+	section.Context = contextItems
+
+	timestamp := e.StageTimestamp
+	if timestamp.IsZero() {
+		timestamp = e.RequestReceivedTimestamp
+	}
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: timestamp,
+		Sections:  []api.Section{section},
+	}
+}
+
+// objectName returns the involved object in the `resource/subresource namespace/name` form, or the request URI for non-resource requests.
+func objectName(e Event) string {
+	if e.ObjectRef == nil {
+		return e.RequestURI
+	}
+
+	name := e.ObjectRef.Name
+	if e.ObjectRef.Namespace != "" {
+		name = fmt.Sprintf("%s/%s", e.ObjectRef.Namespace, name)
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", e.Resource(), strings.TrimSuffix(name, "/")))
+}
+
+func outcome(e Event) string {
+	if e.ResponseStatus == nil || e.ResponseStatus.Code == 0 {
+		return ""
+	}
+
+	result := "Allowed"
+	if !e.IsSuccessful() {
+		result = "Failed"
+	}
+	if e.ResponseStatus.Code == 401 || e.ResponseStatus.Code == 403 {
+		result = "Denied"
+	}
+
+	if e.ResponseStatus.Reason != "" {
+		return fmt.Sprintf("%s (%d %s)", result, e.ResponseStatus.Code, e.ResponseStatus.Reason)
+	}
+	return fmt.Sprintf("%s (%d)", result, e.ResponseStatus.Code)
+}
+
+func appendTextFieldIfNotEmpty(fields []api.TextField, key, in string) []api.TextField {
+	if in == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   key,
+		Value: in,
+	})
+}
@@ -0,0 +1,273 @@
+package audit
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	maxWebhookPayloadSize = 10 << 20
+
+	// maxRecentEvents is the number of recently emitted events remembered to skip duplicates.
+	// The API server resends the whole batch if a previous attempt failed after emitting some of its events.
+	maxRecentEvents = 10000
+)
+
+// processor filters audit events and emits notifications for the allowed ones.
+type processor struct {
+	filter  filter
+	cluster string
+	ch      chan<- source.Event
+
+	mu     sync.Mutex
+	recent recentEvents
+}
+
+// Process emits a notification for a given event, if it's allowed by the configured filters.
+// Events which were already emitted are skipped.
+func (p *processor) Process(ctx context.Context, e Event) error {
+	allowed, err := p.filter.IsAllowed(e)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return nil
+	}
+
+	key := e.AuditID + "/" + e.Stage
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.recent.Has(key) {
+		return nil
+	}
+
+	select {
+	case p.ch <- source.Event{
+		Message:   messageForEvent(e, p.cluster),
+		RawObject: e,
+	}:
+		p.recent.Add(key)
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("while sending event: %w", ctx.Err())
+	}
+}
+
+// recentEvents remembers a limited number of event keys. The oldest key is forgotten first.
+type recentEvents struct {
+	keys  map[string]struct{}
+	order []string
+}
+
+// Has returns true if a given key was added recently.
+func (r *recentEvents) Has(key string) bool {
+	_, found := r.keys[key]
+	return found
+}
+
+// Add remembers a given key.
+func (r *recentEvents) Add(key string) {
+	if r.keys == nil {
+		r.keys = map[string]struct{}{}
+	}
+	if len(r.order) >= maxRecentEvents {
+		delete(r.keys, r.order[0])
+		r.order = r.order[1:]
+	}
+	r.keys[key] = struct{}{}
+	r.order = append(r.order, key)
+}
+
+// router dispatches audit webhook requests to all streams subscribed to a given path.
+// The same path can be streamed multiple times, e.g. for interactive and non-interactive platforms.
+type router struct {
+	log logrus.FieldLogger
+	tls WebhookTLS
+
+	mu     sync.RWMutex
+	routes map[string]*route
+}
+
+type route struct {
+	cfg         WebhookConfig
+	subscribers map[*processor]context.Context
+}
+
+func newRouter(log logrus.FieldLogger, tls WebhookTLS) *router {
+	return &router{
+		log:    log,
+		tls:    tls,
+		routes: map[string]*route{},
+	}
+}
+
+// Subscribe registers a given webhook path and processes its events until the context is canceled.
+// It returns an error if the path is already registered with a different configuration.
+func (r *router) Subscribe(ctx context.Context, cfg WebhookConfig, proc *processor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cfg.TLS != r.tls {
+		return fmt.Errorf("the port %d is already used by a webhook with different TLS configuration", cfg.Port)
+	}
+	rt, found := r.routes[cfg.Path]
+	if found && rt.cfg != cfg {
+		return fmt.Errorf("the path %q is already used by a webhook with different credentials", cfg.Path)
+	}
+	if !found {
+		rt = &route{
+			cfg:         cfg,
+			subscribers: map[*processor]context.Context{},
+		}
+		r.routes[cfg.Path] = rt
+	}
+	rt.subscribers[proc] = ctx
+
+	go func() {
+		<-ctx.Done()
+		r.unsubscribe(cfg.Path, proc)
+	}()
+	return nil
+}
+
+func (r *router) unsubscribe(path string, proc *processor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, found := r.routes[path]
+	if !found {
+		return
+	}
+	delete(rt.subscribers, proc)
+	if len(rt.subscribers) == 0 {
+		delete(r.routes, path)
+	}
+}
+
+// ServeHTTP handles requests from the API server audit webhook backend.
+// If sending any event fails, the API server retries the whole batch. Events which were already emitted are skipped then.
+func (r *router) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.mu.RLock()
+	rt, found := r.routes[request.URL.Path]
+	var subscribers map[*processor]context.Context
+	if found {
+		subscribers = make(map[*processor]context.Context, len(rt.subscribers))
+		for proc, subCtx := range rt.subscribers {
+			subscribers[proc] = subCtx
+		}
+	}
+	r.mu.RUnlock()
+	if !found {
+		http.NotFound(writer, request)
+		return
+	}
+
+	if err := authenticate(rt.cfg, request); err != nil {
+		r.log.Debugf("Rejecting request: %s", err.Error())
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload EventList
+	body := http.MaxBytesReader(writer, request.Body, maxWebhookPayloadSize)
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		r.log.Errorf("while decoding audit webhook payload: %s", err.Error())
+		http.Error(writer, fmt.Sprintf("invalid payload: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	r.log.WithField("events", len(payload.Items)).Debug("Received audit events")
+	for proc, subCtx := range subscribers {
+		if err := processAll(request.Context(), subCtx, proc, payload.Items); err != nil {
+			r.log.Errorf("while processing audit events: %s", err.Error())
+			http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+// processAll processes given events until the request is canceled. Events are dropped if the stream was stopped.
+func processAll(reqCtx, subCtx context.Context, proc *processor, events []Event) error {
+	ctx, cancel := context.WithCancel(reqCtx)
+	defer cancel()
+	go func() {
+		select {
+		case <-subCtx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for _, e := range events {
+		if err := proc.Process(ctx, e); err != nil {
+			if subCtx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("while processing audit event %q: %w", e.AuditID, err)
+		}
+	}
+	return nil
+}
+
+// authenticate verifies the shared token, if configured. Client certificates are verified during the TLS handshake.
+func authenticate(cfg WebhookConfig, request *http.Request) error {
+	if cfg.Token == "" {
+		return nil
+	}
+	token := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(cfg.Token)) != 1 {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+// tlsConfig returns the server TLS configuration, or nil if TLS is not configured.
+// If the client CA file is set, the API server must present a client certificate signed by it.
+func tlsConfig(cfg WebhookTLS) (*tls.Config, error) {
+	if cfg.CertFile == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("while loading TLS certificate: %w", err)
+	}
+	out := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	if cfg.ClientCAFile == "" {
+		return out, nil
+	}
+
+	caCert, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("while reading client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificates found in the client CA file %q", cfg.ClientCAFile)
+	}
+	out.ClientCAs = pool
+	out.ClientAuth = tls.RequireAndVerifyClientCert
+	return out, nil
+}
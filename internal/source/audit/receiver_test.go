@@ -0,0 +1,358 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	// given
+	cfg := fixConfig(t)
+	ch := make(chan source.Event, 10)
+	router := fixRouter(t, &processor{filter: filter{cfg: cfg}, cluster: "prod", ch: ch})
+	req := fixWebhookRequest(fixEventList)
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, req)
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 2)
+
+	event := <-ch
+	assert.Equal(t, "1", event.RawObject.(Event).AuditID)
+	section := event.Message.Sections[0]
+	assert.Equal(t, "🔐 jane create pods/exec default/nginx", section.Header)
+	assert.Equal(t, api.TextFields{
+		{Key: "User", Value: "jane"},
+		{Key: "Verb", Value: "create"},
+		{Key: "Resource", Value: "pods/exec"},
+		{Key: "Namespace", Value: "default"},
+		{Key: "Name", Value: "nginx"},
+		{Key: "Source IP", Value: "10.0.0.1"},
+		{Key: "Outcome", Value: "Allowed (101)"},
+		{Key: "Cluster", Value: "prod"},
+	}, section.TextFields)
+	assert.Equal(t, api.ContextItems{
+		{Text: "User agent: kubectl/v1.25.4"},
+		{Text: "Audit ID: 1"},
+	}, section.Context)
+
+	event = <-ch
+	section = event.Message.Sections[0]
+	assert.Equal(t, "⛔ john (as admin) get secrets kube-public/db-creds", section.Header)
+	assert.Contains(t, section.TextFields, api.TextField{Key: "Outcome", Value: "Denied (403 Forbidden)"})
+	require.Len(t, section.BulletLists, 1)
+	assert.Equal(t, []string{`secrets "db-creds" is forbidden`}, section.BulletLists[0].Items)
+}
+
+func TestWebhookHandler_InvalidPayload(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	router := fixRouter(t, &processor{filter: filter{cfg: fixConfig(t)}, ch: ch})
+	req := fixWebhookRequest("{")
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, ch)
+}
+
+func TestWebhookHandler_InvalidToken(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 10)
+	router := fixRouter(t, &processor{filter: filter{cfg: fixConfig(t)}, ch: ch})
+	req := httptest.NewRequest(http.MethodPost, "/audit", strings.NewReader(fixEventList))
+	req.Header.Set("Authorization", "Bearer invalid")
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, ch)
+}
+
+func TestWebhookHandler_RetriedBatch(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	router := fixRouter(t, &processor{filter: filter{cfg: fixConfig(t)}, ch: ch})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, fixWebhookRequest(fixEventList).WithContext(ctx))
+
+	// then
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Len(t, ch, 1)
+	assert.Equal(t, "1", (<-ch).RawObject.(Event).AuditID)
+
+	// when
+	retryRec := httptest.NewRecorder()
+	router.ServeHTTP(retryRec, fixWebhookRequest(fixEventList))
+
+	// then
+	require.Equal(t, http.StatusOK, retryRec.Code)
+	require.Len(t, ch, 1)
+	assert.Equal(t, "2", (<-ch).RawObject.(Event).AuditID)
+}
+
+func TestRouter_SubscribeWithDifferentCredentials(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := newRouter(loggerx.NewNoop(), WebhookTLS{})
+	require.NoError(t, r.Subscribe(ctx, fixWebhookConfig(), &processor{}))
+
+	otherCfg := fixWebhookConfig()
+	otherCfg.Token = "other"
+
+	// when
+	err := r.Subscribe(ctx, otherCfg, &processor{})
+
+	// then
+	assert.EqualError(t, err, `the path "/audit" is already used by a webhook with different credentials`)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		webhook     WebhookConfig
+		expectedErr string
+	}{
+		{
+			name:        "Missing credentials",
+			webhook:     WebhookConfig{Port: 2117, Path: "/audit"},
+			expectedErr: "the webhook requires either the token or the TLS client CA file",
+		},
+		{
+			name:        "Client CA without server certificate",
+			webhook:     WebhookConfig{Port: 2117, Path: "/audit", TLS: WebhookTLS{ClientCAFile: "/etc/ca.crt"}},
+			expectedErr: "the webhook client CA file requires the TLS certificate and key files",
+		},
+		{
+			name:        "Certificate without key",
+			webhook:     WebhookConfig{Port: 2117, Path: "/audit", Token: "secret", TLS: WebhookTLS{CertFile: "/etc/tls.crt"}},
+			expectedErr: "the webhook TLS requires both certificate and key files",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg := fixConfig(t)
+			cfg.Webhook = tc.webhook
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestFilter_IsAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(cfg *Config)
+		event    Event
+		expected bool
+	}{
+		{
+			name:     "Exec into Pod",
+			event:    fixEvent("create", "jane", "pods", "exec", "default", 101),
+			expected: true,
+		},
+		{
+			name:     "Not sensitive resource",
+			event:    fixEvent("get", "jane", "configmaps", "", "default", 200),
+			expected: false,
+		},
+		{
+			name:     "Watch is excluded by default",
+			event:    fixEvent("watch", "jane", "secrets", "", "default", 200),
+			expected: false,
+		},
+		{
+			name:     "System components are excluded by default",
+			event:    fixEvent("get", "system:kube-controller-manager", "secrets", "", "kube-system", 200),
+			expected: false,
+		},
+		{
+			name: "Not complete stage",
+			event: func() Event {
+				e := fixEvent("get", "jane", "secrets", "", "default", 200)
+				e.Stage = "RequestReceived"
+				return e
+			}(),
+			expected: false,
+		},
+		{
+			name: "Only denied requests",
+			mutate: func(cfg *Config) {
+				cfg.ResponseCodes.Include = []string{"^40[13]$"}
+			},
+			event:    fixEvent("get", "jane", "secrets", "", "default", 200),
+			expected: false,
+		},
+		{
+			name: "Excluded namespace",
+			mutate: func(cfg *Config) {
+				cfg.Namespaces.Exclude = []string{"^dev-.*"}
+			},
+			event:    fixEvent("delete", "jane", "rolebindings", "", "dev-jane", 200),
+			expected: false,
+		},
+		{
+			name: "Cluster-scoped resource",
+			mutate: func(cfg *Config) {
+				cfg.Namespaces.Exclude = []string{"^dev-.*"}
+			},
+			event:    fixEvent("delete", "jane", "clusterrolebindings", "", "", 200),
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg := fixConfig(t)
+			if tc.mutate != nil {
+				tc.mutate(&cfg)
+			}
+
+			// when
+			allowed, err := filter{cfg: cfg}.IsAllowed(tc.event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+}
+
+func TestProcessor_ContextCanceled(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	proc := &processor{filter: filter{cfg: fixConfig(t)}, ch: make(chan source.Event)}
+
+	// when
+	err := proc.Process(ctx, fixEvent("create", "jane", "pods", "exec", "default", 101))
+
+	// then
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func fixWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		Port:  2117,
+		Path:  "/audit",
+		Token: "secret",
+	}
+}
+
+func fixRouter(t *testing.T, proc *processor) *router {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	r := newRouter(loggerx.NewNoop(), WebhookTLS{})
+	require.NoError(t, r.Subscribe(ctx, fixWebhookConfig(), proc))
+	return r
+}
+
+func fixWebhookRequest(payload string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/audit", strings.NewReader(payload))
+	req.Header.Set("Authorization", "Bearer secret")
+	return req
+}
+
+func fixConfig(t *testing.T) Config {
+	t.Helper()
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	return cfg
+}
+
+func fixEvent(verb, user, resource, subresource, namespace string, code int32) Event {
+	return Event{
+		AuditID: "1",
+		Stage:   stageResponseComplete,
+		Verb:    verb,
+		User:    UserInfo{Username: user},
+		ObjectRef: &ObjectReference{
+			Resource:    resource,
+			Subresource: subresource,
+			Namespace:   namespace,
+			Name:        "test",
+		},
+		ResponseStatus: &ResponseStatus{Code: code},
+	}
+}
+
+const fixEventList = `{
+  "kind": "EventList",
+  "apiVersion": "audit.k8s.io/v1",
+  "items": [
+    {
+      "level": "Metadata",
+      "auditID": "1",
+      "stage": "ResponseComplete",
+      "requestURI": "/api/v1/namespaces/default/pods/nginx/exec?command=sh",
+      "verb": "create",
+      "user": {"username": "jane", "groups": ["developers"]},
+      "sourceIPs": ["10.0.0.1"],
+      "userAgent": "kubectl/v1.25.4",
+      "objectRef": {"resource": "pods", "namespace": "default", "name": "nginx", "apiVersion": "v1", "subresource": "exec"},
+      "responseStatus": {"metadata": {}, "code": 101},
+      "requestReceivedTimestamp": "2023-03-01T12:00:00.000000Z",
+      "stageTimestamp": "2023-03-01T12:00:05.000000Z"
+    },
+    {
+      "level": "Metadata",
+      "auditID": "2",
+      "stage": "ResponseComplete",
+      "verb": "get",
+      "user": {"username": "john"},
+      "impersonatedUser": {"username": "admin"},
+      "sourceIPs": ["10.0.0.2"],
+      "objectRef": {"resource": "secrets", "namespace": "kube-public", "name": "db-creds", "apiVersion": "v1"},
+      "responseStatus": {"metadata": {}, "status": "Failure", "reason": "Forbidden", "message": "secrets \"db-creds\" is forbidden", "code": 403},
+      "requestReceivedTimestamp": "2023-03-01T12:01:00.000000Z",
+      "stageTimestamp": "2023-03-01T12:01:00.000000Z"
+    },
+    {
+      "level": "Metadata",
+      "auditID": "3",
+      "stage": "ResponseComplete",
+      "verb": "list",
+      "user": {"username": "jane"},
+      "objectRef": {"resource": "configmaps", "namespace": "default", "apiVersion": "v1"},
+      "responseStatus": {"metadata": {}, "code": 200},
+      "requestReceivedTimestamp": "2023-03-01T12:02:00.000000Z",
+      "stageTimestamp": "2023-03-01T12:02:00.000000Z"
+    }
+  ]
+}`
@@ -0,0 +1,335 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/httpsrv"
+)
+
+const (
+	// PluginName is the name of the Kubernetes audit log Botkube plugin.
+	PluginName = "audit"
+
+	description = "Get notifications about sensitive Kubernetes API activity, such as exec into Pods, Secret reads and RBAC changes, based on the API server audit events."
+)
+
+// Source Kubernetes audit log source plugin data structure
+type Source struct {
+	pluginVersion string
+
+	// routers holds webhook routers indexed by port. All streams share the HTTP servers, as they run in the same plugin process.
+	mu      sync.Mutex
+	routers map[int]*router
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+		routers:       map[int]*router{},
+	}
+}
+
+// Stream streams Kubernetes audit events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	proc := &processor{
+		filter:  filter{cfg: cfg},
+		cluster: input.Context.ClusterName,
+		ch:      out.Event,
+	}
+
+	if cfg.Mode == FileMode {
+		go s.tailFile(ctx, log, cfg.File, proc)
+		return out, nil
+	}
+
+	r, err := s.routerForPort(log, cfg.Webhook)
+	if err != nil {
+		return source.StreamOutput{}, err
+	}
+	if err := r.Subscribe(ctx, cfg.Webhook, proc); err != nil {
+		return source.StreamOutput{}, err
+	}
+	log.Infof("Listening for audit events on %q", cfg.Webhook.Path)
+	return out, nil
+}
+
+// Metadata returns metadata of Kubernetes audit log configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+// routerForPort returns a router for the port of a given webhook. The HTTP server is started once per port and runs until the plugin process exits.
+func (s *Source) routerForPort(log logrus.FieldLogger, cfg WebhookConfig) (*router, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, found := s.routers[cfg.Port]; found {
+		return r, nil
+	}
+
+	tlsCfg, err := tlsConfig(cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("while loading webhook TLS configuration: %w", err)
+	}
+
+	r := newRouter(log.WithField("component", "Audit webhook router"), cfg.TLS)
+	s.routers[cfg.Port] = r
+
+	srvLog := log.WithField("component", "Audit webhook receiver")
+	addr := fmt.Sprintf(":%d", cfg.Port)
+	srv := httpsrv.New(srvLog, addr, r)
+	if tlsCfg != nil {
+		srv = httpsrv.NewTLS(srvLog, addr, r, tlsCfg)
+	}
+	go func() {
+		exitOnError(srv.Serve(context.Background()), log)
+	}()
+	return r, nil
+}
+
+func (s *Source) tailFile(ctx context.Context, log logrus.FieldLogger, cfg FileConfig, proc *processor) {
+	t := &tailer{
+		log:          log.WithField("component", "Audit log tailer"),
+		path:         cfg.Path,
+		pollInterval: cfg.PollInterval,
+	}
+	err := t.Tail(ctx, func(line []byte) {
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			log.Errorf("while decoding audit log line: %s", err.Error())
+			return
+		}
+		if err := proc.Process(ctx, e); err != nil {
+			log.Errorf("while processing audit event %q: %s", e.AuditID, err.Error())
+		}
+	})
+	exitOnError(err, log)
+}
+
+func exitOnError(err error, log logrus.FieldLogger) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Kubernetes audit log",
+		  "description": "%s",
+		  "type": "object",
+		  "definitions": {
+			"regexConstraints": {
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed values. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Values to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			}
+		  },
+		  "properties": {
+			"mode": {
+			  "title": "Mode",
+			  "description": "Defines how the audit events are received.",
+			  "type": "string",
+			  "default": "webhook",
+			  "oneOf": [
+				{
+				  "const": "webhook",
+				  "title": "API server audit webhook backend"
+				},
+				{
+				  "const": "file",
+				  "title": "Tail audit log file"
+				}
+			  ]
+			},
+			"webhook": {
+			  "title": "Webhook",
+			  "description": "Configuration of the audit webhook backend endpoint. Used only in the webhook mode.",
+			  "type": "object",
+			  "properties": {
+				"port": {
+				  "title": "Port",
+				  "type": "integer",
+				  "default": 2117
+				},
+				"path": {
+				  "title": "Path",
+				  "type": "string",
+				  "default": "/audit"
+				},
+				"token": {
+				  "title": "Token",
+				  "description": "Shared token which the API server must send in the Authorization header. Either the token or the client CA file is required.",
+				  "type": "string"
+				},
+				"tls": {
+				  "title": "TLS",
+				  "description": "TLS configuration of the endpoint.",
+				  "type": "object",
+				  "properties": {
+					"certFile": {
+					  "title": "Certificate file",
+					  "description": "Path to the server certificate.",
+					  "type": "string"
+					},
+					"keyFile": {
+					  "title": "Key file",
+					  "description": "Path to the server certificate key.",
+					  "type": "string"
+					},
+					"clientCAFile": {
+					  "title": "Client CA file",
+					  "description": "Path to the CA certificate used to verify the API server client certificate.",
+					  "type": "string"
+					}
+				  }
+				}
+			  }
+			},
+			"file": {
+			  "title": "File",
+			  "description": "Configuration of the audit log file tailing. Used only in the file mode.",
+			  "type": "object",
+			  "properties": {
+				"path": {
+				  "title": "Path",
+				  "type": "string",
+				  "default": "/var/log/kubernetes/audit/audit.log"
+				},
+				"pollInterval": {
+				  "title": "Poll interval",
+				  "description": "Interval between checks for new lines in the file.",
+				  "type": "string",
+				  "default": "1s"
+				}
+			  }
+			},
+			"stages": {
+			  "title": "Stages",
+			  "description": "Audit stages for which notifications are sent.",
+			  "type": "array",
+			  "default": ["ResponseComplete"],
+			  "items": {
+				"type": "string",
+				"enum": ["RequestReceived", "ResponseStarted", "ResponseComplete", "Panic"]
+			  }
+			},
+			"verbs": {
+			  "title": "Verbs",
+			  "description": "Filters events by the request verb, such as get, create or delete.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"users": {
+			  "title": "Users",
+			  "description": "Filters events by the username of the requester.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"resources": {
+			  "title": "Resources",
+			  "description": "Filters events by the resource and subresource, such as secrets or pods/exec.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Filters events by the namespace of the involved object. Cluster-scoped objects have an empty namespace.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"responseCodes": {
+			  "title": "Response codes",
+			  "description": "Filters events by the HTTP response code, such as 200 or 403.",
+			  "$ref": "#/definitions/regexConstraints"
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// tailer follows a file and handles every new line. It starts at the end of the file and reopens the file when it's rotated or truncated.
+type tailer struct {
+	log          logrus.FieldLogger
+	path         string
+	pollInterval time.Duration
+}
+
+// Tail blocks until the context is canceled or the file cannot be read.
+func (t *tailer) Tail(ctx context.Context, handle func(line []byte)) error {
+	file, err := t.open(io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	var partial []byte
+	for {
+		line, err := reader.ReadBytes('\n')
+		partial = append(partial, line...)
+		if err == nil {
+			if trimmed := bytes.TrimSpace(partial); len(trimmed) > 0 {
+				handle(trimmed)
+			}
+			partial = nil
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			return fmt.Errorf("while reading %q: %w", t.path, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(t.pollInterval):
+		}
+
+		rotated, err := t.isRotated(file)
+		if err != nil {
+			t.log.Debugf("while checking if %q was rotated: %s", t.path, err.Error())
+			continue
+		}
+		if !rotated {
+			continue
+		}
+
+		t.log.Infof("File %q was rotated, reopening...", t.path)
+		newFile, err := t.open(io.SeekStart)
+		if err != nil {
+			t.log.Warnf("while reopening rotated file: %s", err.Error())
+			continue
+		}
+		file.Close()
+		file = newFile
+		reader.Reset(file)
+		partial = nil
+	}
+}
+
+func (t *tailer) open(whence int) (*os.File, error) {
+	// #nosec G304
+	file, err := os.Open(t.path)
+	if err != nil {
+		return nil, fmt.Errorf("while opening %q: %w", t.path, err)
+	}
+	if _, err := file.Seek(0, whence); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("while seeking %q: %w", t.path, err)
+	}
+	return file, nil
+}
+
+// isRotated returns true if the path points to a different file than the opened one, or the file was truncated.
+func (t *tailer) isRotated(file *os.File) (bool, error) {
+	opened, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	latest, err := os.Stat(t.path)
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(opened, latest) {
+		return true, nil
+	}
+
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	return latest.Size() < offset, nil
+}
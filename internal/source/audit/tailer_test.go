@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestTailer(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("old-1\nold-2\n"), 0o600))
+
+	var (
+		mu    sync.Mutex
+		lines []string
+	)
+	got := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), lines...)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	tr := &tailer{log: loggerx.NewNoop(), path: path, pollInterval: 10 * time.Millisecond}
+	go func() {
+		done <- tr.Tail(ctx, func(line []byte) {
+			mu.Lock()
+			defer mu.Unlock()
+			lines = append(lines, string(line))
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// when
+	appendToFile(t, path, "new-1\nnew-")
+	time.Sleep(50 * time.Millisecond)
+	appendToFile(t, path, "2\n")
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"new-1", "new-2"}, got())
+	}, time.Second, 10*time.Millisecond)
+
+	// when
+	require.NoError(t, os.Rename(path, path+".1"))
+	require.NoError(t, os.WriteFile(path, []byte("rotated-1\n"), 0o600))
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"new-1", "new-2", "rotated-1"}, got())
+	}, time.Second, 10*time.Millisecond)
+
+	// when
+	cancel()
+
+	// then
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("tailer didn't stop after context cancellation")
+	}
+}
+
+func appendToFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.WriteString(content)
+	require.NoError(t, err)
+}
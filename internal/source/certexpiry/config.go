@@ -0,0 +1,72 @@
+package certexpiry
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds certificate expiry source configuration.
+type Config struct {
+	Log config.Logger `yaml:"log"`
+	// Interval defines how often TLS Secrets and cert-manager Certificates are scanned.
+	Interval time.Duration `yaml:"interval"`
+	// ThresholdDays defines days before expiry on which a warning is sent.
+	// Each threshold is reported only once per certificate. The reported thresholds are kept in memory,
+	// so the last one is reported again after a restart.
+	ThresholdDays []int                      `yaml:"thresholdDays"`
+	Namespaces    k8sconfig.RegexConstraints `yaml:"namespaces"`
+	CertManager   CertManagerConfig          `yaml:"certManager"`
+}
+
+// CertManagerConfig contains configuration for cert-manager Certificate checks.
+type CertManagerConfig struct {
+	// Enabled reports cert-manager Certificates with the Ready=False condition.
+	// It's skipped if the cert-manager CRDs are not installed.
+	Enabled bool `yaml:"enabled"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Interval:      time.Hour,
+		ThresholdDays: []int{30, 7, 1},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		CertManager: CertManagerConfig{
+			Enabled: true,
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("the interval must be positive, got %s", c.Interval)
+	}
+	if len(c.ThresholdDays) == 0 {
+		return errors.New("at least one threshold is required")
+	}
+	for _, days := range c.ThresholdDays {
+		if days <= 0 {
+			return fmt.Errorf("the threshold must be a positive number of days, got %d", days)
+		}
+	}
+	return nil
+}
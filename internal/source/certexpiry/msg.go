@@ -0,0 +1,142 @@
+package certexpiry
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// EventType defines the type of the certificate event.
+type EventType string
+
+const (
+	// ExpiryEventType is used when a certificate reached one of the configured thresholds or expired.
+	ExpiryEventType EventType = "expiry"
+	// NotReadyEventType is used when a cert-manager Certificate has the Ready=False condition.
+	NotReadyEventType EventType = "notReady"
+)
+
+// CertificateEvent is a raw object sent together with the certificate expiry message.
+type CertificateEvent struct {
+	Type          EventType `json:"type"`
+	Kind          string    `json:"kind"`
+	Namespace     string    `json:"namespace"`
+	Name          string    `json:"name"`
+	Secret        string    `json:"secret,omitempty"`
+	Subject       string    `json:"subject,omitempty"`
+	Issuer        string    `json:"issuer,omitempty"`
+	DNSNames      []string  `json:"dnsNames,omitempty"`
+	SerialNumber  string    `json:"serialNumber,omitempty"`
+	ChainPosition int       `json:"chainPosition,omitempty"`
+	ChainLength   int       `json:"chainLength,omitempty"`
+	NotAfter      time.Time `json:"notAfter,omitempty"`
+	ThresholdDays int       `json:"thresholdDays,omitempty"`
+	Reason        string    `json:"reason,omitempty"`
+	Message       string    `json:"message,omitempty"`
+	Cluster       string    `json:"cluster,omitempty"`
+}
+
+// Expired returns true if the certificate is already expired.
+func (e CertificateEvent) Expired(now time.Time) bool {
+	return !e.NotAfter.After(now)
+}
+
+func newExpiryEvent(secret *corev1.Secret, cert *x509.Certificate, idx, chainLen, threshold int, cluster string) CertificateEvent {
+	return CertificateEvent{
+		Type:          ExpiryEventType,
+		Kind:          "Secret",
+		Namespace:     secret.Namespace,
+		Name:          secret.Name,
+		Subject:       cert.Subject.String(),
+		Issuer:        cert.Issuer.String(),
+		DNSNames:      cert.DNSNames,
+		SerialNumber:  cert.SerialNumber.String(),
+		ChainPosition: idx + 1,
+		ChainLength:   chainLen,
+		NotAfter:      cert.NotAfter.UTC(),
+		ThresholdDays: threshold,
+		Cluster:       cluster,
+	}
+}
+
+func messageForExpiry(e CertificateEvent, now time.Time) api.Message {
+	emoji, title := "⚠️", fmt.Sprintf("expires in %s", humanizeDaysLeft(e.NotAfter.Sub(now)))
+	if e.Expired(now) {
+		emoji, title = "❌", "expired"
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s Certificate in Secret %s %s", emoji, objectKey(e.Namespace, e.Name), title),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Subject", e.Subject)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Issuer", e.Issuer)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "DNS names", strings.Join(e.DNSNames, ", "))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Expires", e.NotAfter.Format(time.RFC3339))
+	if e.ChainLength > 1 {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Chain position", fmt.Sprintf("%d of %d", e.ChainPosition, e.ChainLength))
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+	section.Context = api.ContextItems{
+		{Text: fmt.Sprintf("Serial number: %s", e.SerialNumber)},
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func messageForNotReady(e CertificateEvent, now time.Time) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("❗ cert-manager Certificate %s is not ready", objectKey(e.Namespace, e.Name)),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Certificate", e.Name)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Secret", e.Secret)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Reason", e.Reason)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+	if e.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Messages",
+			Items: []string{e.Message},
+		})
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func humanizeDaysLeft(left time.Duration) string {
+	days := int(left / day)
+	switch days {
+	case 0:
+		return "less than a day"
+	case 1:
+		return "1 day"
+	default:
+		return fmt.Sprintf("%d days", days)
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
@@ -0,0 +1,273 @@
+package certexpiry
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	day = 24 * time.Hour
+
+	// expiredThreshold is used for certificates which are already expired.
+	expiredThreshold = 0
+
+	readyCondition = "Ready"
+)
+
+var certificateGVR = schema.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+// scanner checks TLS Secrets and cert-manager Certificates and returns events for the ones that need attention.
+// It remembers already sent warnings, so each threshold is reported only once per certificate.
+// The state is kept in memory only, so the last reached thresholds are reported again after the plugin restarts.
+type scanner struct {
+	log        logrus.FieldLogger
+	cfg        Config
+	cluster    string
+	k8sCli     kubernetes.Interface
+	dynamicCli dynamic.Interface
+	now        func() time.Time
+
+	// notifiedThresholds holds the lowest threshold reported for a given certificate.
+	notifiedThresholds map[string]int
+	// notReadyReasons holds the reason reported for a given not ready cert-manager Certificate.
+	notReadyReasons map[string]string
+}
+
+func newScanner(log logrus.FieldLogger, cfg Config, cluster string, k8sCli kubernetes.Interface, dynamicCli dynamic.Interface) *scanner {
+	thresholds := append([]int(nil), cfg.ThresholdDays...)
+	sort.Ints(thresholds)
+	cfg.ThresholdDays = thresholds
+
+	return &scanner{
+		log:                log,
+		cfg:                cfg,
+		cluster:            cluster,
+		k8sCli:             k8sCli,
+		dynamicCli:         dynamicCli,
+		now:                time.Now,
+		notifiedThresholds: map[string]int{},
+		notReadyReasons:    map[string]string{},
+	}
+}
+
+// Scan returns events for certificates which reached a new threshold and cert-manager Certificates which are not ready.
+// Found events are already marked as reported, so they are returned even if the scan fails afterwards.
+func (s *scanner) Scan(ctx context.Context) ([]source.Event, error) {
+	events, err := s.scanSecrets(ctx)
+	if err != nil {
+		return events, err
+	}
+
+	if !s.cfg.CertManager.Enabled {
+		return events, nil
+	}
+	certEvents, err := s.scanCertificates(ctx)
+	return append(events, certEvents...), err
+}
+
+func (s *scanner) scanSecrets(ctx context.Context) ([]source.Event, error) {
+	list, err := s.k8sCli.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(corev1.SecretTypeTLS)).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while listing TLS Secrets: %w", err)
+	}
+
+	now := s.now()
+	seen := map[string]struct{}{}
+	var out []source.Event
+	for i := range list.Items {
+		secret := &list.Items[i]
+		if secret.Type != corev1.SecretTypeTLS {
+			continue
+		}
+		allowed, err := s.cfg.Namespaces.IsAllowed(secret.Namespace)
+		if err != nil {
+			return out, fmt.Errorf("while matching namespace: %w", err)
+		}
+		if !allowed {
+			continue
+		}
+
+		chain, err := parseCertificates(secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			s.log.WithField("secret", objectKey(secret.Namespace, secret.Name)).Warnf("while parsing certificates: %s", err.Error())
+			continue
+		}
+
+		for idx, cert := range chain {
+			key := fmt.Sprintf("%s/%s/%s", secret.Namespace, secret.Name, cert.SerialNumber.String())
+			seen[key] = struct{}{}
+
+			threshold, reached := s.thresholdFor(cert.NotAfter.Sub(now))
+			if !reached {
+				continue
+			}
+			if last, found := s.notifiedThresholds[key]; found && last <= threshold {
+				continue
+			}
+			s.notifiedThresholds[key] = threshold
+
+			expiry := newExpiryEvent(secret, cert, idx, len(chain), threshold, s.cluster)
+			out = append(out, source.Event{
+				Message:   messageForExpiry(expiry, now),
+				RawObject: expiry,
+			})
+		}
+	}
+
+	// Forget certificates that were renewed or removed, so the state doesn't grow indefinitely.
+	for key := range s.notifiedThresholds {
+		if _, found := seen[key]; !found {
+			delete(s.notifiedThresholds, key)
+		}
+	}
+	return out, nil
+}
+
+// thresholdFor returns the lowest threshold in days which was reached for a given time left to the expiry.
+func (s *scanner) thresholdFor(left time.Duration) (int, bool) {
+	if left <= 0 {
+		return expiredThreshold, true
+	}
+	for _, days := range s.cfg.ThresholdDays {
+		if left <= time.Duration(days)*day {
+			return days, true
+		}
+	}
+	return 0, false
+}
+
+func (s *scanner) scanCertificates(ctx context.Context) ([]source.Event, error) {
+	list, err := s.dynamicCli.Resource(certificateGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			s.log.Debug("cert-manager Certificates are not available in the cluster, skipping...")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("while listing cert-manager Certificates: %w", err)
+	}
+
+	now := s.now()
+	seen := map[string]struct{}{}
+	var out []source.Event
+	for i := range list.Items {
+		cert := &list.Items[i]
+		allowed, err := s.cfg.Namespaces.IsAllowed(cert.GetNamespace())
+		if err != nil {
+			return out, fmt.Errorf("while matching namespace: %w", err)
+		}
+		if !allowed {
+			continue
+		}
+
+		cond, found := readyConditionOf(cert)
+		if !found || cond.Status != string(metav1.ConditionFalse) {
+			continue
+		}
+
+		key := objectKey(cert.GetNamespace(), cert.GetName())
+		seen[key] = struct{}{}
+		if last, found := s.notReadyReasons[key]; found && last == cond.Reason {
+			continue
+		}
+		s.notReadyReasons[key] = cond.Reason
+
+		secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+		notReady := CertificateEvent{
+			Type:      NotReadyEventType,
+			Kind:      "Certificate",
+			Namespace: cert.GetNamespace(),
+			Name:      cert.GetName(),
+			Secret:    secretName,
+			Reason:    cond.Reason,
+			Message:   cond.Message,
+			Cluster:   s.cluster,
+		}
+		out = append(out, source.Event{
+			Message:   messageForNotReady(notReady, now),
+			RawObject: notReady,
+		})
+	}
+
+	// Forget Certificates that are ready again or removed, so they are reported when they fail next time.
+	for key := range s.notReadyReasons {
+		if _, found := seen[key]; !found {
+			delete(s.notReadyReasons, key)
+		}
+	}
+	return out, nil
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func readyConditionOf(obj *unstructured.Unstructured) (condition, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var cond condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &cond); err != nil {
+			continue
+		}
+		if cond.Type == readyCondition {
+			return cond, true
+		}
+	}
+	return condition{}, false
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var out []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, cert)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificates found in %q", corev1.TLSCertKey)
+	}
+	return out, nil
+}
+
+func objectKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
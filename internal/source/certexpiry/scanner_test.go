@@ -0,0 +1,311 @@
+package certexpiry
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestScanner_Secrets(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	notAfter := now.Add(40 * day)
+
+	cli := fake.NewSimpleClientset(
+		fixTLSSecret(t, "default", "web-tls", 1, notAfter),
+		fixTLSSecret(t, "kube-system", "ignored-tls", 2, notAfter),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "default"},
+			Type:       corev1.SecretTypeOpaque,
+		},
+	)
+	cfg := fixConfig(t)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+	sc := fixScanner(cfg, cli, fixDynamicClient())
+
+	tests := []struct {
+		name              string
+		after             time.Duration
+		expectedThreshold []int
+	}{
+		{name: "No threshold reached", after: 0},
+		{name: "First threshold reached", after: 11 * day, expectedThreshold: []int{30}},
+		{name: "Same threshold is not repeated", after: 12 * day},
+		{name: "Lower threshold reached", after: 34 * day, expectedThreshold: []int{7}},
+		{name: "Skipped thresholds are reported once", after: 40*day - time.Hour, expectedThreshold: []int{1}},
+		{name: "Certificate expired", after: 40*day + time.Hour, expectedThreshold: []int{expiredThreshold}},
+		{name: "Expiry is not repeated", after: 50 * day},
+	}
+	for _, tc := range tests {
+		// given
+		sc.now = func() time.Time { return now.Add(tc.after) }
+
+		// when
+		events, err := sc.Scan(ctx)
+
+		// then
+		require.NoError(t, err, tc.name)
+		var thresholds []int
+		for _, event := range events {
+			e := event.RawObject.(CertificateEvent)
+			assert.Equal(t, "web-tls", e.Name, tc.name)
+			thresholds = append(thresholds, e.ThresholdDays)
+		}
+		assert.Equal(t, tc.expectedThreshold, thresholds, tc.name)
+	}
+}
+
+func TestScanner_RenewedSecret(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cli := fake.NewSimpleClientset(fixTLSSecret(t, "default", "web-tls", 1, now.Add(5*day)))
+	sc := fixScanner(fixConfig(t), cli, fixDynamicClient())
+	sc.now = func() time.Time { return now }
+
+	events, err := sc.Scan(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// when
+	_, err = cli.CoreV1().Secrets("default").Update(ctx, fixTLSSecret(t, "default", "web-tls", 2, now.Add(6*day)), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	events, err = sc.Scan(ctx)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "2", events[0].RawObject.(CertificateEvent).SerialNumber)
+	assert.Len(t, sc.notifiedThresholds, 1)
+}
+
+func TestScanner_CertManagerCertificates(t *testing.T) {
+	// given
+	ctx := context.Background()
+	dynamicCli := fixDynamicClient(
+		fixCertificate("default", "web", "True", "Ready", ""),
+		fixCertificate("default", "api", "False", "DoesNotExist", "Issuing certificate as Secret does not exist"),
+	)
+	sc := fixScanner(fixConfig(t), fake.NewSimpleClientset(), dynamicCli)
+
+	// when
+	events, err := sc.Scan(ctx)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, CertificateEvent{
+		Type:      NotReadyEventType,
+		Kind:      "Certificate",
+		Namespace: "default",
+		Name:      "api",
+		Secret:    "api-tls",
+		Reason:    "DoesNotExist",
+		Message:   "Issuing certificate as Secret does not exist",
+		Cluster:   "prod",
+	}, events[0].RawObject)
+	assert.Equal(t, "❗ cert-manager Certificate default/api is not ready", events[0].Message.Sections[0].Header)
+
+	// when
+	events, err = sc.Scan(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// when
+	updateCertificate(t, dynamicCli, fixCertificate("default", "api", "False", "Failed", "The certificate request has failed"))
+	events, err = sc.Scan(ctx)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Failed", events[0].RawObject.(CertificateEvent).Reason)
+
+	// when
+	updateCertificate(t, dynamicCli, fixCertificate("default", "api", "True", "Ready", ""))
+	events, err = sc.Scan(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Empty(t, sc.notReadyReasons)
+}
+
+func TestScanner_CertManagerNotInstalled(t *testing.T) {
+	// given
+	dynamicCli := fixDynamicClient()
+	dynamicCli.PrependReactor("list", "certificates", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(certificateGVR.GroupResource(), "")
+	})
+	sc := fixScanner(fixConfig(t), fake.NewSimpleClientset(), dynamicCli)
+
+	// when
+	events, err := sc.Scan(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestScanner_CertManagerListFailed(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	cli := fake.NewSimpleClientset(fixTLSSecret(t, "default", "web-tls", 1, now.Add(5*day)))
+	dynamicCli := fixDynamicClient()
+	dynamicCli.PrependReactor("list", "certificates", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewServiceUnavailable("unavailable")
+	})
+	sc := fixScanner(fixConfig(t), cli, dynamicCli)
+	sc.now = func() time.Time { return now }
+
+	// when
+	events, err := sc.Scan(context.Background())
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "while listing cert-manager Certificates")
+	require.Len(t, events, 1, "already reported Secret warnings should be returned")
+	assert.Equal(t, "web-tls", events[0].RawObject.(CertificateEvent).Name)
+}
+
+func TestMessageForExpiry(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	e := CertificateEvent{
+		Type:          ExpiryEventType,
+		Kind:          "Secret",
+		Namespace:     "default",
+		Name:          "web-tls",
+		Subject:       "CN=example.com",
+		Issuer:        "CN=Example CA",
+		DNSNames:      []string{"example.com", "www.example.com"},
+		SerialNumber:  "42",
+		ChainPosition: 1,
+		ChainLength:   2,
+		NotAfter:      now.Add(6*day + time.Hour),
+		ThresholdDays: 7,
+		Cluster:       "prod",
+	}
+
+	// when
+	msg := messageForExpiry(e, now)
+
+	// then
+	require.Len(t, msg.Sections, 1)
+	section := msg.Sections[0]
+	assert.Equal(t, "⚠️ Certificate in Secret default/web-tls expires in 6 days", section.Header)
+	assert.Equal(t, api.TextFields{
+		{Key: "Subject", Value: "CN=example.com"},
+		{Key: "Issuer", Value: "CN=Example CA"},
+		{Key: "DNS names", Value: "example.com, www.example.com"},
+		{Key: "Expires", Value: "2023-03-07T13:00:00Z"},
+		{Key: "Chain position", Value: "1 of 2"},
+		{Key: "Cluster", Value: "prod"},
+	}, section.TextFields)
+
+	// when
+	msg = messageForExpiry(e, now.Add(7*day))
+
+	// then
+	assert.Equal(t, "❌ Certificate in Secret default/web-tls expired", msg.Sections[0].Header)
+}
+
+func fixConfig(t *testing.T) Config {
+	t.Helper()
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	return cfg
+}
+
+func fixScanner(cfg Config, cli *fake.Clientset, dynamicCli *dynamicfake.FakeDynamicClient) *scanner {
+	return newScanner(loggerx.NewNoop(), cfg, "prod", cli, dynamicCli)
+}
+
+func fixDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		certificateGVR: "CertificateList",
+	}, objects...)
+}
+
+func fixTLSSecret(t *testing.T, namespace, name string, serial int64, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    notAfter.Add(-90 * day),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		},
+	}
+}
+
+func fixCertificate(namespace, name, status, reason, message string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"secretName": name + "-tls",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":    "Ready",
+						"status":  status,
+						"reason":  reason,
+						"message": message,
+					},
+				},
+			},
+		},
+	}
+}
+
+func updateCertificate(t *testing.T, cli *dynamicfake.FakeDynamicClient, cert *unstructured.Unstructured) {
+	t.Helper()
+
+	_, err := cli.Resource(certificateGVR).Namespace(cert.GetNamespace()).Update(context.Background(), cert, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,215 @@
+package certexpiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the certificate expiry Botkube plugin.
+	PluginName = "cert-expiry"
+
+	description = "Get notifications about TLS certificates stored in Secrets that are about to expire, and cert-manager Certificates that are not ready."
+)
+
+// Source certificate expiry source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams certificate expiry events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+	dynamicCli, err := dynamic.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating dynamic K8s client: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	sc := newScanner(log, cfg, input.Context.ClusterName, k8sCli, dynamicCli)
+	go s.scanPeriodically(ctx, log, cfg.Interval, sc, out.Event)
+
+	return out, nil
+}
+
+// Metadata returns metadata of certificate expiry configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) scanPeriodically(ctx context.Context, log logrus.FieldLogger, interval time.Duration, sc *scanner, ch chan<- source.Event) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := sc.Scan(ctx)
+		if err != nil {
+			log.Errorf("while scanning certificates: %s", err.Error())
+		}
+		for _, event := range events {
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Certificate expiry",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"interval": {
+			  "title": "Interval",
+			  "description": "How often TLS Secrets and cert-manager Certificates are scanned.",
+			  "type": "string",
+			  "default": "1h"
+			},
+			"thresholdDays": {
+			  "title": "Thresholds",
+			  "description": "Days before the expiry on which a warning is sent. Each threshold is reported only once per certificate. The reported thresholds are kept in memory, so the last one is reported again after a restart.",
+			  "type": "array",
+			  "default": [30, 7, 1],
+			  "items": {
+				"type": "integer",
+				"minimum": 1
+			  }
+			},
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces in which certificates are checked.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"certManager": {
+			  "title": "cert-manager",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If true, reports cert-manager Certificates with the Ready=False condition. It's skipped if cert-manager is not installed.",
+				  "type": "boolean",
+				  "default": true
+				}
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
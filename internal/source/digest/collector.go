@@ -0,0 +1,332 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Count holds a number of occurrences of a given item.
+type Count struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Report is a raw object sent together with the cluster health digest message.
+type Report struct {
+	Cluster              string    `json:"cluster,omitempty"`
+	Since                time.Time `json:"since"`
+	Until                time.Time `json:"until"`
+	NotRunningPods       []Count   `json:"notRunningPods,omitempty"`
+	RestartedContainers  []Count   `json:"restartedContainers,omitempty"`
+	PendingPVCs          []string  `json:"pendingPVCs,omitempty"`
+	NotReadyNodes        []string  `json:"notReadyNodes,omitempty"`
+	WarningEvents        []Count   `json:"warningEvents,omitempty"`
+	UnavailableWorkloads []string  `json:"unavailableWorkloads,omitempty"`
+}
+
+// collector gathers the cluster state for the configured digest sections.
+type collector struct {
+	cfg     Config
+	cluster string
+	k8sCli  kubernetes.Interface
+	now     func() time.Time
+
+	// restartCounts holds container restart counts from the previous collection, so only new restarts are reported.
+	restartCounts map[string]int32
+}
+
+func newCollector(cfg Config, cluster string, k8sCli kubernetes.Interface) *collector {
+	return &collector{
+		cfg:     cfg,
+		cluster: cluster,
+		k8sCli:  k8sCli,
+		now:     time.Now,
+
+		restartCounts: map[string]int32{},
+	}
+}
+
+// Collect returns the report with data for the configured sections only.
+func (c *collector) Collect(ctx context.Context) (Report, error) {
+	until := c.now()
+	report := Report{
+		Cluster: c.cluster,
+		Since:   until.Add(-c.cfg.Period),
+		Until:   until,
+	}
+
+	podsCollected := false
+	for _, name := range c.cfg.Sections {
+		var err error
+		switch name {
+		case PodsSection, RestartsSection:
+			// both sections are collected from the same Pod list
+			if podsCollected {
+				continue
+			}
+			podsCollected = true
+			err = c.collectPods(ctx, &report)
+		case PVCsSection:
+			err = c.collectPVCs(ctx, &report)
+		case NodesSection:
+			err = c.collectNodes(ctx, &report)
+		case WarningEventsSection:
+			err = c.collectWarningEvents(ctx, &report)
+		case WorkloadsSection:
+			err = c.collectWorkloads(ctx, &report)
+		}
+		if err != nil {
+			return Report{}, fmt.Errorf("while collecting %s: %w", name, err)
+		}
+	}
+	return report, nil
+}
+
+func (c *collector) collectPods(ctx context.Context, report *Report) error {
+	list, err := c.k8sCli.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	reasons := map[string]int{}
+	restarts := map[string]int{}
+	restartCounts := map[string]int32{}
+	for i := range list.Items {
+		pod := &list.Items[i]
+		allowed, err := c.cfg.Namespaces.IsAllowed(pod.Namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			continue
+		}
+
+		if reason, notRunning := notRunningReason(pod); notRunning {
+			reasons[reason]++
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			key := fmt.Sprintf("%s/%s", pod.UID, status.Name)
+			restartCounts[key] = status.RestartCount
+
+			if count := c.restartsInPeriod(key, pod, status, report.Since); count > 0 {
+				restarts[fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, status.Name)] = count
+			}
+		}
+	}
+	// Containers of removed Pods are forgotten, so the state doesn't grow indefinitely.
+	c.restartCounts = restartCounts
+
+	report.NotRunningPods = sortedCounts(reasons)
+	report.RestartedContainers = sortedCounts(restarts)
+	return nil
+}
+
+// restartsInPeriod returns the number of container restarts since the previous collection.
+// If the container wasn't seen before, all restarts are counted only for Pods created in the period.
+// Otherwise, it returns one restart if the container was last terminated in the period, as earlier restarts cannot be dated.
+func (c *collector) restartsInPeriod(key string, pod *corev1.Pod, status corev1.ContainerStatus, since time.Time) int {
+	if prev, found := c.restartCounts[key]; found {
+		if status.RestartCount < prev {
+			return int(status.RestartCount)
+		}
+		return int(status.RestartCount - prev)
+	}
+
+	if !pod.CreationTimestamp.Time.Before(since) {
+		return int(status.RestartCount)
+	}
+	terminated := status.LastTerminationState.Terminated
+	if terminated == nil || terminated.FinishedAt.Time.Before(since) {
+		return 0
+	}
+	return 1
+}
+
+// notRunningReason returns the most specific reason of a Pod which is not running, or has a waiting container.
+func notRunningReason(pod *corev1.Pod) (string, bool) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return status.State.Waiting.Reason, true
+		}
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+		return "", false
+	}
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason, true
+	}
+	return string(pod.Status.Phase), true
+}
+
+func (c *collector) collectPVCs(ctx context.Context, report *Report) error {
+	list, err := c.k8sCli.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, pvc := range list.Items {
+		if pvc.Status.Phase != corev1.ClaimPending {
+			continue
+		}
+		allowed, err := c.cfg.Namespaces.IsAllowed(pvc.Namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			continue
+		}
+		report.PendingPVCs = append(report.PendingPVCs, fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+	}
+	sort.Strings(report.PendingPVCs)
+	return nil
+}
+
+func (c *collector) collectNodes(ctx context.Context, report *Report) error {
+	list, err := c.k8sCli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range list.Items {
+		if !isNodeReady(node) {
+			report.NotReadyNodes = append(report.NotReadyNodes, node.Name)
+		}
+	}
+	sort.Strings(report.NotReadyNodes)
+	return nil
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (c *collector) collectWarningEvents(ctx context.Context, report *Report) error {
+	list, err := c.k8sCli.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", corev1.EventTypeWarning).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	namespaces := map[string]int{}
+	for i := range list.Items {
+		event := &list.Items[i]
+		if event.Type != corev1.EventTypeWarning || lastSeen(event).Before(report.Since) {
+			continue
+		}
+		allowed, err := c.cfg.Namespaces.IsAllowed(event.Namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			continue
+		}
+
+		count := int(event.Count)
+		if count < 1 {
+			count = 1
+		}
+		namespaces[event.Namespace] += count
+	}
+
+	report.WarningEvents = sortedCounts(namespaces)
+	return nil
+}
+
+func lastSeen(event *corev1.Event) time.Time {
+	out := event.LastTimestamp.Time
+	if out.IsZero() {
+		out = event.EventTime.Time
+	}
+	if event.Series != nil && event.Series.LastObservedTime.Time.After(out) {
+		out = event.Series.LastObservedTime.Time
+	}
+	return out
+}
+
+func (c *collector) collectWorkloads(ctx context.Context, report *Report) error {
+	appendIfUnavailable := func(kind, namespace, name string, desired, available int32) error {
+		if available >= desired {
+			return nil
+		}
+		allowed, err := c.cfg.Namespaces.IsAllowed(namespace)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return nil
+		}
+		report.UnavailableWorkloads = append(report.UnavailableWorkloads, fmt.Sprintf("%s %s/%s (%d/%d available)", kind, namespace, name, available, desired))
+		return nil
+	}
+
+	deployments, err := c.k8sCli.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, d := range deployments.Items {
+		if err := appendIfUnavailable("Deployment", d.Namespace, d.Name, desiredReplicas(d.Spec.Replicas), d.Status.AvailableReplicas); err != nil {
+			return err
+		}
+	}
+
+	statefulSets, err := c.k8sCli.AppsV1().StatefulSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, s := range statefulSets.Items {
+		if err := appendIfUnavailable("StatefulSet", s.Namespace, s.Name, desiredReplicas(s.Spec.Replicas), s.Status.AvailableReplicas); err != nil {
+			return err
+		}
+	}
+
+	daemonSets, err := c.k8sCli.AppsV1().DaemonSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, d := range daemonSets.Items {
+		if err := appendIfUnavailable("DaemonSet", d.Namespace, d.Name, d.Status.DesiredNumberScheduled, d.Status.NumberAvailable); err != nil {
+			return err
+		}
+	}
+
+	sort.Strings(report.UnavailableWorkloads)
+	return nil
+}
+
+// desiredReplicas returns the number of replicas, which defaults to 1 if not set.
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// sortedCounts returns counts sorted from the highest one. Items with the same count are sorted by name.
+func sortedCounts(in map[string]int) []Count {
+	out := make([]Count, 0, len(in))
+	for name, count := range in {
+		out = append(out, Count{Name: name, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
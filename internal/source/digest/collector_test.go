@@ -0,0 +1,314 @@
+package digest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+var fixNow = time.Date(2023, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func TestCollector_Collect(t *testing.T) {
+	// given
+	cfg := fixConfig(t)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+	c := newCollector(cfg, "prod", fake.NewSimpleClientset(fixObjects()...))
+	c.now = func() time.Time { return fixNow }
+
+	// when
+	report, err := c.Collect(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, Report{
+		Cluster: "prod",
+		Since:   fixNow.Add(-24 * time.Hour),
+		Until:   fixNow,
+		NotRunningPods: []Count{
+			{Name: "CrashLoopBackOff", Count: 2},
+			{Name: "Evicted", Count: 1},
+			{Name: "Pending", Count: 1},
+		},
+		RestartedContainers: []Count{
+			{Name: "default/api-1/app", Count: 12},
+			{Name: "default/api-2/app", Count: 1},
+		},
+		PendingPVCs:   []string{"default/data-db-0"},
+		NotReadyNodes: []string{"node-2"},
+		WarningEvents: []Count{
+			{Name: "default", Count: 5},
+			{Name: "team-a", Count: 1},
+		},
+		UnavailableWorkloads: []string{
+			"DaemonSet default/agent (2/3 available)",
+			"Deployment default/api (1/3 available)",
+		},
+	}, report)
+}
+
+func TestCollector_CollectRestartsSincePreviousReport(t *testing.T) {
+	// given
+	cfg := fixConfig(t)
+	cfg.Sections = []SectionName{RestartsSection}
+	cli := fake.NewSimpleClientset(fixObjects()...)
+	c := newCollector(cfg, "prod", cli)
+	c.now = func() time.Time { return fixNow }
+
+	_, err := c.Collect(context.Background())
+	require.NoError(t, err)
+
+	pod, err := cli.CoreV1().Pods("default").Get(context.Background(), "api-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	pod.Status.ContainerStatuses[0].RestartCount = 5
+	pod.Status.ContainerStatuses[0].LastTerminationState.Terminated.FinishedAt = metav1.NewTime(fixNow.Add(time.Hour))
+	_, err = cli.CoreV1().Pods("default").UpdateStatus(context.Background(), pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	c.now = func() time.Time { return fixNow.Add(24 * time.Hour) }
+
+	// when
+	report, err := c.Collect(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Count{{Name: "default/api-2/app", Count: 2}}, report.RestartedContainers)
+}
+
+func TestCollector_CollectSelectedSections(t *testing.T) {
+	// given
+	cfg := fixConfig(t)
+	cfg.Sections = []SectionName{NodesSection, PVCsSection}
+	c := newCollector(cfg, "prod", fake.NewSimpleClientset(fixObjects()...))
+	c.now = func() time.Time { return fixNow }
+
+	// when
+	report, err := c.Collect(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-2"}, report.NotReadyNodes)
+	assert.Equal(t, []string{"default/data-db-0"}, report.PendingPVCs)
+	assert.Nil(t, report.NotRunningPods)
+	assert.Nil(t, report.RestartedContainers)
+	assert.Nil(t, report.WarningEvents)
+	assert.Nil(t, report.UnavailableWorkloads)
+}
+
+func TestMessageForReport(t *testing.T) {
+	// given
+	cfg := fixConfig(t)
+	cfg.Sections = []SectionName{PodsSection, PVCsSection, WorkloadsSection}
+	cfg.MaxItems = 1
+	report := Report{
+		Cluster: "prod",
+		Since:   fixNow.Add(-24 * time.Hour),
+		Until:   fixNow,
+		NotRunningPods: []Count{
+			{Name: "CrashLoopBackOff", Count: 2},
+			{Name: "Evicted", Count: 1},
+		},
+		UnavailableWorkloads: []string{"Deployment default/api (1/3 available)"},
+	}
+
+	// when
+	msg := messageForReport(report, cfg)
+
+	// then
+	assert.Equal(t, api.Message{
+		Timestamp: fixNow,
+		Sections: []api.Section{
+			{
+				Base: api.Base{Header: "📋 Cluster health digest"},
+				TextFields: api.TextFields{
+					{Key: "Cluster", Value: "prod"},
+					{Key: "Period", Value: "last 24h"},
+				},
+			},
+			{
+				Base:       api.Base{Header: "Non-running Pods"},
+				TextFields: api.TextFields{{Key: "CrashLoopBackOff", Value: "2"}},
+				Context:    api.ContextItems{{Text: "...and 1 more"}},
+			},
+			{
+				Base: api.Base{Header: "Pending PersistentVolumeClaims", Description: "✅ No pending PersistentVolumeClaims."},
+			},
+			{
+				Base:        api.Base{Header: "Workloads below desired replicas"},
+				BulletLists: api.BulletLists{{Items: []string{"Deployment default/api (1/3 available)"}}},
+			},
+		},
+	}, msg)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name: "Default configuration",
+		},
+		{
+			name:   "Weekly schedule with time zone",
+			mutate: func(cfg *Config) { cfg.Schedule = "CRON_TZ=Europe/Warsaw 0 9 * * 1" },
+		},
+		{
+			name:        "Invalid schedule",
+			mutate:      func(cfg *Config) { cfg.Schedule = "every day" },
+			expectedErr: `while parsing schedule "every day": expected exactly 5 fields, found 2: [every day]`,
+		},
+		{
+			name:        "Unknown section",
+			mutate:      func(cfg *Config) { cfg.Sections = []SectionName{"jobs"} },
+			expectedErr: `unknown section "jobs", allowed values: ["pods" "restarts" "pvcs" "nodes" "warningEvents" "workloads"]`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg := fixConfig(t)
+			if tc.mutate != nil {
+				tc.mutate(&cfg)
+			}
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func fixConfig(t *testing.T) Config {
+	t.Helper()
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	return cfg
+}
+
+func fixObjects() []runtime.Object {
+	recently := metav1.NewTime(fixNow.Add(-time.Hour))
+	longAgo := metav1.NewTime(fixNow.Add(-48 * time.Hour))
+
+	crashingPod := func(name string, restarts int32, finishedAt metav1.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name), CreationTimestamp: longAgo},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 "app",
+						RestartCount:         restarts,
+						State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: finishedAt}},
+					},
+				},
+			},
+		}
+	}
+	pod := func(namespace, name string, phase corev1.PodPhase, reason string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     corev1.PodStatus{Phase: phase, Reason: reason},
+		}
+	}
+	node := func(name string, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+	warning := func(namespace, name string, count int32, lastTimestamp metav1.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Type:          corev1.EventTypeWarning,
+			Count:         count,
+			LastTimestamp: lastTimestamp,
+		}
+	}
+
+	return []runtime.Object{
+		func() *corev1.Pod {
+			p := crashingPod("api-1", 12, recently)
+			p.CreationTimestamp = recently
+			return p
+		}(),
+		crashingPod("api-2", 3, recently),
+		pod("default", "web", corev1.PodRunning, ""),
+		pod("default", "migration", corev1.PodSucceeded, ""),
+		pod("default", "evicted", corev1.PodFailed, "Evicted"),
+		pod("team-a", "pending", corev1.PodPending, ""),
+		pod("kube-system", "excluded", corev1.PodPending, ""),
+		func() *corev1.Pod {
+			p := crashingPod("old-restart", 1, longAgo)
+			p.Status.ContainerStatuses[0].State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+			return p
+		}(),
+
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "default"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-db-1", Namespace: "default"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		},
+
+		node("node-1", corev1.ConditionTrue),
+		node("node-2", corev1.ConditionUnknown),
+
+		warning("default", "api-1.backoff", 4, recently),
+		warning("default", "api-2.backoff", 1, recently),
+		warning("default", "old", 10, longAgo),
+		warning("team-a", "pending.failed-scheduling", 0, recently),
+		warning("kube-system", "excluded", 100, recently),
+		&corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: "normal", Namespace: "team-a"},
+			Type:          corev1.EventTypeNormal,
+			LastTimestamp: recently,
+		},
+
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(3)},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: 1},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: 1},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: pointer.Int32(2)},
+			Status:     appsv1.StatefulSetStatus{AvailableReplicas: 2},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 2},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "excluded", Namespace: "kube-system"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 0},
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// SectionName defines a name of the digest section.
+type SectionName string
+
+const (
+	// PodsSection lists counts of non-running Pods by reason.
+	PodsSection SectionName = "pods"
+	// RestartsSection lists containers restarted in the last period.
+	RestartsSection SectionName = "restarts"
+	// PVCsSection lists pending PersistentVolumeClaims.
+	PVCsSection SectionName = "pvcs"
+	// NodesSection lists Nodes which are not ready.
+	NodesSection SectionName = "nodes"
+	// WarningEventsSection lists namespaces with the highest number of warning events in the last period.
+	WarningEventsSection SectionName = "warningEvents"
+	// WorkloadsSection lists Deployments, StatefulSets and DaemonSets with less available replicas than desired.
+	WorkloadsSection SectionName = "workloads"
+)
+
+var allSections = []SectionName{PodsSection, RestartsSection, PVCsSection, NodesSection, WarningEventsSection, WorkloadsSection}
+
+// Config holds cluster health digest source configuration.
+type Config struct {
+	Log config.Logger `yaml:"log"`
+	// Schedule is a cron expression which defines when the digest is sent.
+	// The time zone can be set with the `CRON_TZ=` prefix, e.g. `CRON_TZ=Europe/Warsaw 0 9 * * 1`.
+	Schedule string `yaml:"schedule"`
+	// Period defines how far back restarts and warning events are counted. It should match the schedule.
+	Period     time.Duration              `yaml:"period"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// Sections defines digest sections and their order.
+	Sections []SectionName `yaml:"sections"`
+	// MaxItems limits the number of items listed in a single section.
+	MaxItems int `yaml:"maxItems"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Schedule: "0 9 * * *",
+		Period:   24 * time.Hour,
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		Sections: allSections,
+		MaxItems: 10,
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if _, err := cron.ParseStandard(c.Schedule); err != nil {
+		return fmt.Errorf("while parsing schedule %q: %w", c.Schedule, err)
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("the period must be positive, got %s", c.Period)
+	}
+	if c.MaxItems <= 0 {
+		return fmt.Errorf("the max items must be positive, got %d", c.MaxItems)
+	}
+	if len(c.Sections) == 0 {
+		return errors.New("at least one section is required")
+	}
+	for _, name := range c.Sections {
+		if !isKnownSection(name) {
+			return fmt.Errorf("unknown section %q, allowed values: %q", name, allSections)
+		}
+	}
+	return nil
+}
+
+func isKnownSection(name SectionName) bool {
+	for _, known := range allSections {
+		if name == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package digest
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func messageForReport(report Report, cfg Config) api.Message {
+	period := duration.HumanDuration(report.Until.Sub(report.Since))
+
+	header := api.Section{
+		Base: api.Base{
+			Header: "📋 Cluster health digest",
+		},
+	}
+	header.TextFields = appendTextFieldIfNotEmpty(header.TextFields, "Cluster", report.Cluster)
+	header.TextFields = appendTextFieldIfNotEmpty(header.TextFields, "Period", fmt.Sprintf("last %s", period))
+
+	sections := []api.Section{header}
+	for _, name := range cfg.Sections {
+		switch name {
+		case PodsSection:
+			sections = append(sections, countsSection("Non-running Pods", "All Pods are running.", report.NotRunningPods, cfg.MaxItems))
+		case RestartsSection:
+			items := make([]string, 0, len(report.RestartedContainers))
+			for _, c := range report.RestartedContainers {
+				items = append(items, fmt.Sprintf("%s (%d restarts)", c.Name, c.Count))
+			}
+			sections = append(sections, listSection(fmt.Sprintf("Containers restarted in the last %s", period), "No container restarts.", items, cfg.MaxItems))
+		case PVCsSection:
+			sections = append(sections, listSection("Pending PersistentVolumeClaims", "No pending PersistentVolumeClaims.", report.PendingPVCs, cfg.MaxItems))
+		case NodesSection:
+			sections = append(sections, listSection("Not ready Nodes", "All Nodes are ready.", report.NotReadyNodes, cfg.MaxItems))
+		case WarningEventsSection:
+			sections = append(sections, countsSection("Top namespaces by warning events", "No warning events.", report.WarningEvents, cfg.MaxItems))
+		case WorkloadsSection:
+			sections = append(sections, listSection("Workloads below desired replicas", "All workloads have desired replicas available.", report.UnavailableWorkloads, cfg.MaxItems))
+		}
+	}
+
+	return api.Message{
+		Timestamp: report.Until,
+		Sections:  sections,
+	}
+}
+
+func countsSection(title, emptyDesc string, counts []Count, maxItems int) api.Section {
+	section := api.Section{
+		Base: api.Base{
+			Header: title,
+		},
+	}
+	if len(counts) == 0 {
+		section.Description = fmt.Sprintf("✅ %s", emptyDesc)
+		return section
+	}
+
+	for _, c := range counts[:min(len(counts), maxItems)] {
+		section.TextFields = append(section.TextFields, api.TextField{Key: c.Name, Value: strconv.Itoa(c.Count)})
+	}
+	section.Context = moreItemsContext(len(counts), maxItems)
+	return section
+}
+
+func listSection(title, emptyDesc string, items []string, maxItems int) api.Section {
+	section := api.Section{
+		Base: api.Base{
+			Header: title,
+		},
+	}
+	if len(items) == 0 {
+		section.Description = fmt.Sprintf("✅ %s", emptyDesc)
+		return section
+	}
+
+	section.BulletLists = api.BulletLists{
+		{Items: items[:min(len(items), maxItems)]},
+	}
+	section.Context = moreItemsContext(len(items), maxItems)
+	return section
+}
+
+func moreItemsContext(total, maxItems int) api.ContextItems {
+	if total <= maxItems {
+		return nil
+	}
+	return api.ContextItems{
+		{Text: fmt.Sprintf("...and %d more", total-maxItems)},
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
@@ -0,0 +1,244 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the cluster health digest Botkube plugin.
+	PluginName = "digest"
+
+	description = "Get a periodic digest of the cluster health, such as non-running Pods, restarts, pending PVCs, not ready Nodes, warning events and unavailable workloads."
+)
+
+// Source cluster health digest source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams cluster health digests
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+	schedule, err := cron.ParseStandard(cfg.Schedule)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while parsing schedule: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	go s.sendOnSchedule(ctx, log, schedule, newCollector(cfg, input.Context.ClusterName, k8sCli), cfg, out.Event)
+
+	return out, nil
+}
+
+// Metadata returns metadata of cluster health digest configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) sendOnSchedule(ctx context.Context, log logrus.FieldLogger, schedule cron.Schedule, c *collector, cfg Config, ch chan<- source.Event) {
+	for {
+		next := schedule.Next(time.Now())
+		log.Debugf("Next digest scheduled at %s", next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+
+		report, err := c.Collect(ctx)
+		if err != nil {
+			log.Errorf("while collecting cluster health digest: %s", err.Error())
+			continue
+		}
+
+		select {
+		case ch <- source.Event{
+			Message:   messageForReport(report, cfg),
+			RawObject: report,
+		}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Cluster health digest",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"schedule": {
+			  "title": "Schedule",
+			  "description": "Cron expression which defines when the digest is sent. The time zone can be set with the CRON_TZ= prefix.",
+			  "type": "string",
+			  "default": "0 9 * * *"
+			},
+			"period": {
+			  "title": "Period",
+			  "description": "How far back restarts and warning events are counted. It should match the schedule.",
+			  "type": "string",
+			  "default": "24h"
+			},
+			"sections": {
+			  "title": "Sections",
+			  "description": "Digest sections in the order they are displayed.",
+			  "type": "array",
+			  "default": ["pods", "restarts", "pvcs", "nodes", "warningEvents", "workloads"],
+			  "items": {
+				"type": "string",
+				"oneOf": [
+				  {
+					"const": "pods",
+					"title": "Non-running Pods by reason"
+				  },
+				  {
+					"const": "restarts",
+					"title": "Restarted containers"
+				  },
+				  {
+					"const": "pvcs",
+					"title": "Pending PersistentVolumeClaims"
+				  },
+				  {
+					"const": "nodes",
+					"title": "Not ready Nodes"
+				  },
+				  {
+					"const": "warningEvents",
+					"title": "Top namespaces by warning events"
+				  },
+				  {
+					"const": "workloads",
+					"title": "Workloads below desired replicas"
+				  }
+				]
+			  }
+			},
+			"maxItems": {
+			  "title": "Max items",
+			  "description": "Maximum number of items listed in a single section.",
+			  "type": "integer",
+			  "default": 10
+			},
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces included in the digest. Nodes are always included.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/mute"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
@@ -112,6 +113,7 @@ func (d *Dispatcher) Dispatch(dispatch PluginDispatch) error {
 			IsInteractivitySupported: dispatch.isInteractivitySupported,
 			ClusterName:              dispatch.cfg.Settings.ClusterName,
 			KubeConfig:               kubeconfig,
+			SourceName:               dispatch.sourceName,
 		},
 	})
 	if err != nil {
@@ -148,11 +150,13 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 		sources    = []string{dispatch.sourceName}
 	)
 
+	object := mute.TargetFromObject(event.RawObject)
 	for _, n := range d.getBotNotifiers(dispatch) {
 		go func(n notifier.Bot) {
 			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
 			msg := interactive.CoreMessage{
 				Message: event.Message,
+				Object:  object,
 			}
 			err := n.SendMessage(ctx, msg, sources)
 			if err != nil {
@@ -0,0 +1,90 @@
+package gitops
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds GitOps source configuration.
+type Config struct {
+	Log        config.Logger              `yaml:"log"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	ArgoCD     ToolConfig                 `yaml:"argocd"`
+	Flux       ToolConfig                 `yaml:"flux"`
+	// Events defines status transitions which are reported.
+	Events []EventType `yaml:"events"`
+	// MaxResources is the maximum number of failed resources listed in a single message.
+	MaxResources         int           `yaml:"maxResources"`
+	InformerResyncPeriod time.Duration `yaml:"informerResyncPeriod"`
+}
+
+// ToolConfig contains configuration for a given GitOps tool.
+type ToolConfig struct {
+	// Enabled watches the tool resources. It's skipped if the tool CRDs are not installed.
+	Enabled bool `yaml:"enabled"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		ArgoCD: ToolConfig{
+			Enabled: true,
+		},
+		Flux: ToolConfig{
+			Enabled: true,
+		},
+		Events:               []EventType{OutOfSyncEventType, DegradedEventType, ProgressingEventType, HealthyEventType},
+		MaxResources:         10,
+		InformerResyncPeriod: 30 * time.Minute,
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if !c.ArgoCD.Enabled && !c.Flux.Enabled {
+		return errors.New("at least one GitOps tool must be enabled")
+	}
+	if len(c.Events) == 0 {
+		return errors.New("at least one event type is required")
+	}
+	for _, event := range c.Events {
+		switch event {
+		case OutOfSyncEventType, DegradedEventType, ProgressingEventType, HealthyEventType:
+		default:
+			return fmt.Errorf("unknown event type %q", event)
+		}
+	}
+	if c.MaxResources <= 0 {
+		return fmt.Errorf("the max number of resources must be positive, got %d", c.MaxResources)
+	}
+	return nil
+}
+
+// IsEventEnabled returns true if a given event type should be reported.
+func (c Config) IsEventEnabled(eventType EventType) bool {
+	for _, event := range c.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+package gitops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	argoCDTool = "Argo CD"
+	fluxTool   = "Flux"
+)
+
+// kind describes a watched GitOps custom resource.
+type kind struct {
+	Tool     string
+	Kind     string
+	Group    string
+	Resource string
+	// Versions lists supported API versions in the preference order.
+	Versions []string
+	State    func(obj *unstructured.Unstructured) State
+	// SourceRefPath is the path to the Flux source reference used to find the commit message.
+	SourceRefPath []string
+}
+
+var (
+	argoCDApplication = kind{
+		Tool:     argoCDTool,
+		Kind:     "Application",
+		Group:    "argoproj.io",
+		Resource: "applications",
+		Versions: []string{"v1alpha1"},
+		State:    argoCDApplicationState,
+	}
+	fluxKustomization = kind{
+		Tool:          fluxTool,
+		Kind:          "Kustomization",
+		Group:         "kustomize.toolkit.fluxcd.io",
+		Resource:      "kustomizations",
+		Versions:      []string{"v1", "v1beta2"},
+		State:         fluxState,
+		SourceRefPath: []string{"spec", "sourceRef"},
+	}
+	fluxHelmRelease = kind{
+		Tool:     fluxTool,
+		Kind:     "HelmRelease",
+		Group:    "helm.toolkit.fluxcd.io",
+		Resource: "helmreleases",
+		Versions: []string{"v2", "v2beta2", "v2beta1"},
+		State:    fluxState,
+	}
+)
+
+// watchedKind is a kind resolved to the API version served by the cluster.
+type watchedKind struct {
+	kind
+	GVR schema.GroupVersionResource
+}
+
+// resolveKinds returns kinds of enabled tools which are served by the cluster.
+// Kinds without installed CRDs are skipped.
+func resolveKinds(log logrus.FieldLogger, cfg Config, discoveryCli discovery.DiscoveryInterface) ([]watchedKind, error) {
+	var kinds []kind
+	if cfg.ArgoCD.Enabled {
+		kinds = append(kinds, argoCDApplication)
+	}
+	if cfg.Flux.Enabled {
+		kinds = append(kinds, fluxKustomization, fluxHelmRelease)
+	}
+
+	var out []watchedKind
+	for _, k := range kinds {
+		gvr, found, err := servedVersion(discoveryCli, k)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			log.Infof("%s %s resources are not served by the cluster. Skipping...", k.Tool, k.Kind)
+			continue
+		}
+		out = append(out, watchedKind{kind: k, GVR: gvr})
+	}
+	return out, nil
+}
+
+func servedVersion(discoveryCli discovery.DiscoveryInterface, k kind) (schema.GroupVersionResource, bool, error) {
+	for _, version := range k.Versions {
+		gv := schema.GroupVersion{Group: k.Group, Version: version}
+		list, err := discoveryCli.ServerResourcesForGroupVersion(gv.String())
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return schema.GroupVersionResource{}, false, fmt.Errorf("while discovering %s resources: %w", gv.String(), err)
+		}
+		for _, res := range list.APIResources {
+			if res.Name == k.Resource {
+				return gv.WithResource(k.Resource), true, nil
+			}
+		}
+	}
+	return schema.GroupVersionResource{}, false, nil
+}
+
+// argoCDApplicationState returns the state of the Argo CD Application.
+// See https://argo-cd.readthedocs.io/en/stable/operator-manual/health/.
+func argoCDApplicationState(obj *unstructured.Unstructured) State {
+	state := State{
+		Sync:     SyncStatus(nestedString(obj.Object, "status", "sync", "status")),
+		Health:   HealthStatus(nestedString(obj.Object, "status", "health", "status")),
+		Revision: nestedString(obj.Object, "status", "sync", "revision"),
+	}
+	if state.Revision == "" {
+		revisions, _, _ := unstructured.NestedStringSlice(obj.Object, "status", "sync", "revisions")
+		state.Revision = strings.Join(revisions, ", ")
+	}
+
+	switch phase := nestedString(obj.Object, "status", "operationState", "phase"); phase {
+	case "Failed", "Error":
+		state.Message = nestedString(obj.Object, "status", "operationState", "message")
+	}
+	if state.Message == "" {
+		state.Message = nestedString(obj.Object, "status", "health", "message")
+	}
+
+	resources, _, _ := unstructured.NestedSlice(obj.Object, "status", "resources")
+	for _, item := range resources {
+		res, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		health := nestedString(res, "health", "status")
+		switch {
+		case health == string(DegradedHealthStatus) || health == "Missing":
+			state.Resources = append(state.Resources, resourceEntry(res, health, nestedString(res, "health", "message")))
+		case nestedString(res, "status") == string(OutOfSyncStatus):
+			state.Resources = append(state.Resources, resourceEntry(res, string(OutOfSyncStatus), ""))
+		}
+	}
+
+	syncResources, _, _ := unstructured.NestedSlice(obj.Object, "status", "operationState", "syncResult", "resources")
+	for _, item := range syncResources {
+		res, ok := item.(map[string]interface{})
+		if !ok || nestedString(res, "status") != "SyncFailed" {
+			continue
+		}
+		state.Resources = append(state.Resources, resourceEntry(res, "SyncFailed", nestedString(res, "message")))
+	}
+	return state
+}
+
+// fluxState returns the state of the Flux resource based on its Ready condition.
+// Flux doesn't report the sync status, so it's always empty.
+func fluxState(obj *unstructured.Unstructured) State {
+	state := State{
+		Revision: nestedString(obj.Object, "status", "lastAppliedRevision"),
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		cond, ok := item.(map[string]interface{})
+		if !ok || nestedString(cond, "type") != "Ready" {
+			continue
+		}
+		switch nestedString(cond, "status") {
+		case "True":
+			state.Health = HealthyHealthStatus
+		case "False":
+			state.Health = DegradedHealthStatus
+		case "Unknown":
+			state.Health = ProgressingHealthStatus
+		}
+		state.Message = nestedString(cond, "message")
+	}
+
+	if attempted := nestedString(obj.Object, "status", "lastAttemptedRevision"); attempted != "" && state.Health != HealthyHealthStatus {
+		state.Revision = attempted
+	}
+	return state
+}
+
+// resourceEntry returns a human-readable resource description, such as "Deployment default/web: Degraded (timeout)".
+func resourceEntry(res map[string]interface{}, status, message string) string {
+	name := nestedString(res, "name")
+	if ns := nestedString(res, "namespace"); ns != "" {
+		name = fmt.Sprintf("%s/%s", ns, name)
+	}
+	out := fmt.Sprintf("%s %s: %s", nestedString(res, "kind"), name, status)
+	if message != "" {
+		out = fmt.Sprintf("%s (%s)", out, message)
+	}
+	return out
+}
+
+func nestedString(obj map[string]interface{}, fields ...string) string {
+	out, _, _ := unstructured.NestedString(obj, fields...)
+	return out
+}
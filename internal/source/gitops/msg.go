@@ -0,0 +1,144 @@
+package gitops
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// SyncStatus is the Argo CD sync status.
+type SyncStatus string
+
+const (
+	// SyncedStatus is used when the live state matches the desired one.
+	SyncedStatus SyncStatus = "Synced"
+	// OutOfSyncStatus is used when the live state differs from the desired one.
+	OutOfSyncStatus SyncStatus = "OutOfSync"
+)
+
+// HealthStatus is the resource health status. Flux Ready conditions are mapped to the Argo CD health statuses.
+type HealthStatus string
+
+const (
+	// HealthyHealthStatus is used when the resource is healthy.
+	HealthyHealthStatus HealthStatus = "Healthy"
+	// ProgressingHealthStatus is used when the resource isn't healthy yet, but it's still making progress.
+	ProgressingHealthStatus HealthStatus = "Progressing"
+	// DegradedHealthStatus is used when the resource failed.
+	DegradedHealthStatus HealthStatus = "Degraded"
+)
+
+// State holds the GitOps resource state common for all supported tools.
+type State struct {
+	Sync     SyncStatus
+	Health   HealthStatus
+	Revision string
+	Message  string
+	// Resources holds failed or out of sync resources.
+	Resources []string
+}
+
+// EventType describes the reported status transition.
+type EventType string
+
+const (
+	// OutOfSyncEventType is used when the resource became out of sync.
+	OutOfSyncEventType EventType = "OutOfSync"
+	// DegradedEventType is used when the resource became degraded.
+	DegradedEventType EventType = "Degraded"
+	// ProgressingEventType is used when the resource started progressing.
+	ProgressingEventType EventType = "Progressing"
+	// HealthyEventType is used when the resource became healthy.
+	HealthyEventType EventType = "Healthy"
+)
+
+// StatusEvent is a raw object sent together with the GitOps status message.
+type StatusEvent struct {
+	Type          EventType    `json:"type"`
+	Tool          string       `json:"tool"`
+	Kind          string       `json:"kind"`
+	Name          string       `json:"name"`
+	Namespace     string       `json:"namespace"`
+	Sync          SyncStatus   `json:"sync,omitempty"`
+	Health        HealthStatus `json:"health,omitempty"`
+	Revision      string       `json:"revision,omitempty"`
+	CommitMessage string       `json:"commitMessage,omitempty"`
+	Message       string       `json:"message,omitempty"`
+	Resources     []string     `json:"resources,omitempty"`
+	Cluster       string       `json:"cluster,omitempty"`
+}
+
+func messageForStatus(e StatusEvent, maxResources int, now time.Time) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s %s %s %s/%s is %s", emojiForEvent(e.Type), e.Tool, e.Kind, e.Namespace, e.Name, e.Type),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, e.Kind, e.Name)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Sync status", string(e.Sync))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Health status", string(e.Health))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Revision", e.Revision)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	if e.CommitMessage != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Commit message",
+			Items: []string{e.CommitMessage},
+		})
+	}
+	if e.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Message",
+			Items: []string{e.Message},
+		})
+	}
+	if len(e.Resources) > 0 {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Resources",
+			Items: e.Resources[:min(len(e.Resources), maxResources)],
+		})
+	}
+	if len(e.Resources) > maxResources {
+		section.Context = api.ContextItems{
+			{Text: fmt.Sprintf("...and %d more resources", len(e.Resources)-maxResources)},
+		}
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func emojiForEvent(eventType EventType) string {
+	switch eventType {
+	case HealthyEventType:
+		return "✅"
+	case ProgressingEventType:
+		return "⏳"
+	case DegradedEventType:
+		return "❌"
+	default:
+		return "⚠️"
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
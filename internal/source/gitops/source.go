@@ -0,0 +1,254 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the GitOps Botkube plugin.
+	PluginName = "gitops"
+
+	description = "Get notifications about sync and health status changes of Argo CD Applications, and Flux Kustomizations and HelmReleases."
+)
+
+// Source GitOps source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams GitOps status events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+	dynamicCli, err := dynamic.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating dynamic K8s client: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	kinds, err := resolveKinds(log, cfg, k8sCli.Discovery())
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while resolving GitOps resources: %w", err)
+	}
+
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	w := newWatcher(log, cfg, input.Context.ClusterName, k8sCli, out.Event)
+	go s.watch(ctx, dynamicCli, kinds, w)
+
+	return out, nil
+}
+
+// Metadata returns metadata of GitOps configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) watch(ctx context.Context, dynamicCli dynamic.Interface, kinds []watchedKind, w *watcher) {
+	if len(kinds) == 0 {
+		w.log.Warn("None of the enabled GitOps tools is installed in the cluster. No events will be sent.")
+		return
+	}
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicCli, w.cfg.InformerResyncPeriod, metav1.NamespaceAll, nil)
+	for _, wk := range kinds {
+		k := wk.kind
+		factory.ForResource(wk.GVR).Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				w.OnAdd(k, obj)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				w.OnUpdate(ctx, k, oldObj, newObj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				w.OnDelete(k, obj)
+			},
+		})
+		w.log.Infof("Watching %s %s resources (%s)...", k.Tool, k.Kind, wk.GVR.String())
+	}
+
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "GitOps",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces of watched Argo CD Applications and Flux resources.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"argocd": {
+			  "title": "Argo CD",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If true, watches Argo CD Applications. It's skipped if Argo CD is not installed.",
+				  "type": "boolean",
+				  "default": true
+				}
+			  }
+			},
+			"flux": {
+			  "title": "Flux",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If true, watches Flux Kustomizations and HelmReleases. It's skipped if Flux is not installed.",
+				  "type": "boolean",
+				  "default": true
+				}
+			  }
+			},
+			"events": {
+			  "title": "Events",
+			  "description": "Status transitions which are reported.",
+			  "type": "array",
+			  "default": ["OutOfSync", "Degraded", "Progressing", "Healthy"],
+			  "uniqueItems": true,
+			  "items": {
+				"type": "string",
+				"oneOf": [
+				  {
+					"const": "OutOfSync",
+					"title": "Out of sync"
+				  },
+				  {
+					"const": "Degraded",
+					"title": "Degraded"
+				  },
+				  {
+					"const": "Progressing",
+					"title": "Progressing"
+				  },
+				  {
+					"const": "Healthy",
+					"title": "Healthy"
+				  }
+				]
+			  }
+			},
+			"maxResources": {
+			  "title": "Max resources",
+			  "description": "Maximum number of failed resources listed in a single message.",
+			  "type": "integer",
+			  "default": 10,
+			  "minimum": 1
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
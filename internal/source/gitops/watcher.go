@@ -0,0 +1,258 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	gitRepositoryKind      = "GitRepository"
+	newArtifactReason      = "NewArtifact"
+	fluxRevisionAnnotation = "source.toolkit.fluxcd.io/revision"
+)
+
+// commitMessageRegex matches the message of the event which Flux emits when it fetches a new commit.
+var commitMessageRegex = regexp.MustCompile(`(?s)^stored artifact for commit '(.*)'$`)
+
+// reported describes the last status transition reported for a given resource.
+type reported struct {
+	Type     EventType
+	Revision string
+}
+
+// watcher reports status transitions of GitOps resources.
+type watcher struct {
+	log     logrus.FieldLogger
+	cfg     Config
+	cluster string
+	k8sCli  kubernetes.Interface
+	eventCh chan<- source.Event
+
+	mu sync.Mutex
+	// reported holds the last reported transition, indexed by the resource key.
+	reported map[string]reported
+}
+
+func newWatcher(log logrus.FieldLogger, cfg Config, cluster string, k8sCli kubernetes.Interface, eventCh chan<- source.Event) *watcher {
+	return &watcher{
+		log:      log,
+		cfg:      cfg,
+		cluster:  cluster,
+		k8sCli:   k8sCli,
+		eventCh:  eventCh,
+		reported: map[string]reported{},
+	}
+}
+
+// OnAdd records the initial state of the resource, so it's not reported again after the watcher starts.
+func (w *watcher) OnAdd(k kind, obj interface{}) {
+	u, ok := w.object(obj)
+	if !ok {
+		return
+	}
+	state := k.State(u)
+	if eventType, ok := eventTypeForState(state); ok {
+		w.shouldReport(resourceKey(k, u), eventType, state.Revision)
+	}
+}
+
+// OnUpdate reports the status transition of the resource.
+func (w *watcher) OnUpdate(ctx context.Context, k kind, oldObj, newObj interface{}) {
+	oldU, ok := w.object(oldObj)
+	if !ok {
+		return
+	}
+	newU, ok := w.object(newObj)
+	if !ok {
+		return
+	}
+
+	state := k.State(newU)
+	eventType, ok := transition(k.State(oldU), state)
+	if !ok || !w.shouldReport(resourceKey(k, newU), eventType, state.Revision) || !w.cfg.IsEventEnabled(eventType) {
+		return
+	}
+
+	event := StatusEvent{
+		Type:      eventType,
+		Tool:      k.Tool,
+		Kind:      k.Kind,
+		Name:      newU.GetName(),
+		Namespace: newU.GetNamespace(),
+		Sync:      state.Sync,
+		Health:    state.Health,
+		Revision:  state.Revision,
+		Message:   state.Message,
+		Resources: state.Resources,
+		Cluster:   w.cluster,
+	}
+	if len(k.SourceRefPath) > 0 {
+		msg, err := w.commitMessage(ctx, k, newU, state.Revision)
+		if err != nil {
+			w.log.Warnf("while getting commit message for %s %s/%s: %s", k.Kind, event.Namespace, event.Name, err.Error())
+		}
+		event.CommitMessage = msg
+	}
+
+	select {
+	case w.eventCh <- source.Event{
+		Message:   messageForStatus(event, w.cfg.MaxResources, time.Now()),
+		RawObject: event,
+	}:
+	case <-ctx.Done():
+	}
+}
+
+// OnDelete forgets the resource.
+func (w *watcher) OnDelete(k kind, obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.reported, resourceKey(k, u))
+}
+
+// shouldReport returns true if the transition wasn't reported yet for a given revision, and records it.
+// Progressing is reported only for a new revision or after the resource became out of sync,
+// as periodic Flux reconciliations and retries go through it without any change.
+func (w *watcher) shouldReport(key string, eventType EventType, revision string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	last, found := w.reported[key]
+	if found && last.Revision == revision {
+		if last.Type == eventType || (eventType == ProgressingEventType && last.Type != OutOfSyncEventType) {
+			return false
+		}
+	}
+	w.reported[key] = reported{Type: eventType, Revision: revision}
+	return true
+}
+
+// commitMessage returns the message of the commit fetched by the Flux GitRepository source.
+// Flux doesn't store it in the resource status, so it's taken from the event emitted for the new artifact.
+func (w *watcher) commitMessage(ctx context.Context, k kind, obj *unstructured.Unstructured, revision string) (string, error) {
+	ref, found, err := unstructured.NestedStringMap(obj.Object, k.SourceRefPath...)
+	if err != nil || !found || ref["kind"] != gitRepositoryKind {
+		return "", err
+	}
+	ns := ref["namespace"]
+	if ns == "" {
+		ns = obj.GetNamespace()
+	}
+
+	events, err := w.k8sCli.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": gitRepositoryKind,
+			"involvedObject.name": ref["name"],
+			"reason":              newArtifactReason,
+		}.String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("while listing events: %w", err)
+	}
+
+	var latest *corev1.Event
+	for i := range events.Items {
+		event := &events.Items[i]
+		if event.InvolvedObject.Kind != gitRepositoryKind || event.InvolvedObject.Name != ref["name"] || event.Reason != newArtifactReason {
+			continue
+		}
+		if rev, ok := event.Annotations[fluxRevisionAnnotation]; ok && rev != revision {
+			continue
+		}
+		if latest == nil || eventTime(event).After(eventTime(latest)) {
+			latest = event
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+
+	match := commitMessageRegex.FindStringSubmatch(latest.Message)
+	if len(match) != 2 {
+		return "", nil
+	}
+	return match[1], nil
+}
+
+func (w *watcher) object(obj interface{}) (*unstructured.Unstructured, bool) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+
+	allowed, err := w.cfg.Namespaces.IsAllowed(u.GetNamespace())
+	if err != nil {
+		w.log.Errorf("while matching namespace: %s", err.Error())
+		return nil, false
+	}
+	return u, allowed
+}
+
+// transition returns the reported event type if the health or sync status changed.
+func transition(oldState, newState State) (EventType, bool) {
+	if newState.Health != oldState.Health {
+		switch newState.Health {
+		case DegradedHealthStatus:
+			return DegradedEventType, true
+		case ProgressingHealthStatus:
+			return ProgressingEventType, true
+		case HealthyHealthStatus:
+			return HealthyEventType, true
+		}
+	}
+	if newState.Sync != oldState.Sync && newState.Sync == OutOfSyncStatus {
+		return OutOfSyncEventType, true
+	}
+	return "", false
+}
+
+// eventTypeForState returns the event type describing the current state.
+func eventTypeForState(state State) (EventType, bool) {
+	switch {
+	case state.Health == DegradedHealthStatus:
+		return DegradedEventType, true
+	case state.Sync == OutOfSyncStatus:
+		return OutOfSyncEventType, true
+	case state.Health == ProgressingHealthStatus:
+		return ProgressingEventType, true
+	case state.Health == HealthyHealthStatus:
+		return HealthyEventType, true
+	}
+	return "", false
+}
+
+func resourceKey(k kind, obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", k.Kind, obj.GetNamespace(), obj.GetName())
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
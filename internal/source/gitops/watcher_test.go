@@ -0,0 +1,219 @@
+package gitops
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var (
+	argoCDApplicationGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "applications"}
+	fluxKustomizationGVR = schema.GroupVersionResource{Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Resource: "kustomizations"}
+)
+
+func TestSourceWatch(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dynamicCli := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		argoCDApplicationGVR: "ApplicationList",
+		fluxKustomizationGVR: "KustomizationList",
+	},
+		fixObject(t, "argocd-application-healthy.yaml"),
+		fixObject(t, "flux-kustomization-ready.yaml"),
+	)
+	k8sCli := fake.NewSimpleClientset(
+		fixNewArtifactEvent("main@sha1:4f1c2a9d0e7b3c5a8f6d2e1b9c0a7d3e5f8b1c2a", "Initial commit", time.Minute),
+		fixNewArtifactEvent("main@sha1:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b", "Bump web to 1.2.0", 2*time.Minute),
+	)
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+
+	ch := make(chan source.Event)
+	w := newWatcher(loggerx.NewNoop(), cfg, "prod", k8sCli, ch)
+	go (&Source{}).watch(ctx, dynamicCli, []watchedKind{
+		{kind: argoCDApplication, GVR: argoCDApplicationGVR},
+		{kind: fluxKustomization, GVR: fluxKustomizationGVR},
+	}, w)
+
+	assert.Eventually(t, func() bool {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return len(w.reported) == 2
+	}, 5*time.Second, 10*time.Millisecond, "initial state wasn't recorded")
+
+	// when
+	fixUpdate(ctx, t, dynamicCli, argoCDApplicationGVR, "argocd-application-out-of-sync.yaml")
+
+	// then
+	assert.Equal(t, StatusEvent{
+		Type:      OutOfSyncEventType,
+		Tool:      "Argo CD",
+		Kind:      "Application",
+		Name:      "web",
+		Namespace: "argocd",
+		Sync:      OutOfSyncStatus,
+		Health:    HealthyHealthStatus,
+		Revision:  "9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b",
+		Resources: []string{"Deployment web/web: OutOfSync"},
+		Cluster:   "prod",
+	}, receiveEvent(t, ch))
+
+	// when periodic reconciliation of the same revision is followed by a failed one
+	fixUpdate(ctx, t, dynamicCli, fluxKustomizationGVR, "flux-kustomization-progressing.yaml")
+	fixUpdate(ctx, t, dynamicCli, fluxKustomizationGVR, "flux-kustomization-ready.yaml")
+	fixUpdate(ctx, t, dynamicCli, fluxKustomizationGVR, "flux-kustomization-failed.yaml")
+
+	// then
+	assert.Equal(t, StatusEvent{
+		Type:          DegradedEventType,
+		Tool:          "Flux",
+		Kind:          "Kustomization",
+		Name:          "apps",
+		Namespace:     "flux-system",
+		Health:        DegradedHealthStatus,
+		Revision:      "main@sha1:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b",
+		CommitMessage: "Bump web to 1.2.0",
+		Message:       "health check failed after 5m0s: timeout waiting for: [Deployment/apps/web status: 'InProgress']",
+		Cluster:       "prod",
+	}, receiveEvent(t, ch))
+
+	// when
+	fixUpdate(ctx, t, dynamicCli, argoCDApplicationGVR, "argocd-application-degraded.yaml")
+
+	// then
+	assert.Equal(t, StatusEvent{
+		Type:      DegradedEventType,
+		Tool:      "Argo CD",
+		Kind:      "Application",
+		Name:      "web",
+		Namespace: "argocd",
+		Sync:      SyncedStatus,
+		Health:    DegradedHealthStatus,
+		Revision:  "9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b",
+		Message:   "one or more objects failed to apply, reason: admission webhook denied the request",
+		Resources: []string{
+			`Deployment web/web: Degraded (Deployment "web" exceeded its progress deadline)`,
+			"ConfigMap web/web-config: SyncFailed (admission webhook denied the request)",
+		},
+		Cluster: "prod",
+	}, receiveEvent(t, ch))
+}
+
+func TestShouldReport(t *testing.T) {
+	// given
+	w := newWatcher(loggerx.NewNoop(), Config{}, "prod", nil, nil)
+	steps := []struct {
+		eventType EventType
+		revision  string
+		expected  bool
+	}{
+		{eventType: HealthyEventType, revision: "a", expected: true},
+		{eventType: ProgressingEventType, revision: "a", expected: false},
+		{eventType: HealthyEventType, revision: "a", expected: false},
+		{eventType: OutOfSyncEventType, revision: "b", expected: true},
+		{eventType: ProgressingEventType, revision: "b", expected: true},
+		{eventType: DegradedEventType, revision: "b", expected: true},
+		{eventType: ProgressingEventType, revision: "b", expected: false},
+		{eventType: DegradedEventType, revision: "b", expected: false},
+		{eventType: HealthyEventType, revision: "b", expected: true},
+		{eventType: ProgressingEventType, revision: "c", expected: true},
+	}
+
+	for _, step := range steps {
+		// when
+		actual := w.shouldReport("Application/argocd/web", step.eventType, step.revision)
+
+		// then
+		assert.Equal(t, step.expected, actual, "%s for revision %q", step.eventType, step.revision)
+	}
+}
+
+func TestResolveKinds(t *testing.T) {
+	// given
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+
+	discoveryCli := &fakediscovery.FakeDiscovery{Fake: &fake.NewSimpleClientset().Fake}
+	discoveryCli.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "argoproj.io/v1alpha1", APIResources: []metav1.APIResource{{Name: "applications"}, {Name: "appprojects"}}},
+		{GroupVersion: "kustomize.toolkit.fluxcd.io/v1beta2", APIResources: []metav1.APIResource{{Name: "kustomizations"}}},
+	}
+
+	// when
+	kinds, err := resolveKinds(loggerx.NewNoop(), cfg, discoveryCli)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, kinds, 2)
+	assert.Equal(t, argoCDApplicationGVR, kinds[0].GVR)
+	assert.Equal(t, fluxKustomizationGVR.GroupResource().WithVersion("v1beta2"), kinds[1].GVR)
+}
+
+func receiveEvent(t *testing.T, ch <-chan source.Event) StatusEvent {
+	t.Helper()
+
+	select {
+	case event := <-ch:
+		return event.RawObject.(StatusEvent)
+	case <-time.After(5 * time.Second):
+		t.Fatal("status event wasn't sent")
+		return StatusEvent{}
+	}
+}
+
+func fixObject(t *testing.T, file string) *unstructured.Unstructured {
+	t.Helper()
+
+	raw, err := os.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal(raw, &obj.Object))
+	return obj
+}
+
+func fixUpdate(ctx context.Context, t *testing.T, dynamicCli *dynamicfake.FakeDynamicClient, gvr schema.GroupVersionResource, file string) {
+	t.Helper()
+
+	obj := fixObject(t, file)
+	_, err := dynamicCli.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func fixNewArtifactEvent(revision, commitMessage string, createdAfter time.Duration) *corev1.Event {
+	createdAt := metav1.NewTime(time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC).Add(createdAfter))
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "flux-system." + revision[len(revision)-8:],
+			Namespace:         "flux-system",
+			CreationTimestamp: createdAt,
+			Annotations: map[string]string{
+				fluxRevisionAnnotation: revision,
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "GitRepository", Name: "flux-system", Namespace: "flux-system"},
+		Reason:         "NewArtifact",
+		Message:        "stored artifact for commit '" + commitMessage + "'",
+		LastTimestamp:  createdAt,
+	}
+}
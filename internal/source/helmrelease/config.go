@@ -0,0 +1,65 @@
+package helmrelease
+
+import (
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	secretDriver    = "secret"
+	configMapDriver = "configmap"
+)
+
+// Config holds Helm release source configuration.
+type Config struct {
+	Log config.Logger `yaml:"log"`
+	// HelmDriver is the Helm release storage driver. It should be the same as in the Helm executor.
+	HelmDriver string                     `yaml:"helmDriver"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// PendingTimeout is the time after which a release in the pending state is reported as an error.
+	PendingTimeout time.Duration `yaml:"pendingTimeout"`
+	// IncludeNotes adds the rendered chart notes to the message.
+	IncludeNotes         bool          `yaml:"includeNotes"`
+	InformerResyncPeriod time.Duration `yaml:"informerResyncPeriod"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		HelmDriver: secretDriver,
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		PendingTimeout:       5 * time.Minute,
+		IncludeNotes:         true,
+		InformerResyncPeriod: 30 * time.Minute,
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	switch c.HelmDriver {
+	case secretDriver, configMapDriver:
+	default:
+		return fmt.Errorf("unsupported Helm driver %q, allowed values: %q, %q", c.HelmDriver, secretDriver, configMapDriver)
+	}
+	if c.PendingTimeout <= 0 {
+		return fmt.Errorf("the pending timeout must be positive, got %s", c.PendingTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package helmrelease
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const (
+	helmPluginName = "helm"
+	maxNotesLength = 2000
+)
+
+// Operation describes what happened with the release.
+type Operation string
+
+const (
+	// InstalledOperation is used when the first revision of a release is deployed.
+	InstalledOperation Operation = "installed"
+	// UpgradedOperation is used when a new revision of a release is deployed.
+	UpgradedOperation Operation = "upgraded"
+	// RolledBackOperation is used when a release is rolled back to a previous revision.
+	RolledBackOperation Operation = "rolled back"
+	// UninstalledOperation is used when a release is uninstalled.
+	UninstalledOperation Operation = "uninstalled"
+	// FailedOperation is used when a release operation failed.
+	FailedOperation Operation = "failed"
+	// PendingOperation is used when a release is in the pending state for longer than the configured timeout.
+	PendingOperation Operation = "pending"
+)
+
+// ReleaseEvent is a raw object sent together with the Helm release message.
+type ReleaseEvent struct {
+	Operation    Operation `json:"operation"`
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Revision     int       `json:"revision"`
+	Status       Status    `json:"status"`
+	Chart        string    `json:"chart,omitempty"`
+	ChartVersion string    `json:"chartVersion,omitempty"`
+	AppVersion   string    `json:"appVersion,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Notes        string    `json:"notes,omitempty"`
+	TriggeredBy  string    `json:"triggeredBy,omitempty"`
+	Cluster      string    `json:"cluster,omitempty"`
+
+	// LastDeployedRevision is the last successfully deployed revision of the release, if known.
+	LastDeployedRevision int `json:"lastDeployedRevision,omitempty"`
+}
+
+// IsError returns true if the release needs attention.
+func (e ReleaseEvent) IsError() bool {
+	return e.Operation == FailedOperation || e.Operation == PendingOperation
+}
+
+func newReleaseEvent(op Operation, rel *storedRelease, cluster string) ReleaseEvent {
+	return ReleaseEvent{
+		Operation:    op,
+		Name:         rel.Name,
+		Namespace:    rel.Namespace,
+		Revision:     rel.Version,
+		Status:       rel.Info.Status,
+		Chart:        rel.Chart.Metadata.Name,
+		ChartVersion: rel.Chart.Metadata.Version,
+		AppVersion:   rel.Chart.Metadata.AppVersion,
+		Description:  rel.Info.Description,
+		Notes:        rel.Info.Notes,
+		TriggeredBy:  rel.Manager,
+		Cluster:      cluster,
+	}
+}
+
+func messageForRelease(e ReleaseEvent, includeNotes, isInteractivitySupported bool, now time.Time) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s Helm release %s/%s %s", emojiForOperation(e.Operation), e.Namespace, e.Name, titleForOperation(e)),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Release", e.Name)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Chart", chartRef(e))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "App version", e.AppVersion)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Revision", strconv.Itoa(e.Revision))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Status", string(e.Status))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Triggered by", e.TriggeredBy)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	if e.Description != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Description",
+			Items: []string{e.Description},
+		})
+	}
+	if includeNotes && e.Notes != "" {
+		section.Body.CodeBlock = truncate(e.Notes, maxNotesLength)
+	}
+
+	msg := api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+	if !e.IsError() || !isInteractivitySupported {
+		return msg
+	}
+
+	msg.Type = api.DefaultMessage
+	msg.Sections = append(msg.Sections, actionsSection(e))
+	return msg
+}
+
+// actionsSection adds buttons which run commands through the Helm executor.
+func actionsSection(e ReleaseEvent) api.Section {
+	btnBuilder := api.NewMessageButtonBuilder()
+	buttons := api.Buttons{
+		btnBuilder.ForCommandWithDescCmd("History", fmt.Sprintf("%s history %s -n %s", helmPluginName, e.Name, e.Namespace)),
+	}
+	if e.LastDeployedRevision > 0 && e.LastDeployedRevision != e.Revision {
+		rollbackCmd := fmt.Sprintf("%s rollback %s %d -n %s", helmPluginName, e.Name, e.LastDeployedRevision, e.Namespace)
+		buttons = append(buttons, btnBuilder.ForCommandWithDescCmd(fmt.Sprintf("Rollback to %d", e.LastDeployedRevision), rollbackCmd, api.ButtonStyleDanger))
+	}
+	return api.Section{
+		Buttons: buttons,
+	}
+}
+
+func emojiForOperation(op Operation) string {
+	switch op {
+	case InstalledOperation, UpgradedOperation:
+		return "✅"
+	case RolledBackOperation:
+		return "↩️"
+	case UninstalledOperation:
+		return "🗑️"
+	case FailedOperation:
+		return "❌"
+	default:
+		return "❗"
+	}
+}
+
+func titleForOperation(e ReleaseEvent) string {
+	if e.Operation == PendingOperation {
+		return fmt.Sprintf("stuck in %s", e.Status)
+	}
+	return string(e.Operation)
+}
+
+func chartRef(e ReleaseEvent) string {
+	if e.ChartVersion == "" {
+		return e.Chart
+	}
+	return fmt.Sprintf("%s-%s", e.Chart, e.ChartVersion)
+}
+
+func truncate(in string, maxLen int) string {
+	runes := []rune(in)
+	if len(runes) <= maxLen {
+		return in
+	}
+	return string(runes[:maxLen]) + "…"
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
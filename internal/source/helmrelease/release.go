@@ -0,0 +1,159 @@
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status is the Helm release status.
+type Status string
+
+// Helm release statuses, see https://github.com/helm/helm/blob/v3.6.3/pkg/release/status.go.
+const (
+	StatusUnknown         Status = "unknown"
+	StatusDeployed        Status = "deployed"
+	StatusUninstalled     Status = "uninstalled"
+	StatusSuperseded      Status = "superseded"
+	StatusFailed          Status = "failed"
+	StatusUninstalling    Status = "uninstalling"
+	StatusPendingInstall  Status = "pending-install"
+	StatusPendingUpgrade  Status = "pending-upgrade"
+	StatusPendingRollback Status = "pending-rollback"
+)
+
+// IsPending returns true if the release operation is in progress.
+func (s Status) IsPending() bool {
+	switch s {
+	case StatusPendingInstall, StatusPendingUpgrade, StatusPendingRollback:
+		return true
+	}
+	return false
+}
+
+const releaseKey = "release"
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// release holds the subset of the Helm release stored by the Helm storage drivers.
+type release struct {
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace"`
+	Version   int         `json:"version"`
+	Info      releaseInfo `json:"info"`
+	Chart     chart       `json:"chart"`
+}
+
+type releaseInfo struct {
+	Description string `json:"description"`
+	Status      Status `json:"status"`
+	Notes       string `json:"notes"`
+}
+
+type chart struct {
+	Metadata chartMetadata `json:"metadata"`
+}
+
+type chartMetadata struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	AppVersion string `json:"appVersion"`
+}
+
+// storedRelease is a decoded release together with the metadata of the Secret or ConfigMap it's stored in.
+type storedRelease struct {
+	release
+	StorageNamespace string
+	StorageKey       string
+	ResourceVersion  string
+	CreatedAt        metav1.Time
+	Manager          string
+}
+
+func storedReleaseFromObject(obj interface{}) (*storedRelease, error) {
+	var (
+		meta metav1.ObjectMeta
+		data string
+	)
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		meta, data = o.ObjectMeta, string(o.Data[releaseKey])
+	case *corev1.ConfigMap:
+		meta, data = o.ObjectMeta, o.Data[releaseKey]
+	default:
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	rel, err := decodeRelease(data)
+	if err != nil {
+		return nil, fmt.Errorf("while decoding release %s/%s: %w", meta.Namespace, meta.Name, err)
+	}
+	if rel.Namespace == "" {
+		rel.Namespace = meta.Namespace
+	}
+
+	return &storedRelease{
+		release:          rel,
+		StorageNamespace: meta.Namespace,
+		StorageKey:       fmt.Sprintf("%s/%s", meta.Namespace, meta.Name),
+		ResourceVersion:  meta.ResourceVersion,
+		CreatedAt:        meta.CreationTimestamp,
+		Manager:          lastManager(meta.ManagedFields),
+	}, nil
+}
+
+// decodeRelease decodes the release in the same way as the Helm storage drivers do: base64, optionally gzipped, JSON.
+func decodeRelease(data string) (release, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return release{}, fmt.Errorf("while decoding base64: %w", err)
+	}
+
+	if bytes.HasPrefix(raw, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return release{}, fmt.Errorf("while creating gzip reader: %w", err)
+		}
+		defer reader.Close()
+		raw, err = io.ReadAll(reader)
+		if err != nil {
+			return release{}, fmt.Errorf("while decompressing: %w", err)
+		}
+	}
+
+	var out release
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return release{}, fmt.Errorf("while unmarshaling JSON: %w", err)
+	}
+	return out, nil
+}
+
+// lastManager returns the name of the client which modified the object most recently, such as helm or helm-controller.
+// Helm doesn't store the user who triggered the operation, so this is the best available hint.
+func lastManager(entries []metav1.ManagedFieldsEntry) string {
+	var (
+		out    string
+		latest metav1.Time
+	)
+	for _, entry := range entries {
+		if entry.Time == nil || entry.Manager == "" {
+			continue
+		}
+		if out == "" || entry.Time.After(latest.Time) {
+			out, latest = entry.Manager, *entry.Time
+		}
+	}
+	return out
+}
+
+// isRollback returns true if the release was created by the rollback operation.
+func (r release) isRollback() bool {
+	return strings.HasPrefix(r.Info.Description, "Rollback to")
+}
@@ -0,0 +1,217 @@
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the Helm release Botkube plugin.
+	PluginName = "helm-release"
+
+	description = "Get notifications when Helm releases are installed, upgraded, rolled back, uninstalled or fail."
+
+	helmStorageLabelSelector = "owner=helm"
+)
+
+// Source Helm release source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams Helm release events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	w := newWatcher(loggerx.New(cfg.Log), cfg, input.Context.ClusterName, input.Context.IsInteractivitySupported, out.Event)
+	go s.watch(ctx, k8sCli, w)
+
+	return out, nil
+}
+
+// Metadata returns metadata of Helm release configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) watch(ctx context.Context, k8sCli kubernetes.Interface, w *watcher) {
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sCli, w.cfg.InformerResyncPeriod, informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+		opts.LabelSelector = helmStorageLabelSelector
+	}))
+
+	var informer cache.SharedIndexInformer
+	switch w.cfg.HelmDriver {
+	case configMapDriver:
+		informer = factory.Core().V1().ConfigMaps().Informer()
+	default:
+		informer = factory.Core().V1().Secrets().Informer()
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.OnAdd(ctx, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.OnUpdate(ctx, oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			w.OnDelete(ctx, obj)
+		},
+	})
+
+	w.log.Infof("Starting Helm release informer for the %q driver...", w.cfg.HelmDriver)
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Helm release",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"helmDriver": {
+			  "title": "Helm driver",
+			  "description": "Helm release storage driver. It should be the same as in the Helm executor.",
+			  "type": "string",
+			  "default": "secret",
+			  "oneOf": [
+				{
+				  "const": "secret",
+				  "title": "Secret"
+				},
+				{
+				  "const": "configmap",
+				  "title": "ConfigMap"
+				}
+			  ]
+			},
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces of watched Helm releases.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"pendingTimeout": {
+			  "title": "Pending timeout",
+			  "description": "Time after which a release in the pending state is reported as an error.",
+			  "type": "string",
+			  "default": "5m"
+			},
+			"includeNotes": {
+			  "title": "Include notes",
+			  "description": "If true, adds the rendered chart notes to the message.",
+			  "type": "boolean",
+			  "default": true
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
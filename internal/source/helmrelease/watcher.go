@@ -0,0 +1,221 @@
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// watcher detects Helm release lifecycle changes based on the Helm release storage objects.
+//
+// Helm creates a new storage object for every revision and updates its status when the operation finishes.
+// Previous revisions are marked as superseded, and uninstall marks the latest revision as uninstalling
+// before the whole history is deleted, unless it's kept.
+type watcher struct {
+	log                      logrus.FieldLogger
+	cfg                      Config
+	cluster                  string
+	isInteractivitySupported bool
+	startedAt                time.Time
+	eventCh                  chan<- source.Event
+
+	mu sync.Mutex
+	// pending holds timers for releases which are in the pending state, indexed by the storage key.
+	pending map[string]*time.Timer
+	// deployed holds the last deployed revision of a given release, indexed by the release namespace and name.
+	// It's used as the rollback target, as failed or pending revisions don't replace the deployed one.
+	deployed map[string]int
+}
+
+func newWatcher(log logrus.FieldLogger, cfg Config, cluster string, isInteractivitySupported bool, eventCh chan<- source.Event) *watcher {
+	return &watcher{
+		log:                      log,
+		cfg:                      cfg,
+		cluster:                  cluster,
+		isInteractivitySupported: isInteractivitySupported,
+		startedAt:                time.Now(),
+		eventCh:                  eventCh,
+		pending:                  map[string]*time.Timer{},
+		deployed:                 map[string]int{},
+	}
+}
+
+// OnAdd handles a new release revision. Revisions created before the watcher started are only used to track the deployed revision.
+func (w *watcher) OnAdd(ctx context.Context, obj interface{}) {
+	rel, ok := w.decode(obj)
+	if !ok {
+		return
+	}
+	w.trackDeployed(rel)
+	if rel.CreatedAt.Time.Before(w.startedAt) {
+		return
+	}
+	w.process(ctx, rel)
+}
+
+// OnUpdate handles status changes of a release revision.
+func (w *watcher) OnUpdate(ctx context.Context, oldObj, newObj interface{}) {
+	oldRel, ok := w.decode(oldObj)
+	if !ok {
+		return
+	}
+	newRel, ok := w.decode(newObj)
+	if !ok {
+		return
+	}
+	w.trackDeployed(newRel)
+	if oldRel.ResourceVersion == newRel.ResourceVersion || oldRel.Info.Status == newRel.Info.Status {
+		return
+	}
+	w.process(ctx, newRel)
+}
+
+// OnDelete handles removal of a release revision. Only the revision which was being uninstalled is reported,
+// so pruning the release history doesn't trigger notifications.
+func (w *watcher) OnDelete(ctx context.Context, obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	rel, ok := w.decode(obj)
+	if !ok {
+		return
+	}
+
+	w.stopPending(rel.StorageKey)
+	if rel.Info.Status != StatusUninstalling {
+		return
+	}
+	w.forgetDeployed(rel)
+	event := newReleaseEvent(UninstalledOperation, rel, w.cluster)
+	event.Status = StatusUninstalled
+	w.send(ctx, event)
+}
+
+func (w *watcher) process(ctx context.Context, rel *storedRelease) {
+	w.stopPending(rel.StorageKey)
+
+	var op Operation
+	switch status := rel.Info.Status; {
+	case status.IsPending():
+		w.schedulePending(ctx, rel)
+		return
+	case status == StatusDeployed && rel.isRollback():
+		op = RolledBackOperation
+	case status == StatusDeployed && rel.Version == 1:
+		op = InstalledOperation
+	case status == StatusDeployed:
+		op = UpgradedOperation
+	case status == StatusFailed:
+		op = FailedOperation
+	case status == StatusUninstalled:
+		op = UninstalledOperation
+	default:
+		// superseded and uninstalling states are intermediate ones
+		return
+	}
+	w.send(ctx, w.newReleaseEvent(op, rel))
+}
+
+// schedulePending reports the release as pending if its status doesn't change within the configured timeout.
+func (w *watcher) schedulePending(ctx context.Context, rel *storedRelease) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var timer *time.Timer
+	timer = time.AfterFunc(w.cfg.PendingTimeout, func() {
+		w.mu.Lock()
+		if w.pending[rel.StorageKey] != timer {
+			w.mu.Unlock()
+			return
+		}
+		delete(w.pending, rel.StorageKey)
+		w.mu.Unlock()
+
+		w.send(ctx, w.newReleaseEvent(PendingOperation, rel))
+	})
+	w.pending[rel.StorageKey] = timer
+}
+
+// trackDeployed remembers the revision if it's the latest deployed one. Uninstalled releases are forgotten.
+func (w *watcher) trackDeployed(rel *storedRelease) {
+	switch rel.Info.Status {
+	case StatusDeployed:
+	case StatusUninstalling, StatusUninstalled:
+		w.forgetDeployed(rel)
+		return
+	default:
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := releaseKeyFor(rel)
+	if rel.Version > w.deployed[key] {
+		w.deployed[key] = rel.Version
+	}
+}
+
+func (w *watcher) forgetDeployed(rel *storedRelease) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.deployed, releaseKeyFor(rel))
+}
+
+// newReleaseEvent returns the release event with the last deployed revision, if it's different from the given one.
+func (w *watcher) newReleaseEvent(op Operation, rel *storedRelease) ReleaseEvent {
+	event := newReleaseEvent(op, rel, w.cluster)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if deployed := w.deployed[releaseKeyFor(rel)]; deployed != rel.Version {
+		event.LastDeployedRevision = deployed
+	}
+	return event
+}
+
+func releaseKeyFor(rel *storedRelease) string {
+	return fmt.Sprintf("%s/%s", rel.Namespace, rel.Name)
+}
+
+func (w *watcher) stopPending(key string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if timer, found := w.pending[key]; found {
+		timer.Stop()
+		delete(w.pending, key)
+	}
+}
+
+func (w *watcher) decode(obj interface{}) (*storedRelease, bool) {
+	rel, err := storedReleaseFromObject(obj)
+	if err != nil {
+		w.log.Warnf("while reading Helm release: %s", err.Error())
+		return nil, false
+	}
+
+	allowed, err := w.cfg.Namespaces.IsAllowed(rel.StorageNamespace)
+	if err != nil {
+		w.log.Errorf("while matching namespace: %s", err.Error())
+		return nil, false
+	}
+	return rel, allowed
+}
+
+func (w *watcher) send(ctx context.Context, event ReleaseEvent) {
+	select {
+	case w.eventCh <- source.Event{
+		Message:   messageForRelease(event, w.cfg.IncludeNotes, w.isInteractivitySupported, time.Now()),
+		RawObject: event,
+	}:
+	case <-ctx.Done():
+	}
+}
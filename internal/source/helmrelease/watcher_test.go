@@ -0,0 +1,336 @@
+package helmrelease
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var fixStartedAt = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestWatcher(t *testing.T) {
+	type step struct {
+		action string
+		old    interface{}
+		obj    interface{}
+	}
+	tests := []struct {
+		name     string
+		steps    []step
+		expected []Operation
+	}{
+		{
+			name: "Install",
+			steps: []step{
+				{action: "add", obj: fixSecret(t, 1, StatusPendingInstall, "Initial install underway", "1")},
+				{action: "update", old: fixSecret(t, 1, StatusPendingInstall, "Initial install underway", "1"), obj: fixSecret(t, 1, StatusDeployed, "Install complete", "2")},
+			},
+			expected: []Operation{InstalledOperation},
+		},
+		{
+			name: "Upgrade",
+			steps: []step{
+				{action: "add", obj: fixSecret(t, 2, StatusPendingUpgrade, "Preparing upgrade", "1")},
+				{action: "update", old: fixSecret(t, 1, StatusDeployed, "Install complete", "1"), obj: fixSecret(t, 1, StatusSuperseded, "Install complete", "2")},
+				{action: "update", old: fixSecret(t, 2, StatusPendingUpgrade, "Preparing upgrade", "1"), obj: fixSecret(t, 2, StatusDeployed, "Upgrade complete", "2")},
+			},
+			expected: []Operation{UpgradedOperation},
+		},
+		{
+			name: "Rollback",
+			steps: []step{
+				{action: "add", obj: fixSecret(t, 3, StatusDeployed, "Rollback to 1", "1")},
+			},
+			expected: []Operation{RolledBackOperation},
+		},
+		{
+			name: "Failed upgrade",
+			steps: []step{
+				{action: "update", old: fixSecret(t, 2, StatusPendingUpgrade, "Preparing upgrade", "1"), obj: fixSecret(t, 2, StatusFailed, `Upgrade "web" failed: timed out waiting for the condition`, "2")},
+			},
+			expected: []Operation{FailedOperation},
+		},
+		{
+			name: "Uninstall",
+			steps: []step{
+				{action: "update", old: fixSecret(t, 2, StatusDeployed, "Upgrade complete", "1"), obj: fixSecret(t, 2, StatusUninstalling, "Deletion in progress", "2")},
+				{action: "delete", obj: fixSecret(t, 1, StatusSuperseded, "Install complete", "1")},
+				{action: "delete", obj: fixSecret(t, 2, StatusUninstalling, "Deletion in progress", "2")},
+			},
+			expected: []Operation{UninstalledOperation},
+		},
+		{
+			name: "Uninstall with kept history",
+			steps: []step{
+				{action: "update", old: fixSecret(t, 2, StatusUninstalling, "Deletion in progress", "1"), obj: fixSecret(t, 2, StatusUninstalled, "Uninstallation complete", "2")},
+			},
+			expected: []Operation{UninstalledOperation},
+		},
+		{
+			name: "History pruning and resync",
+			steps: []step{
+				{action: "delete", obj: fixSecret(t, 1, StatusSuperseded, "Install complete", "1")},
+				{action: "update", old: fixSecret(t, 2, StatusDeployed, "Upgrade complete", "1"), obj: fixSecret(t, 2, StatusDeployed, "Upgrade complete", "1")},
+			},
+		},
+		{
+			name: "Releases created before start",
+			steps: []step{
+				{action: "add", obj: func() *corev1.Secret {
+					s := fixSecret(t, 1, StatusDeployed, "Install complete", "1")
+					s.CreationTimestamp = metav1.NewTime(fixStartedAt.Add(-time.Hour))
+					return s
+				}()},
+			},
+		},
+		{
+			name: "Excluded namespace",
+			steps: []step{
+				{action: "add", obj: func() *corev1.Secret {
+					s := fixSecret(t, 1, StatusDeployed, "Install complete", "1")
+					s.Namespace = "kube-system"
+					return s
+				}()},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			ch := make(chan source.Event, 10)
+			w := fixWatcher(t, ch)
+
+			// when
+			for _, s := range tc.steps {
+				switch s.action {
+				case "add":
+					w.OnAdd(ctx, s.obj)
+				case "update":
+					w.OnUpdate(ctx, s.old, s.obj)
+				case "delete":
+					w.OnDelete(ctx, s.obj)
+				}
+			}
+
+			// then
+			close(ch)
+			var actual []Operation
+			for event := range ch {
+				actual = append(actual, event.RawObject.(ReleaseEvent).Operation)
+			}
+			assert.Equal(t, tc.expected, actual)
+			assert.Empty(t, w.pending)
+		})
+	}
+}
+
+func TestWatcher_PendingTimeout(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	w := fixWatcher(t, ch)
+	w.cfg.PendingTimeout = 10 * time.Millisecond
+
+	// when
+	w.OnAdd(context.Background(), fixSecret(t, 2, StatusPendingUpgrade, "Preparing upgrade", "1"))
+
+	// then
+	select {
+	case event := <-ch:
+		e := event.RawObject.(ReleaseEvent)
+		assert.Equal(t, PendingOperation, e.Operation)
+		assert.Equal(t, "❗ Helm release default/web stuck in pending-upgrade", event.Message.Sections[0].Header)
+	case <-time.After(time.Second):
+		t.Fatal("pending release wasn't reported")
+	}
+}
+
+func TestWatcher_LastDeployedRevision(t *testing.T) {
+	// given
+	ctx := context.Background()
+	ch := make(chan source.Event, 10)
+	w := fixWatcher(t, ch)
+
+	deployed := fixSecret(t, 2, StatusDeployed, "Upgrade complete", "1")
+	deployed.CreationTimestamp = metav1.NewTime(fixStartedAt.Add(-time.Hour))
+	w.OnAdd(ctx, deployed)
+
+	// when
+	w.OnUpdate(ctx, fixSecret(t, 3, StatusPendingUpgrade, "Preparing upgrade", "1"), fixSecret(t, 3, StatusFailed, "Upgrade failed", "2"))
+	w.OnUpdate(ctx, fixSecret(t, 4, StatusPendingUpgrade, "Preparing upgrade", "1"), fixSecret(t, 4, StatusFailed, "Upgrade failed", "2"))
+
+	// then
+	close(ch)
+	var actual []int
+	for event := range ch {
+		actual = append(actual, event.RawObject.(ReleaseEvent).LastDeployedRevision)
+	}
+	assert.Equal(t, []int{2, 2}, actual)
+}
+
+func TestMessageForRelease(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	rel, err := storedReleaseFromObject(fixSecret(t, 3, StatusFailed, `Upgrade "web" failed: timed out waiting for the condition`, "1"))
+	require.NoError(t, err)
+	event := newReleaseEvent(FailedOperation, rel, "prod")
+	event.LastDeployedRevision = 1
+
+	// when
+	msg := messageForRelease(event, true, true, now)
+
+	// then
+	assert.Equal(t, api.Message{
+		Type:      api.DefaultMessage,
+		Timestamp: now,
+		Sections: []api.Section{
+			{
+				Base: api.Base{
+					Header: "❌ Helm release default/web failed",
+					Body: api.Body{
+						CodeBlock: "Visit http://web.example.com",
+					},
+				},
+				TextFields: api.TextFields{
+					{Key: "Release", Value: "web"},
+					{Key: "Namespace", Value: "default"},
+					{Key: "Chart", Value: "nginx-13.2.0"},
+					{Key: "App version", Value: "1.23.3"},
+					{Key: "Revision", Value: "3"},
+					{Key: "Status", Value: "failed"},
+					{Key: "Triggered by", Value: "helm"},
+					{Key: "Cluster", Value: "prod"},
+				},
+				BulletLists: api.BulletLists{
+					{Title: "Description", Items: []string{`Upgrade "web" failed: timed out waiting for the condition`}},
+				},
+			},
+			{
+				Buttons: api.Buttons{
+					{
+						Name:        "History",
+						Command:     fmt.Sprintf("%s helm history web -n default", api.MessageBotNamePlaceholder),
+						Description: fmt.Sprintf("%s helm history web -n default", api.MessageBotNamePlaceholder),
+					},
+					{
+						Name:        "Rollback to 1",
+						Command:     fmt.Sprintf("%s helm rollback web 1 -n default", api.MessageBotNamePlaceholder),
+						Description: fmt.Sprintf("%s helm rollback web 1 -n default", api.MessageBotNamePlaceholder),
+						Style:       api.ButtonStyleDanger,
+					},
+				},
+			},
+		},
+	}, msg)
+
+	// when
+	event.LastDeployedRevision = 0
+	msg = messageForRelease(event, true, true, now)
+
+	// then
+	require.Len(t, msg.Sections, 2)
+	assert.Len(t, msg.Sections[1].Buttons, 1, "rollback button should be omitted without a deployed revision")
+
+	// when
+	msg = messageForRelease(event, false, false, now)
+
+	// then
+	assert.Equal(t, api.NonInteractiveSingleSection, msg.Type)
+	require.Len(t, msg.Sections, 1)
+	assert.Empty(t, msg.Sections[0].Body.CodeBlock)
+}
+
+func TestStoredReleaseFromObject_ConfigMap(t *testing.T) {
+	// given
+	raw, err := json.Marshal(fixRelease(1, StatusDeployed, "Install complete"))
+	require.NoError(t, err)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.web.v1", Namespace: "default"},
+		Data: map[string]string{
+			releaseKey: base64.StdEncoding.EncodeToString(raw),
+		},
+	}
+
+	// when
+	rel, err := storedReleaseFromObject(cm)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "default/sh.helm.release.v1.web.v1", rel.StorageKey)
+	assert.Equal(t, fixRelease(1, StatusDeployed, "Install complete"), rel.release)
+}
+
+func fixWatcher(t *testing.T, ch chan source.Event) *watcher {
+	t.Helper()
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+
+	w := newWatcher(loggerx.NewNoop(), cfg, "prod", true, ch)
+	w.startedAt = fixStartedAt
+	return w
+}
+
+func fixRelease(revision int, status Status, desc string) release {
+	return release{
+		Name:      "web",
+		Namespace: "default",
+		Version:   revision,
+		Info: releaseInfo{
+			Description: desc,
+			Status:      status,
+			Notes:       "Visit http://web.example.com",
+		},
+		Chart: chart{
+			Metadata: chartMetadata{Name: "nginx", Version: "13.2.0", AppVersion: "1.23.3"},
+		},
+	}
+}
+
+// fixSecret returns the release stored in the same way as the Helm secret driver does.
+func fixSecret(t *testing.T, revision int, status Status, desc, resourceVersion string) *corev1.Secret {
+	t.Helper()
+
+	raw, err := json.Marshal(fixRelease(revision, status, desc))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(raw)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	modifiedAt := metav1.NewTime(fixStartedAt.Add(time.Minute))
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fmt.Sprintf("sh.helm.release.v1.web.v%d", revision),
+			Namespace:         "default",
+			ResourceVersion:   resourceVersion,
+			CreationTimestamp: metav1.NewTime(fixStartedAt.Add(time.Second)),
+			Labels:            map[string]string{"owner": "helm", "name": "web", "status": string(status)},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: fixStartedAt.Add(-time.Hour)}},
+				{Manager: "helm", Operation: metav1.ManagedFieldsOperationUpdate, Time: &modifiedAt},
+			},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{
+			releaseKey: []byte(base64.StdEncoding.EncodeToString(buf.Bytes())),
+		},
+	}
+}
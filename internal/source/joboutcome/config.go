@@ -0,0 +1,89 @@
+package joboutcome
+
+import (
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds Job outcome source configuration.
+type Config struct {
+	Log        config.Logger              `yaml:"log"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// NotifyOnSuccess sends a message also when a Job completes successfully. Failures are always reported.
+	NotifyOnSuccess      bool           `yaml:"notifyOnSuccess"`
+	Logs                 LogsConfig     `yaml:"logs"`
+	CronJobs             CronJobsConfig `yaml:"cronJobs"`
+	InformerResyncPeriod time.Duration  `yaml:"informerResyncPeriod"`
+}
+
+// LogsConfig contains configuration for logs attached to Job failure messages.
+type LogsConfig struct {
+	// Enabled attaches logs of the most recently failed Job Pod.
+	Enabled bool `yaml:"enabled"`
+	// TailLines is the number of the last log lines to attach.
+	TailLines int64 `yaml:"tailLines"`
+}
+
+// CronJobsConfig contains configuration for CronJob checks.
+type CronJobsConfig struct {
+	// CheckInterval defines how often CronJobs are checked.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+	// MissedScheduleTolerance is the time after the scheduled run in which a Job must be started.
+	// If the CronJob has a longer starting deadline, the deadline is used instead.
+	MissedScheduleTolerance time.Duration `yaml:"missedScheduleTolerance"`
+	// MaxSuspendedDuration is the time after which a suspended CronJob is reported. It's counted since the suspension
+	// was first observed, so CronJobs suspended before the plugin started are counted since the start. Zero disables the check.
+	MaxSuspendedDuration time.Duration `yaml:"maxSuspendedDuration"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		NotifyOnSuccess: false,
+		Logs: LogsConfig{
+			Enabled:   true,
+			TailLines: 20,
+		},
+		CronJobs: CronJobsConfig{
+			CheckInterval:           5 * time.Minute,
+			MissedScheduleTolerance: 5 * time.Minute,
+			MaxSuspendedDuration:    7 * 24 * time.Hour,
+		},
+		InformerResyncPeriod: 30 * time.Minute,
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.Logs.Enabled && c.Logs.TailLines <= 0 {
+		return fmt.Errorf("the number of log lines must be positive, got %d", c.Logs.TailLines)
+	}
+	if c.CronJobs.CheckInterval <= 0 {
+		return fmt.Errorf("the CronJob check interval must be positive, got %s", c.CronJobs.CheckInterval)
+	}
+	if c.CronJobs.MissedScheduleTolerance < 0 {
+		return fmt.Errorf("the missed schedule tolerance must not be negative, got %s", c.CronJobs.MissedScheduleTolerance)
+	}
+	if c.CronJobs.MaxSuspendedDuration < 0 {
+		return fmt.Errorf("the max suspended duration must not be negative, got %s", c.CronJobs.MaxSuspendedDuration)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package joboutcome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// cronJobChecker detects CronJobs which missed their schedule or have been suspended for too long.
+type cronJobChecker struct {
+	log     logrus.FieldLogger
+	cfg     Config
+	cluster string
+	k8sCli  kubernetes.Interface
+	now     func() time.Time
+
+	// reported holds the last reported problem for each CronJob, indexed by namespace/name,
+	// so the same problem is sent only once.
+	reported map[string]string
+	// suspendedSince holds the time when each suspended CronJob was first seen suspended, indexed by namespace/name.
+	// It's kept in memory, so CronJobs suspended before the plugin started are counted since the start.
+	suspendedSince map[string]time.Time
+}
+
+func newCronJobChecker(log logrus.FieldLogger, cfg Config, cluster string, k8sCli kubernetes.Interface) *cronJobChecker {
+	return &cronJobChecker{
+		log:      log,
+		cfg:      cfg,
+		cluster:  cluster,
+		k8sCli:   k8sCli,
+		now:      time.Now,
+		reported: map[string]string{},
+
+		suspendedSince: map[string]time.Time{},
+	}
+}
+
+// Check returns events for CronJobs with problems which weren't reported yet.
+func (c *cronJobChecker) Check(ctx context.Context) ([]CronJobEvent, error) {
+	list, err := c.k8sCli.BatchV1().CronJobs(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("while listing CronJobs: %w", err)
+	}
+
+	now := c.now()
+	seen := map[string]struct{}{}
+	var out []CronJobEvent
+	for i := range list.Items {
+		cronJob := &list.Items[i]
+		allowed, err := c.cfg.Namespaces.IsAllowed(cronJob.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("while matching namespace: %w", err)
+		}
+		if !allowed {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", cronJob.Namespace, cronJob.Name)
+		seen[key] = struct{}{}
+
+		event, found, err := c.check(key, cronJob, now)
+		if err != nil {
+			c.log.Warnf("while checking CronJob %s: %s", key, err.Error())
+			continue
+		}
+		if !found {
+			delete(c.reported, key)
+			continue
+		}
+
+		id := event.id()
+		if c.reported[key] == id {
+			continue
+		}
+		c.reported[key] = id
+		out = append(out, event)
+	}
+
+	for key := range c.reported {
+		if _, ok := seen[key]; !ok {
+			delete(c.reported, key)
+		}
+	}
+	for key := range c.suspendedSince {
+		if _, ok := seen[key]; !ok {
+			delete(c.suspendedSince, key)
+		}
+	}
+	return out, nil
+}
+
+func (c *cronJobChecker) check(key string, cronJob *batchv1.CronJob, now time.Time) (CronJobEvent, bool, error) {
+	lastRun := cronJob.CreationTimestamp.Time
+	if cronJob.Status.LastScheduleTime != nil {
+		lastRun = cronJob.Status.LastScheduleTime.Time
+	}
+	event := newCronJobEvent(cronJob, c.cluster)
+
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		suspendedSince, found := c.suspendedSince[key]
+		if !found {
+			suspendedSince = now
+			c.suspendedSince[key] = suspendedSince
+		}
+		maxSuspended := c.cfg.CronJobs.MaxSuspendedDuration
+		if maxSuspended == 0 || now.Sub(suspendedSince) < maxSuspended {
+			return CronJobEvent{}, false, nil
+		}
+		event.Type = SuspendedEventType
+		event.SuspendedFor = now.Sub(suspendedSince)
+		return event, true, nil
+	}
+	delete(c.suspendedSince, key)
+
+	schedule, err := cron.ParseStandard(scheduleSpec(cronJob))
+	if err != nil {
+		return CronJobEvent{}, false, fmt.Errorf("while parsing schedule %q: %w", cronJob.Spec.Schedule, err)
+	}
+	expectedAt := schedule.Next(lastRun)
+	if expectedAt.IsZero() || now.Before(expectedAt.Add(c.tolerance(cronJob))) {
+		return CronJobEvent{}, false, nil
+	}
+
+	event.Type = MissedScheduleEventType
+	event.ExpectedAt = &expectedAt
+	return event, true, nil
+}
+
+// tolerance returns the time in which a scheduled Job can still be started by the CronJob controller.
+func (c *cronJobChecker) tolerance(cronJob *batchv1.CronJob) time.Duration {
+	out := c.cfg.CronJobs.MissedScheduleTolerance
+	if deadline := cronJob.Spec.StartingDeadlineSeconds; deadline != nil {
+		if d := time.Duration(*deadline) * time.Second; d > out {
+			out = d
+		}
+	}
+	return out
+}
+
+// scheduleSpec returns the CronJob schedule with the time zone prefix supported by the cron parser.
+func scheduleSpec(cronJob *batchv1.CronJob) string {
+	schedule := strings.TrimSpace(cronJob.Spec.Schedule)
+	if cronJob.Spec.TimeZone == nil || *cronJob.Spec.TimeZone == "" {
+		return schedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", *cronJob.Spec.TimeZone, schedule)
+}
@@ -0,0 +1,153 @@
+package joboutcome
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestCronJobChecker(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 10, 9, 30, 0, 0, time.UTC)
+	lastSchedule := time.Date(2023, 3, 9, 9, 0, 0, 0, time.UTC)
+	missedAt := time.Date(2023, 3, 10, 9, 0, 0, 0, time.UTC)
+	newYorkLastSchedule := time.Date(2023, 3, 9, 14, 0, 0, 0, time.UTC)
+
+	k8sCli := fake.NewSimpleClientset(
+		fixCronJob("missed", "0 9 * * *", &lastSchedule, nil),
+		fixCronJob("on-time", "0 * * * *", &missedAt, nil),
+		fixCronJob("long-deadline", "0 9 * * *", &lastSchedule, func(cj *batchv1.CronJob) {
+			cj.Spec.StartingDeadlineSeconds = pointer.Int64(3600)
+		}),
+		fixCronJob("other-time-zone", "0 9 * * *", &newYorkLastSchedule, func(cj *batchv1.CronJob) {
+			cj.Spec.TimeZone = pointer.String("America/New_York")
+		}),
+		fixCronJob("suspended", "0 9 * * *", &lastSchedule, func(cj *batchv1.CronJob) {
+			cj.Spec.Suspend = pointer.Bool(true)
+		}),
+		fixCronJob("invalid-schedule", "every day", &lastSchedule, nil),
+		fixCronJob("excluded", "0 9 * * *", &lastSchedule, func(cj *batchv1.CronJob) {
+			cj.Namespace = "kube-system"
+		}),
+	)
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+
+	checker := newCronJobChecker(loggerx.NewNoop(), cfg, "prod", k8sCli)
+	checker.now = func() time.Time { return now }
+
+	// when
+	events, err := checker.Check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []CronJobEvent{
+		{
+			Type:             MissedScheduleEventType,
+			Name:             "missed",
+			Namespace:        "default",
+			Schedule:         "0 9 * * *",
+			LastScheduleTime: &lastSchedule,
+			ExpectedAt:       &missedAt,
+			Cluster:          "prod",
+		},
+	}, events)
+
+	msg := messageForCronJob(events[0], now)
+	assert.Equal(t, "⏰ CronJob default/missed missed its scheduled run", msg.Sections[0].Header)
+
+	// when
+	events, err = checker.Check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events, "the same problems shouldn't be reported twice")
+}
+
+func TestCronJobChecker_Suspended(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 3, 10, 9, 30, 0, 0, time.UTC)
+	lastSchedule := time.Date(2023, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	k8sCli := fake.NewSimpleClientset(fixCronJob("suspended", "0 9 * * *", &lastSchedule, func(cj *batchv1.CronJob) {
+		cj.Spec.Suspend = pointer.Bool(true)
+	}))
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+
+	checker := newCronJobChecker(loggerx.NewNoop(), cfg, "prod", k8sCli)
+	checker.now = func() time.Time { return now }
+
+	// when
+	events, err := checker.Check(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events, "the suspension should be counted since it was first observed, not since the last run")
+
+	// when
+	checker.now = func() time.Time { return now.Add(8 * 24 * time.Hour) }
+	events, err = checker.Check(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []CronJobEvent{
+		{
+			Type:             SuspendedEventType,
+			Name:             "suspended",
+			Namespace:        "default",
+			Schedule:         "0 9 * * *",
+			LastScheduleTime: &lastSchedule,
+			SuspendedFor:     8 * 24 * time.Hour,
+			Cluster:          "prod",
+		},
+	}, events)
+	msg := messageForCronJob(events[0], now)
+	assert.Equal(t, "⏸️ CronJob default/suspended has been suspended for 8d", msg.Sections[0].Header)
+
+	// when the CronJob is resumed
+	cronJob, err := k8sCli.BatchV1().CronJobs("default").Get(ctx, "suspended", metav1.GetOptions{})
+	require.NoError(t, err)
+	cronJob.Spec.Suspend = pointer.Bool(false)
+	cronJob.Status.LastScheduleTime = &metav1.Time{Time: now.Add(8 * 24 * time.Hour)}
+	_, err = k8sCli.BatchV1().CronJobs("default").Update(ctx, cronJob, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	events, err = checker.Check(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	assert.Empty(t, checker.suspendedSince)
+}
+
+func fixCronJob(name, schedule string, lastSchedule *time.Time, mutate func(cj *batchv1.CronJob)) *batchv1.CronJob {
+	cj := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: schedule,
+		},
+	}
+	if lastSchedule != nil {
+		cj.Status.LastScheduleTime = &metav1.Time{Time: *lastSchedule}
+	}
+	if mutate != nil {
+		mutate(cj)
+	}
+	return cj
+}
@@ -0,0 +1,205 @@
+package joboutcome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	jobNameLabel = "job-name"
+	cronJobKind  = "CronJob"
+)
+
+// jobWatcher reports Jobs once they complete or fail.
+type jobWatcher struct {
+	log       logrus.FieldLogger
+	cfg       Config
+	cluster   string
+	k8sCli    kubernetes.Interface
+	startedAt time.Time
+	eventCh   chan<- source.Event
+}
+
+func newJobWatcher(log logrus.FieldLogger, cfg Config, cluster string, k8sCli kubernetes.Interface, eventCh chan<- source.Event) *jobWatcher {
+	return &jobWatcher{
+		log:       log,
+		cfg:       cfg,
+		cluster:   cluster,
+		k8sCli:    k8sCli,
+		startedAt: time.Now(),
+		eventCh:   eventCh,
+	}
+}
+
+// OnAdd handles a new Job. Jobs which finished before the watcher started are ignored.
+func (w *jobWatcher) OnAdd(ctx context.Context, obj interface{}) {
+	job, ok := w.job(obj)
+	if !ok {
+		return
+	}
+	_, finishedAt, finished := jobOutcome(job)
+	if !finished || finishedAt.Before(w.startedAt) {
+		return
+	}
+	w.process(ctx, job)
+}
+
+// OnUpdate handles a Job which has just finished.
+func (w *jobWatcher) OnUpdate(ctx context.Context, oldObj, newObj interface{}) {
+	oldJob, ok := w.job(oldObj)
+	if !ok {
+		return
+	}
+	newJob, ok := w.job(newObj)
+	if !ok {
+		return
+	}
+	if _, _, finished := jobOutcome(oldJob); finished {
+		return
+	}
+	if _, _, finished := jobOutcome(newJob); !finished {
+		return
+	}
+	w.process(ctx, newJob)
+}
+
+func (w *jobWatcher) process(ctx context.Context, job *batchv1.Job) {
+	event := newJobEvent(job, w.cluster)
+	if event.Outcome == SucceededOutcome && !w.cfg.NotifyOnSuccess {
+		return
+	}
+
+	if event.Outcome == FailedOutcome && w.cfg.Logs.Enabled {
+		pod, container, logs, err := w.failedPodLogs(ctx, job)
+		if err != nil {
+			w.log.Warnf("while getting logs of failed Job %s/%s: %s", job.Namespace, job.Name, err.Error())
+		}
+		event.FailedPod, event.Container, event.Logs = pod, container, logs
+	}
+
+	select {
+	case w.eventCh <- source.Event{
+		Message:   messageForJob(event, time.Now()),
+		RawObject: event,
+	}:
+	case <-ctx.Done():
+	}
+}
+
+// failedPodLogs returns the last log lines of the most recently created failed Pod of a given Job.
+func (w *jobWatcher) failedPodLogs(ctx context.Context, job *batchv1.Job) (string, string, string, error) {
+	selector, err := jobPodSelector(job)
+	if err != nil {
+		return "", "", "", fmt.Errorf("while parsing Pod selector: %w", err)
+	}
+	pods, err := w.k8sCli.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return "", "", "", fmt.Errorf("while listing Pods: %w", err)
+	}
+
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		item := &pods.Items[i]
+		if item.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if pod == nil || item.CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = item
+		}
+	}
+	if pod == nil {
+		return "", "", "", nil
+	}
+
+	container := failedContainer(pod)
+	raw, err := w.k8sCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &w.cfg.Logs.TailLines,
+	}).DoRaw(ctx)
+	if err != nil {
+		return pod.Name, container, "", fmt.Errorf("while getting logs of Pod %q: %w", pod.Name, err)
+	}
+	return pod.Name, container, strings.TrimRight(string(raw), "\n"), nil
+}
+
+func (w *jobWatcher) job(obj interface{}) (*batchv1.Job, bool) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return nil, false
+	}
+
+	allowed, err := w.cfg.Namespaces.IsAllowed(job.Namespace)
+	if err != nil {
+		w.log.Errorf("while matching namespace: %s", err.Error())
+		return nil, false
+	}
+	return job, allowed
+}
+
+// jobOutcome returns the Job outcome and the time it finished, if it's already finished.
+func jobOutcome(job *batchv1.Job) (Outcome, time.Time, bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			if job.Status.CompletionTime != nil {
+				return SucceededOutcome, job.Status.CompletionTime.Time, true
+			}
+			return SucceededOutcome, cond.LastTransitionTime.Time, true
+		case batchv1.JobFailed:
+			return FailedOutcome, cond.LastTransitionTime.Time, true
+		}
+	}
+	return "", time.Time{}, false
+}
+
+// jobPodSelector returns the selector of Job Pods. The Job selector is set by the API server,
+// and the job-name label is used only as a fallback.
+func jobPodSelector(job *batchv1.Job) (string, error) {
+	if job.Spec.Selector == nil {
+		return labels.Set{jobNameLabel: job.Name}.String(), nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+	return selector.String(), nil
+}
+
+// failedContainer returns the name of the first container which terminated with a non-zero exit code.
+// If there is no such container, the first one is returned.
+func failedContainer(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+			if state.Terminated != nil && state.Terminated.ExitCode != 0 {
+				return status.Name
+			}
+		}
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+	return pod.Spec.Containers[0].Name
+}
+
+func ownerCronJob(job *batchv1.Job) string {
+	for _, ref := range job.OwnerReferences {
+		if ref.Kind == cronJobKind {
+			return ref.Name
+		}
+	}
+	return ""
+}
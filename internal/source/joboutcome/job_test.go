@@ -0,0 +1,222 @@
+package joboutcome
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var fixStartedAt = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestJobWatcher(t *testing.T) {
+	tests := []struct {
+		name            string
+		notifyOnSuccess bool
+		old             *batchv1.Job
+		new             *batchv1.Job
+		expected        []JobEvent
+	}{
+		{
+			name: "Failed Job with logs",
+			old:  fixJob(""),
+			new:  fixJob(batchv1.JobFailed),
+			expected: []JobEvent{
+				{
+					Outcome:    FailedOutcome,
+					Name:       "backup-28000000",
+					Namespace:  "default",
+					CronJob:    "backup",
+					StartedAt:  fixStartedAt.Add(time.Minute),
+					FinishedAt: fixStartedAt.Add(4 * time.Minute),
+					Duration:   3 * time.Minute,
+					Attempts:   3,
+					Reason:     "BackoffLimitExceeded",
+					Message:    "Job has reached the specified backoff limit",
+					FailedPod:  "backup-28000000-second",
+					Container:  "backup",
+					Logs:       "fake logs",
+					Cluster:    "prod",
+				},
+			},
+		},
+		{
+			name: "Succeeded Job without success notifications",
+			old:  fixJob(""),
+			new:  fixJob(batchv1.JobComplete),
+		},
+		{
+			name:            "Succeeded Job",
+			notifyOnSuccess: true,
+			old:             fixJob(""),
+			new:             fixJob(batchv1.JobComplete),
+			expected: []JobEvent{
+				{
+					Outcome:    SucceededOutcome,
+					Name:       "backup-28000000",
+					Namespace:  "default",
+					CronJob:    "backup",
+					StartedAt:  fixStartedAt.Add(time.Minute),
+					FinishedAt: fixStartedAt.Add(4 * time.Minute),
+					Duration:   3 * time.Minute,
+					Attempts:   3,
+					Cluster:    "prod",
+				},
+			},
+		},
+		{
+			name: "Resync of finished Job",
+			old:  fixJob(batchv1.JobFailed),
+			new:  fixJob(batchv1.JobFailed),
+		},
+		{
+			name: "Running Job",
+			old:  fixJob(""),
+			new:  fixJob(""),
+		},
+		{
+			name: "Excluded namespace",
+			old: func() *batchv1.Job {
+				job := fixJob("")
+				job.Namespace = "kube-system"
+				return job
+			}(),
+			new: func() *batchv1.Job {
+				job := fixJob(batchv1.JobFailed)
+				job.Namespace = "kube-system"
+				return job
+			}(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ch := make(chan source.Event, 10)
+			w := fixJobWatcher(t, ch, fixFailedPod("backup-28000000-first", 0), fixFailedPod("backup-28000000-second", time.Minute))
+			w.cfg.NotifyOnSuccess = tc.notifyOnSuccess
+
+			// when
+			w.OnUpdate(context.Background(), tc.old, tc.new)
+
+			// then
+			close(ch)
+			var actual []JobEvent
+			for event := range ch {
+				actual = append(actual, event.RawObject.(JobEvent))
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestJobWatcher_OnAdd(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 10)
+	w := fixJobWatcher(t, ch, fixFailedPod("backup-28000000-first", 0))
+
+	finishedBeforeStart := fixJob(batchv1.JobFailed)
+	finishedBeforeStart.Status.Conditions[0].LastTransitionTime = metav1.NewTime(fixStartedAt.Add(-time.Hour))
+
+	// when
+	w.OnAdd(context.Background(), finishedBeforeStart)
+	w.OnAdd(context.Background(), fixJob(""))
+	w.OnAdd(context.Background(), fixJob(batchv1.JobFailed))
+
+	// then
+	close(ch)
+	var actual []*source.Event
+	for event := range ch {
+		event := event
+		actual = append(actual, &event)
+	}
+	require.Len(t, actual, 1)
+	assert.Equal(t, "❌ Job default/backup-28000000 failed after 3m", actual[0].Message.Sections[0].Header)
+	assert.Equal(t, "fake logs", actual[0].Message.Sections[0].Body.CodeBlock)
+}
+
+func fixJobWatcher(t *testing.T, ch chan source.Event, objects ...runtime.Object) *jobWatcher {
+	t.Helper()
+
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+
+	w := newJobWatcher(loggerx.NewNoop(), cfg, "prod", fake.NewSimpleClientset(objects...), ch)
+	w.startedAt = fixStartedAt
+	return w
+}
+
+func fixJob(condType batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "backup-28000000",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(fixStartedAt),
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup"},
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"controller-uid": "1234"},
+			},
+		},
+		Status: batchv1.JobStatus{
+			StartTime: &metav1.Time{Time: fixStartedAt.Add(time.Minute)},
+			Failed:    2,
+		},
+	}
+
+	finishedAt := metav1.NewTime(fixStartedAt.Add(4 * time.Minute))
+	switch condType {
+	case batchv1.JobComplete:
+		job.Status.Succeeded = 1
+		job.Status.CompletionTime = &finishedAt
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: finishedAt},
+		}
+	case batchv1.JobFailed:
+		job.Status.Failed = 3
+		job.Status.Conditions = []batchv1.JobCondition{
+			{
+				Type:               batchv1.JobFailed,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: finishedAt,
+				Reason:             "BackoffLimitExceeded",
+				Message:            "Job has reached the specified backoff limit",
+			},
+		}
+	}
+	return job
+}
+
+func fixFailedPod(name string, createdAfter time.Duration) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(fixStartedAt.Add(createdAfter)),
+			Labels:            map[string]string{"controller-uid": "1234", "job-name": "backup-28000000"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "istio-proxy"}, {Name: "backup"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "istio-proxy", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "backup", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}},
+			},
+		},
+	}
+}
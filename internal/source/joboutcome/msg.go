@@ -0,0 +1,197 @@
+package joboutcome
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// Outcome describes how the Job finished.
+type Outcome string
+
+const (
+	// SucceededOutcome is used when the Job completed successfully.
+	SucceededOutcome Outcome = "succeeded"
+	// FailedOutcome is used when the Job failed.
+	FailedOutcome Outcome = "failed"
+)
+
+// JobEvent is a raw object sent together with the Job outcome message.
+type JobEvent struct {
+	Outcome    Outcome       `json:"outcome"`
+	Name       string        `json:"name"`
+	Namespace  string        `json:"namespace"`
+	CronJob    string        `json:"cronJob,omitempty"`
+	StartedAt  time.Time     `json:"startedAt"`
+	FinishedAt time.Time     `json:"finishedAt"`
+	Duration   time.Duration `json:"duration"`
+	Attempts   int32         `json:"attempts"`
+	Reason     string        `json:"reason,omitempty"`
+	Message    string        `json:"message,omitempty"`
+	FailedPod  string        `json:"failedPod,omitempty"`
+	Container  string        `json:"container,omitempty"`
+	Logs       string        `json:"logs,omitempty"`
+	Cluster    string        `json:"cluster,omitempty"`
+}
+
+func newJobEvent(job *batchv1.Job, cluster string) JobEvent {
+	outcome, finishedAt, _ := jobOutcome(job)
+	startedAt := job.CreationTimestamp.Time
+	if job.Status.StartTime != nil {
+		startedAt = job.Status.StartTime.Time
+	}
+
+	event := JobEvent{
+		Outcome:    outcome,
+		Name:       job.Name,
+		Namespace:  job.Namespace,
+		CronJob:    ownerCronJob(job),
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		Duration:   finishedAt.Sub(startedAt),
+		Attempts:   job.Status.Succeeded + job.Status.Failed,
+		Cluster:    cluster,
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			event.Reason, event.Message = cond.Reason, cond.Message
+		}
+	}
+	return event
+}
+
+// CronJobEventType describes the CronJob problem.
+type CronJobEventType string
+
+const (
+	// MissedScheduleEventType is used when the CronJob didn't start a Job on time.
+	MissedScheduleEventType CronJobEventType = "missedSchedule"
+	// SuspendedEventType is used when the CronJob has been suspended for too long.
+	SuspendedEventType CronJobEventType = "suspended"
+)
+
+// CronJobEvent is a raw object sent together with the CronJob message.
+type CronJobEvent struct {
+	Type             CronJobEventType `json:"type"`
+	Name             string           `json:"name"`
+	Namespace        string           `json:"namespace"`
+	Schedule         string           `json:"schedule"`
+	TimeZone         string           `json:"timeZone,omitempty"`
+	LastScheduleTime *time.Time       `json:"lastScheduleTime,omitempty"`
+	ExpectedAt       *time.Time       `json:"expectedAt,omitempty"`
+	SuspendedFor     time.Duration    `json:"suspendedFor,omitempty"`
+	Cluster          string           `json:"cluster,omitempty"`
+}
+
+func newCronJobEvent(cronJob *batchv1.CronJob, cluster string) CronJobEvent {
+	event := CronJobEvent{
+		Name:      cronJob.Name,
+		Namespace: cronJob.Namespace,
+		Schedule:  cronJob.Spec.Schedule,
+		Cluster:   cluster,
+	}
+	if cronJob.Spec.TimeZone != nil {
+		event.TimeZone = *cronJob.Spec.TimeZone
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		event.LastScheduleTime = &cronJob.Status.LastScheduleTime.Time
+	}
+	return event
+}
+
+// id identifies the problem, so it's reported only once. A missed schedule is identified by the missed run.
+func (e CronJobEvent) id() string {
+	if e.ExpectedAt == nil {
+		return string(e.Type)
+	}
+	return fmt.Sprintf("%s/%s", e.Type, e.ExpectedAt.UTC().Format(time.RFC3339))
+}
+
+func messageForJob(e JobEvent, now time.Time) api.Message {
+	emoji := "✅"
+	if e.Outcome == FailedOutcome {
+		emoji = "❌"
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s Job %s/%s %s after %s", emoji, e.Namespace, e.Name, e.Outcome, duration.HumanDuration(e.Duration)),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Job", e.Name)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "CronJob", e.CronJob)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Attempts", strconv.Itoa(int(e.Attempts)))
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Reason", e.Reason)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	if e.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Message",
+			Items: []string{e.Message},
+		})
+	}
+	if e.Logs != "" {
+		section.Body.CodeBlock = e.Logs
+		section.Context = api.ContextItems{
+			{Text: fmt.Sprintf("Last log lines of the %q container in the %q Pod", e.Container, e.FailedPod)},
+		}
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func messageForCronJob(e CronJobEvent, now time.Time) api.Message {
+	var header string
+	switch e.Type {
+	case SuspendedEventType:
+		header = fmt.Sprintf("⏸️ CronJob %s/%s has been suspended for %s", e.Namespace, e.Name, duration.HumanDuration(e.SuspendedFor))
+	default:
+		header = fmt.Sprintf("⏰ CronJob %s/%s missed its scheduled run", e.Namespace, e.Name)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: header,
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "CronJob", e.Name)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Schedule", e.Schedule)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Time zone", e.TimeZone)
+	if e.ExpectedAt != nil {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Expected at", e.ExpectedAt.UTC().Format(time.RFC3339))
+	}
+	lastSchedule := "never"
+	if e.LastScheduleTime != nil {
+		lastSchedule = e.LastScheduleTime.UTC().Format(time.RFC3339)
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Last scheduled", lastSchedule)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
@@ -0,0 +1,258 @@
+package joboutcome
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the Job outcome Botkube plugin.
+	PluginName = "job-outcome"
+
+	description = "Get notifications when Jobs complete or fail, and when CronJobs miss their schedule or stay suspended for too long."
+)
+
+// Source Job outcome source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams Job and CronJob events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+
+	w := newJobWatcher(log, cfg, input.Context.ClusterName, k8sCli, out.Event)
+	go s.watchJobs(ctx, k8sCli, w)
+
+	checker := newCronJobChecker(log, cfg, input.Context.ClusterName, k8sCli)
+	go s.checkCronJobsPeriodically(ctx, checker, out.Event)
+
+	return out, nil
+}
+
+// Metadata returns metadata of Job outcome configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) watchJobs(ctx context.Context, k8sCli kubernetes.Interface, w *jobWatcher) {
+	factory := informers.NewSharedInformerFactory(k8sCli, w.cfg.InformerResyncPeriod)
+	informer := factory.Batch().V1().Jobs().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.OnAdd(ctx, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.OnUpdate(ctx, oldObj, newObj)
+		},
+	})
+
+	w.log.Info("Starting Job informer...")
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+}
+
+func (s *Source) checkCronJobsPeriodically(ctx context.Context, checker *cronJobChecker, ch chan<- source.Event) {
+	ticker := time.NewTicker(checker.cfg.CronJobs.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := checker.Check(ctx)
+		if err != nil {
+			checker.log.Errorf("while checking CronJobs: %s", err.Error())
+		}
+		for _, event := range events {
+			select {
+			case ch <- source.Event{
+				Message:   messageForCronJob(event, time.Now()),
+				RawObject: event,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Job outcome",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces of watched Jobs and CronJobs.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"notifyOnSuccess": {
+			  "title": "Notify on success",
+			  "description": "If true, sends a message also when a Job completes successfully. Failures are always reported.",
+			  "type": "boolean",
+			  "default": false
+			},
+			"logs": {
+			  "title": "Logs",
+			  "description": "Logs of the failed Job Pod attached to the failure message.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If true, attaches logs of the most recently failed Job Pod.",
+				  "type": "boolean",
+				  "default": true
+				},
+				"tailLines": {
+				  "title": "Tail lines",
+				  "description": "Number of the last log lines to attach.",
+				  "type": "integer",
+				  "default": 20,
+				  "minimum": 1
+				}
+			  }
+			},
+			"cronJobs": {
+			  "title": "CronJobs",
+			  "description": "CronJob checks configuration.",
+			  "type": "object",
+			  "properties": {
+				"checkInterval": {
+				  "title": "Check interval",
+				  "description": "How often CronJobs are checked.",
+				  "type": "string",
+				  "default": "5m"
+				},
+				"missedScheduleTolerance": {
+				  "title": "Missed schedule tolerance",
+				  "description": "Time after the scheduled run in which a Job must be started. If the CronJob has a longer starting deadline, the deadline is used instead.",
+				  "type": "string",
+				  "default": "5m"
+				},
+				"maxSuspendedDuration": {
+				  "title": "Max suspended duration",
+				  "description": "Time after which a suspended CronJob is reported, counted since the suspension was first observed. Set 0 to disable.",
+				  "type": "string",
+				  "default": "168h"
+				}
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
@@ -19,6 +20,7 @@ type Client struct {
 	discoveryCli discovery.DiscoveryInterface
 	mapper       meta.RESTMapper
 	k8sCli       *kubernetes.Clientset
+	metadataCli  metadata.Interface
 }
 
 // NewClient initializes Kubernetes client
@@ -35,11 +37,16 @@ func NewClient(kubeConfigBytes []byte) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("while creating K8s clientset. %v", err)
 	}
+	metadataCli, err := metadata.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("while creating K8s metadata client. %v", err)
+	}
 	return &Client{
 		dynamicCli:   dynamicCli,
 		discoveryCli: discoveryCli,
 		k8sCli:       k8sCli,
 		mapper:       mapper,
+		metadataCli:  metadataCli,
 	}, nil
 }
 
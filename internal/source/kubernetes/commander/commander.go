@@ -13,18 +13,21 @@ import (
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 )
 
+const kubectlCmdName = "kubectl"
+
 // Command defines a command that is executed by the app.
 type Command struct {
 	Name string
 	Cmd  string
 }
 
-// Commander is responsible for generating kubectl commands for the given event.
+// Commander is responsible for generating commands for the given event.
 type Commander struct {
 	log              logrus.FieldLogger
 	guard            CmdGuard
 	allowedVerbs     []string
 	allowedResources []string
+	resourceCommands map[string][]resourceCommand
 }
 
 // unsupportedEventCommandVerbs contains list of verbs that are not supported for the actionable event notifications.
@@ -38,15 +41,41 @@ type CmdGuard interface {
 	GetResourceDetailsFromMap(selectedVerb, resourceType string, resMap map[string]metav1.APIResource) (command.Resource, error)
 }
 
-// NewCommander creates a new Commander instance.
-func NewCommander(log logrus.FieldLogger, guard CmdGuard, commands config.Commands) *Commander {
-	return &Commander{log: log, guard: guard, allowedVerbs: commands.Verbs, allowedResources: commands.Resources}
+// NewCommander creates a new Commander instance. It returns an error if any of the resource command templates is invalid.
+func NewCommander(log logrus.FieldLogger, guard CmdGuard, commands config.Commands, resources []config.Resource) (*Commander, error) {
+	resourceCommands, err := parseResourceCommands(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Commander{
+		log:              log,
+		guard:            guard,
+		allowedVerbs:     commands.Verbs,
+		allowedResources: commands.Resources,
+		resourceCommands: resourceCommands,
+	}, nil
 }
 
 // GetCommandsForEvent returns a list of commands for the given event.
+// Built-in kubectl commands are followed by the commands configured for a given resource.
 func (c *Commander) GetCommandsForEvent(event event.Event) ([]Command, error) {
+	commands, err := c.builtInCommandsForEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	customCommands, err := c.resourceCommandsForEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(commands, customCommands...), nil
+}
+
+func (c *Commander) builtInCommandsForEvent(event event.Event) ([]Command, error) {
 	if event.Type == config.DeleteEvent {
-		c.log.Debug("Skipping commands for the DELETE type of event for %q...", event.Kind)
+		c.log.Debugf("Skipping built-in commands for the DELETE type of event for %q...", event.Kind)
 		return nil, nil
 	}
 
@@ -94,7 +123,7 @@ func (c *Commander) GetCommandsForEvent(event event.Event) ([]Command, error) {
 
 		commands = append(commands, Command{
 			Name: verb,
-			Cmd:  fmt.Sprintf("%s %s%s", verb, resourceSubstr, namespaceSubstr),
+			Cmd:  fmt.Sprintf("%s %s %s%s", kubectlCmdName, verb, resourceSubstr, namespaceSubstr),
 		})
 	}
 
@@ -72,9 +72,9 @@ func TestCommander_GetCommandsForEvent(t *testing.T) {
 				verbMap: fixVerbMapForFakeGuard(),
 			},
 			ExpectedResult: []Command{
-				{Name: "describe", Cmd: "describe pods foo --namespace default"},
-				{Name: "get", Cmd: "get pods foo --namespace default"},
-				{Name: "logs", Cmd: "logs pods/foo --namespace default"},
+				{Name: "describe", Cmd: "kubectl describe pods foo --namespace default"},
+				{Name: "get", Cmd: "kubectl get pods foo --namespace default"},
+				{Name: "logs", Cmd: "kubectl logs pods/foo --namespace default"},
 			},
 			ExpectedErrMessage: "",
 		},
@@ -93,8 +93,8 @@ func TestCommander_GetCommandsForEvent(t *testing.T) {
 				verbMap: fixVerbMapForFakeGuard(),
 			},
 			ExpectedResult: []Command{
-				{Name: "describe", Cmd: "describe nodes foo"},
-				{Name: "get", Cmd: "get nodes foo"},
+				{Name: "describe", Cmd: "kubectl describe nodes foo"},
+				{Name: "get", Cmd: "kubectl get nodes foo"},
 			},
 			ExpectedErrMessage: "",
 		},
@@ -102,10 +102,11 @@ func TestCommander_GetCommandsForEvent(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			cmder := NewCommander(loggerx.NewNoop(), tc.Guard, config.Commands{
+			cmder, err := NewCommander(loggerx.NewNoop(), tc.Guard, config.Commands{
 				Verbs:     allowedVerbs,
 				Resources: allowedResources,
-			})
+			}, nil)
+			require.NoError(t, err)
 
 			// when
 			result, err := cmder.GetCommandsForEvent(tc.Event)
@@ -123,6 +124,153 @@ func TestCommander_GetCommandsForEvent(t *testing.T) {
 	}
 }
 
+func TestCommander_GetCommandsForEvent_ResourceCommands(t *testing.T) {
+	// given
+	resources := []config.Resource{
+		{
+			Type: "apps/v1/deployments",
+			Commands: []config.ResourceCommand{
+				{
+					DisplayName: "Restart",
+					Command:     "kubectl rollout restart deploy/{{ .Name }} -n {{ .Namespace }}",
+					Events:      []config.EventType{config.UpdateEvent, config.ErrorEvent},
+				},
+				{
+					DisplayName: "Helm history",
+					Command:     `helm history {{ index .Labels "app.kubernetes.io/instance" }} -n {{ .Namespace }}`,
+				},
+				{
+					DisplayName: "Exec",
+					Command:     "kubectl exec deploy/{{ .Name }} -n {{ .Namespace }} -- sh",
+				},
+				{
+					DisplayName: "Recreate",
+					Command:     "kubectl apply -f https://example.com/{{ .Name }}.yaml",
+					Events:      []config.EventType{config.DeleteEvent},
+				},
+			},
+		},
+	}
+	guard := &fakeGuard{
+		resMap: map[string]metav1.APIResource{
+			"deployments": {Name: "deployments", SingularName: "deployment", Namespaced: true, Kind: "Deployment", Verbs: []string{"create", "delete", "get", "list", "patch", "update", "watch"}, ShortNames: []string{"deploy"}},
+		},
+		verbMap: map[string]map[string]command.Resource{},
+	}
+	evt := event.Event{
+		Resource:  "apps/v1/deployments",
+		Name:      "foo",
+		Namespace: "default",
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app.kubernetes.io/instance": "foo-release"},
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		EventType      config.EventType
+		ExpectedResult []Command
+	}{
+		{
+			Name:      "Update event",
+			EventType: config.UpdateEvent,
+			ExpectedResult: []Command{
+				{Name: "Restart", Cmd: "kubectl rollout restart deploy/foo -n default"},
+				{Name: "Helm history", Cmd: "helm history foo-release -n default"},
+			},
+		},
+		{
+			Name:      "Create event",
+			EventType: config.CreateEvent,
+			ExpectedResult: []Command{
+				{Name: "Helm history", Cmd: "helm history foo-release -n default"},
+			},
+		},
+		{
+			Name:           "Delete event",
+			EventType:      config.DeleteEvent,
+			ExpectedResult: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cmder, err := NewCommander(loggerx.NewNoop(), guard, config.Commands{
+				Verbs:     []string{"get", "rollout"},
+				Resources: []string{"deployments"},
+			}, resources)
+			require.NoError(t, err)
+
+			evt.Type = tc.EventType
+
+			// when
+			result, err := cmder.GetCommandsForEvent(evt)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedResult, result)
+		})
+	}
+}
+
+func TestCommander_GetCommandsForEvent_ResourceCommandsOutsideAllowlist(t *testing.T) {
+	// given
+	resources := []config.Resource{
+		{
+			Type: "apps/v1/deployments",
+			Commands: []config.ResourceCommand{
+				{DisplayName: "Restart", Command: "kubectl rollout restart deploy/{{ .Name }} -n {{ .Namespace }}"},
+				{DisplayName: "Scale down", Command: "kubectl scale deploy/{{ .Name }} --replicas=0 -n {{ .Namespace }}"},
+			},
+		},
+	}
+	guard := &fakeGuard{
+		resMap: map[string]metav1.APIResource{
+			"deployments": {Name: "deployments", SingularName: "deployment", Namespaced: true, Kind: "Deployment", Verbs: []string{"get", "list", "patch", "update", "watch"}, ShortNames: []string{"deploy"}},
+		},
+		verbMap: map[string]map[string]command.Resource{
+			"scale": {"deployments": {Name: "deployments", Namespaced: true}},
+		},
+	}
+	cmder, err := NewCommander(loggerx.NewNoop(), guard, config.Commands{
+		Verbs:     []string{"get", "scale"},
+		Resources: []string{"pods"},
+	}, resources)
+	require.NoError(t, err)
+
+	// when
+	result, err := cmder.GetCommandsForEvent(event.Event{
+		Resource:  "apps/v1/deployments",
+		Name:      "foo",
+		Namespace: "default",
+		Type:      config.CreateEvent,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Command{
+		{Name: "scale", Cmd: "kubectl scale deployments foo --namespace default"},
+	}, result)
+}
+
+func TestValidateResourceCommands(t *testing.T) {
+	// given
+	resources := []config.Resource{
+		{
+			Type: "v1/pods",
+			Commands: []config.ResourceCommand{
+				{DisplayName: "Logs", Command: "kubectl logs {{ .Name"},
+			},
+		},
+	}
+
+	// when
+	err := ValidateResourceCommands(resources)
+
+	// then
+	assert.EqualError(t, err, `while parsing "Logs" command for resource "v1/pods": template: Logs:1: unclosed action`)
+}
+
 type fakeGuard struct {
 	resMap  map[string]metav1.APIResource
 	verbMap map[string]map[string]command.Resource
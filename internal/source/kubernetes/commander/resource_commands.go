@@ -0,0 +1,234 @@
+package commander
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	sprig "github.com/go-task/slim-sprig"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/internal/command"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+// kubectlSubcommandAPIVerbs contains kubectl commands which are followed by a subcommand, together with
+// the API verb required to execute them. For example, `kubectl rollout restart` patches the given resource.
+var kubectlSubcommandAPIVerbs = map[string]string{
+	"rollout": "patch",
+}
+
+// CommandTemplateData holds data available in resource command templates.
+type CommandTemplateData struct {
+	Name        string
+	Namespace   string
+	Kind        string
+	APIVersion  string
+	Resource    string
+	Cluster     string
+	Type        config.EventType
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+type resourceCommand struct {
+	displayName string
+	tpl         *template.Template
+	events      []config.EventType
+}
+
+// ValidateResourceCommands returns an error if any of the resource command templates is invalid.
+func ValidateResourceCommands(resources []config.Resource) error {
+	_, err := parseResourceCommands(resources)
+	return err
+}
+
+func parseResourceCommands(resources []config.Resource) (map[string][]resourceCommand, error) {
+	out := make(map[string][]resourceCommand)
+	for _, res := range resources {
+		for idx, cmd := range res.Commands {
+			if cmd.DisplayName == "" {
+				return nil, fmt.Errorf("command #%d for resource %q: display name cannot be empty", idx, res.Type)
+			}
+			tpl, err := template.New(cmd.DisplayName).Funcs(sprig.TxtFuncMap()).Parse(cmd.Command)
+			if err != nil {
+				return nil, fmt.Errorf("while parsing %q command for resource %q: %w", cmd.DisplayName, res.Type, err)
+			}
+			out[res.Type] = append(out[res.Type], resourceCommand{
+				displayName: cmd.DisplayName,
+				tpl:         tpl,
+				events:      cmd.Events,
+			})
+		}
+	}
+	return out, nil
+}
+
+func (c *Commander) resourceCommandsForEvent(event event.Event) ([]Command, error) {
+	cmds := c.resourceCommands[event.Resource]
+	if len(cmds) == 0 {
+		return nil, nil
+	}
+
+	data := CommandTemplateData{
+		Name:        event.Name,
+		Namespace:   event.Namespace,
+		Kind:        event.Kind,
+		APIVersion:  event.APIVersion,
+		Resource:    event.Resource,
+		Cluster:     event.Cluster,
+		Type:        event.Type,
+		Labels:      event.ObjectMeta.Labels,
+		Annotations: event.ObjectMeta.Annotations,
+	}
+
+	var resMap map[string]metav1.APIResource
+	var out []Command
+	for _, cmd := range cmds {
+		if !isVisibleForEventType(cmd.events, event.Type) {
+			continue
+		}
+
+		var buff bytes.Buffer
+		if err := cmd.tpl.Execute(&buff, data); err != nil {
+			c.log.Errorf("while rendering %q command for %q: %s", cmd.displayName, event.Resource, err.Error())
+			continue
+		}
+		rendered := strings.Join(strings.Fields(buff.String()), " ")
+		if rendered == "" {
+			continue
+		}
+
+		if isKubectlCommand(rendered) {
+			if resMap == nil {
+				var err error
+				resMap, err = c.guard.GetServerResourceMap()
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			allowed, err := c.isKubectlCommandAllowed(rendered, resMap)
+			if err != nil {
+				return nil, fmt.Errorf("while checking %q command: %w", cmd.displayName, err)
+			}
+			if !allowed {
+				c.log.Debugf("Command %q is not supported. Skipping...", rendered)
+				continue
+			}
+		}
+
+		out = append(out, Command{
+			Name: cmd.displayName,
+			Cmd:  rendered,
+		})
+	}
+
+	return out, nil
+}
+
+// isKubectlCommandAllowed checks whether the verb used in a given kubectl command is supported for the given resource.
+// Both the verb and the resource have to be allowed in the commands configuration, the same as for the built-in commands.
+func (c *Commander) isKubectlCommandAllowed(cmd string, resMap map[string]metav1.APIResource) (bool, error) {
+	args := strings.Fields(cmd)[1:]
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	verb := args[0]
+	apiVerb, hasSubcommand := kubectlSubcommandAPIVerbs[verb]
+	args = args[1:]
+	if hasSubcommand {
+		if len(args) == 0 {
+			return false, nil
+		}
+		args = args[1:]
+	}
+
+	resourceArg, found := firstPositionalArg(args)
+	if !found {
+		return false, nil
+	}
+	resourceName, found := resolveResourceName(strings.Split(resourceArg, "/")[0], resMap)
+	if !found {
+		return false, nil
+	}
+	if !c.isAllowedByConfig(verb, resourceName) {
+		return false, nil
+	}
+
+	if hasSubcommand {
+		res := resMap[resourceName]
+		for _, v := range res.Verbs {
+			if v == apiVerb {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	_, err := c.guard.GetResourceDetailsFromMap(verb, resourceName, resMap)
+	switch err {
+	case nil:
+		return true, nil
+	case command.ErrVerbNotSupported, command.ErrResourceNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("while getting resource details: %w", err)
+	}
+}
+
+func (c *Commander) isAllowedByConfig(verb, resourceName string) bool {
+	if _, unsupported := unsupportedEventCommandVerbs[verb]; unsupported {
+		return false
+	}
+	return slices.Contains(c.allowedVerbs, verb) && slices.Contains(c.allowedResources, resourceName)
+}
+
+func isKubectlCommand(cmd string) bool {
+	return strings.Fields(cmd)[0] == kubectlCmdName
+}
+
+func isVisibleForEventType(events []config.EventType, eventType config.EventType) bool {
+	if len(events) == 0 {
+		return eventType != config.DeleteEvent
+	}
+	for _, e := range events {
+		if e == eventType || e == config.AllEvent {
+			return true
+		}
+	}
+	return false
+}
+
+func firstPositionalArg(args []string) (string, bool) {
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+		return arg, true
+	}
+	return "", false
+}
+
+// resolveResourceName returns the plural resource name for a given resource name, its singular form or short name.
+func resolveResourceName(name string, resMap map[string]metav1.APIResource) (string, bool) {
+	name = strings.ToLower(name)
+	if _, ok := resMap[name]; ok {
+		return name, true
+	}
+	for plural, res := range resMap {
+		if res.SingularName == name || strings.ToLower(res.Kind) == name {
+			return plural, true
+		}
+		for _, short := range res.ShortNames {
+			if short == name {
+				return plural, true
+			}
+		}
+	}
+	return "", false
+}
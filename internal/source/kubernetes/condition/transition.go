@@ -0,0 +1,112 @@
+package condition
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Transition describes a status change of a given condition.
+type Transition struct {
+	Type      string
+	OldStatus string
+	NewStatus string
+	Reason    string
+	Message   string
+	// Duration is the time spent in the previous status.
+	// It's zero if the condition doesn't report the last transition time.
+	Duration time.Duration
+}
+
+// Messages returns human-readable messages describing the transition.
+func (t Transition) Messages() []string {
+	msg := fmt.Sprintf("Condition %q changed from %q to %q", t.Type, t.OldStatus, t.NewStatus)
+	if t.Duration > 0 {
+		msg = fmt.Sprintf("%s after %s", msg, t.Duration)
+	}
+
+	out := []string{msg}
+	if t.Message != "" {
+		out = append(out, t.Message)
+	}
+	return out
+}
+
+// unknownStatus is the status of a condition which isn't reported yet.
+const unknownStatus = "Unknown"
+
+type condition struct {
+	status             string
+	reason             string
+	message            string
+	lastTransitionTime time.Time
+}
+
+// Detect returns status transitions of given condition types between the old and new object version.
+// A condition which is added is reported as a transition from the Unknown status. Removed conditions are not reported.
+func Detect(oldObj, newObj *unstructured.Unstructured, types []string) []Transition {
+	if oldObj == nil || newObj == nil {
+		return nil
+	}
+
+	oldConds, newConds := conditions(oldObj), conditions(newObj)
+
+	var out []Transition
+	for _, condType := range types {
+		newCond, found := newConds[condType]
+		if !found {
+			continue
+		}
+		oldCond, found := oldConds[condType]
+		if !found {
+			oldCond = condition{status: unknownStatus}
+		}
+		if oldCond.status == newCond.status {
+			continue
+		}
+
+		tr := Transition{
+			Type:      condType,
+			OldStatus: oldCond.status,
+			NewStatus: newCond.status,
+			Reason:    newCond.reason,
+			Message:   newCond.message,
+		}
+		if !oldCond.lastTransitionTime.IsZero() && newCond.lastTransitionTime.After(oldCond.lastTransitionTime) {
+			tr.Duration = newCond.lastTransitionTime.Sub(oldCond.lastTransitionTime).Round(time.Second)
+		}
+		out = append(out, tr)
+	}
+	return out
+}
+
+func conditions(obj *unstructured.Unstructured) map[string]condition {
+	items, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	out := make(map[string]condition, len(items))
+	for _, item := range items {
+		cond, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condType, _, _ := unstructured.NestedString(cond, "type")
+		if condType == "" {
+			continue
+		}
+
+		status, _, _ := unstructured.NestedString(cond, "status")
+		reason, _, _ := unstructured.NestedString(cond, "reason")
+		message, _, _ := unstructured.NestedString(cond, "message")
+		rawTime, _, _ := unstructured.NestedString(cond, "lastTransitionTime")
+		// the time is optional and zero value is fine if it can't be parsed
+		lastTransitionTime, _ := time.Parse(time.RFC3339, rawTime)
+
+		out[condType] = condition{
+			status:             status,
+			reason:             reason,
+			message:            message,
+			lastTransitionTime: lastTransitionTime,
+		}
+	}
+	return out
+}
@@ -0,0 +1,148 @@
+package condition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDetect(t *testing.T) {
+	// given
+	readySince := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	notReadySince := readySince.Add(3*time.Hour + 2*time.Minute)
+
+	tests := []struct {
+		name     string
+		oldObj   *unstructured.Unstructured
+		newObj   *unstructured.Unstructured
+		types    []string
+		expected []Transition
+	}{
+		{
+			name: "Ready changed from True to False",
+			oldObj: fixObject(
+				fixCondition("Ready", "True", "Ready", "Certificate is up to date and has not expired", readySince),
+				fixCondition("Issuing", "False", "", "", readySince),
+			),
+			newObj: fixObject(
+				fixCondition("Ready", "False", "Expired", "Certificate expired on 2023-03-01", notReadySince),
+				fixCondition("Issuing", "True", "Expired", "Renewing certificate as it expired", notReadySince),
+			),
+			types: []string{"Ready"},
+			expected: []Transition{
+				{
+					Type:      "Ready",
+					OldStatus: "True",
+					NewStatus: "False",
+					Reason:    "Expired",
+					Message:   "Certificate expired on 2023-03-01",
+					Duration:  3*time.Hour + 2*time.Minute,
+				},
+			},
+		},
+		{
+			name:   "Multiple conditions without transition time",
+			oldObj: fixObject(fixCondition("Ready", "True", "", "", time.Time{}), fixCondition("Degraded", "False", "", "", time.Time{})),
+			newObj: fixObject(fixCondition("Ready", "Unknown", "", "", time.Time{}), fixCondition("Degraded", "True", "Crashing", "", time.Time{})),
+			types:  []string{"Ready", "Degraded"},
+			expected: []Transition{
+				{Type: "Ready", OldStatus: "True", NewStatus: "Unknown"},
+				{Type: "Degraded", OldStatus: "False", NewStatus: "True", Reason: "Crashing"},
+			},
+		},
+		{
+			name:   "Same status with a different message",
+			oldObj: fixObject(fixCondition("Ready", "False", "Pending", "Waiting for 1 replica", readySince)),
+			newObj: fixObject(fixCondition("Ready", "False", "Pending", "Waiting for 2 replicas", readySince)),
+			types:  []string{"Ready"},
+		},
+		{
+			name:   "Condition added",
+			oldObj: fixObject(),
+			newObj: fixObject(fixCondition("Ready", "False", "Pending", "", readySince)),
+			types:  []string{"Ready"},
+			expected: []Transition{
+				{Type: "Ready", OldStatus: "Unknown", NewStatus: "False", Reason: "Pending"},
+			},
+		},
+		{
+			name:   "Condition added with Unknown status",
+			oldObj: fixObject(),
+			newObj: fixObject(fixCondition("Ready", "Unknown", "", "", readySince)),
+			types:  []string{"Ready"},
+		},
+		{
+			name:   "Condition removed",
+			oldObj: fixObject(fixCondition("Ready", "True", "", "", readySince)),
+			newObj: fixObject(),
+			types:  []string{"Ready"},
+		},
+		{
+			name:   "Not watched condition",
+			oldObj: fixObject(fixCondition("Ready", "True", "", "", readySince)),
+			newObj: fixObject(fixCondition("Ready", "False", "", "", notReadySince)),
+			types:  []string{"Synced"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := Detect(tc.oldObj, tc.newObj, tc.types)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTransition_Messages(t *testing.T) {
+	// given
+	tr := Transition{
+		Type:      "Ready",
+		OldStatus: "True",
+		NewStatus: "False",
+		Reason:    "Expired",
+		Message:   "Certificate expired on 2023-03-01",
+		Duration:  3*time.Hour + 2*time.Minute,
+	}
+
+	// when
+	msgs := tr.Messages()
+
+	// then
+	assert.Equal(t, []string{
+		`Condition "Ready" changed from "True" to "False" after 3h2m0s`,
+		"Certificate expired on 2023-03-01",
+	}, msgs)
+}
+
+func fixObject(conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      "web-tls",
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"conditions": conditions,
+			},
+		},
+	}
+}
+
+func fixCondition(condType, status, reason, message string, lastTransitionTime time.Time) interface{} {
+	cond := map[string]interface{}{
+		"type":    condType,
+		"status":  status,
+		"reason":  reason,
+		"message": message,
+	}
+	if !lastTransitionTime.IsZero() {
+		cond["lastTransitionTime"] = lastTransitionTime.Format(time.RFC3339)
+	}
+	return cond
+}
@@ -24,6 +24,9 @@ type Config struct {
 	Annotations          *map[string]string `yaml:"annotations"`
 	Labels               *map[string]string `yaml:"labels"`
 	Filters              *Filters           `yaml:"filters"`
+	OwnerRollup          *OwnerRollup       `yaml:"ownerRollup"`
+	Templates            []MessageTemplate  `yaml:"templates"`
+	Replay               *Replay            `yaml:"replay"`
 }
 
 // Commands contains allowed verbs and resources
@@ -34,8 +37,10 @@ type Commands struct {
 
 // Recommendations contains configuration for various recommendation insights.
 type Recommendations struct {
-	Ingress IngressRecommendations `yaml:"ingress"`
-	Pod     PodRecommendations     `yaml:"pod"`
+	Ingress    IngressRecommendations    `yaml:"ingress"`
+	Pod        PodRecommendations        `yaml:"pod"`
+	Deployment DeploymentRecommendations `yaml:"deployment"`
+	Service    ServiceRecommendations    `yaml:"service"`
 }
 
 // IngressRecommendations contains configuration for ingress recommendations.
@@ -54,6 +59,30 @@ type PodRecommendations struct {
 
 	// LabelsSet notifies about Pod resources created without labels.
 	LabelsSet *bool `yaml:"labelsSet,omitempty"`
+
+	// ResourcesSet notifies about Pod containers without CPU and memory requests or limits.
+	ResourcesSet *bool `yaml:"resourcesSet,omitempty"`
+
+	// ProbesSet notifies about Pod containers without liveness or readiness probes.
+	ProbesSet *bool `yaml:"probesSet,omitempty"`
+
+	// NoPrivilegedContainers notifies about privileged Pod containers and containers running as root.
+	NoPrivilegedContainers *bool `yaml:"noPrivilegedContainers,omitempty"`
+
+	// NoHostPathVolumes notifies about Pods which mount hostPath volumes.
+	NoHostPathVolumes *bool `yaml:"noHostPathVolumes,omitempty"`
+}
+
+// DeploymentRecommendations contains configuration for deployments recommendations.
+type DeploymentRecommendations struct {
+	// PodDisruptionBudgetSet notifies about Deployments with more than one replica without matching PodDisruptionBudget.
+	PodDisruptionBudgetSet *bool `yaml:"podDisruptionBudgetSet,omitempty"`
+}
+
+// ServiceRecommendations contains configuration for services recommendations.
+type ServiceRecommendations struct {
+	// SelectorMatchesPods notifies about Services which selector doesn't match any Pod.
+	SelectorMatchesPods *bool `yaml:"selectorMatchesPods,omitempty"`
 }
 
 // KubernetesEvent contains configuration for Kubernetes events.
@@ -161,8 +190,38 @@ const (
 	InfoEvent EventType = "info"
 	// AllEvent to watch all events
 	AllEvent EventType = "all"
+
+	// RolloutStartedEvent when a new rollout of Deployment, StatefulSet or DaemonSet is started
+	RolloutStartedEvent EventType = "rolloutStarted"
+	// RolloutSucceededEvent when all replicas were updated and are available
+	RolloutSucceededEvent EventType = "rolloutSucceeded"
+	// RolloutStuckEvent when Deployment exceeded its progress deadline
+	RolloutStuckEvent EventType = "rolloutStuck"
+	// RolloutRolledBackEvent when a rollout to one of the previous revisions is started
+	RolloutRolledBackEvent EventType = "rolloutRolledBack"
+
+	// ConditionChangedEvent when one of the configured status conditions changed its status
+	ConditionChangedEvent EventType = "conditionChanged"
 )
 
+// RolloutEventTypes contains all event types derived from rollout status transitions.
+var RolloutEventTypes = []EventType{
+	RolloutStartedEvent,
+	RolloutSucceededEvent,
+	RolloutStuckEvent,
+	RolloutRolledBackEvent,
+}
+
+// IsRolloutEvent returns true if a given event type is derived from rollout status transitions.
+func (eventType EventType) IsRolloutEvent() bool {
+	for _, e := range RolloutEventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 func (eventType EventType) String() string {
 	return string(eventType)
 }
@@ -176,6 +235,122 @@ type Resource struct {
 	Labels        map[string]string `yaml:"labels"`
 	Event         KubernetesEvent   `yaml:"event"`
 	UpdateSetting UpdateSetting     `yaml:"updateSetting"`
+	Owner         OwnerConstraints  `yaml:"owner"`
+	Commands      []ResourceCommand `yaml:"commands"`
+	// Conditions contains status conditions watched for the "conditionChanged" event type.
+	// If empty, DefaultResourceConditions are used.
+	Conditions []ResourceCondition `yaml:"conditions"`
+	// MetadataOnly caches only object metadata if the resource is watched only for create and delete events.
+	// Events sent to sinks and actions don't contain the object spec and status then.
+	MetadataOnly bool `yaml:"metadataOnly"`
+}
+
+// ResourceCondition defines a status condition which transitions are reported.
+type ResourceCondition struct {
+	// Type is the condition type, such as "Ready".
+	Type string `yaml:"type"`
+	// HealthyStatus is the condition status which means that the resource is healthy. Defaults to "True".
+	// Use "False" for abnormal-true conditions, such as "Degraded" or "Stalled".
+	HealthyStatus string `yaml:"healthyStatus"`
+}
+
+// DefaultResourceConditions contains conditions watched if the "conditionChanged" event type is enabled without conditions.
+var DefaultResourceConditions = []ResourceCondition{
+	{Type: "Ready"},
+}
+
+// IsHealthy returns true if a given status means that the resource is healthy.
+func (c ResourceCondition) IsHealthy(status string) bool {
+	healthy := c.HealthyStatus
+	if healthy == "" {
+		healthy = "True"
+	}
+	return strings.EqualFold(status, healthy)
+}
+
+// ResourceCommand defines a suggested command displayed for events of a given resource.
+type ResourceCommand struct {
+	// DisplayName is the name displayed in the commands dropdown.
+	DisplayName string `yaml:"displayName"`
+	// Command is a Go template of the command, e.g. `kubectl rollout restart deploy/{{ .Name }} -n {{ .Namespace }}`.
+	// Template has access to the event object name, namespace, kind, labels and annotations.
+	Command string `yaml:"command"`
+	// Events contains event types for which the command is displayed. If empty, the command is displayed for all events apart from delete.
+	Events []EventType `yaml:"events"`
+}
+
+// OwnerConstraints contains constraints for the top-level owner of a given resource, such as Deployment for a Pod.
+type OwnerConstraints struct {
+	Kind RegexConstraints `yaml:"kind"`
+	Name RegexConstraints `yaml:"name"`
+}
+
+// AreConstraintsDefined checks if any of the owner constraints are defined.
+func (o OwnerConstraints) AreConstraintsDefined() bool {
+	return o.Kind.AreConstraintsDefined() || o.Name.AreConstraintsDefined()
+}
+
+// OwnerRollup contains configuration for reporting events against the top-level owner of a given object.
+type OwnerRollup struct {
+	// Enabled resolves owner references (e.g. Pod -> ReplicaSet -> Deployment) and reports events against the top-level workload.
+	Enabled bool `yaml:"enabled"`
+}
+
+// Replay contains configuration for replaying events emitted while the source wasn't running, e.g. during Botkube restart or upgrade.
+type Replay struct {
+	// Enabled replays core/v1 Events newer than the last delivered one, which is persisted in the checkpoint.
+	Enabled bool `yaml:"enabled"`
+	// Window limits how old the replayed events can be.
+	Window     time.Duration    `yaml:"window"`
+	Checkpoint ReplayCheckpoint `yaml:"checkpoint"`
+}
+
+// ReplayCheckpoint describes the ConfigMap in which the last delivered event is persisted.
+type ReplayCheckpoint struct {
+	Namespace string `yaml:"namespace"`
+	Name      string `yaml:"name"`
+	// Key is the ConfigMap data key under which the checkpoint is stored. It must be unique per source.
+	// If empty, the source name is used. The key is suffixed with `.interactive` or `.non-interactive`,
+	// as the source is streamed separately for interactive and non-interactive platforms.
+	Key string `yaml:"key"`
+	// Interval defines how often the checkpoint is persisted. It's also persisted when the source is stopped.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// MessageTemplate defines a custom notification layout rendered with Go templates.
+// Templates have access to the event (`.Event`), the involved object in the unstructured form (`.Object`)
+// and helper functions, such as the ones from sprig library, `age` and `owner`.
+type MessageTemplate struct {
+	// Trigger defines for which events the template is used. The first matching template wins.
+	Trigger MessageTemplateTrigger `yaml:"trigger"`
+	// Header is a template for the message header.
+	Header string `yaml:"header"`
+	// TextFields are rendered as key-value pairs. Fields with empty value are skipped.
+	TextFields []TemplateTextField `yaml:"textFields"`
+	// BulletLists are rendered as titled lists. Every non-empty line of the rendered items template is a separate item.
+	BulletLists []TemplateBulletList `yaml:"bulletLists"`
+	// Context contains templates for context lines displayed at the bottom of the message.
+	Context []string `yaml:"context"`
+}
+
+// MessageTemplateTrigger defines when a given message template is used.
+type MessageTemplateTrigger struct {
+	// Resources contains resource types, e.g. `apps/v1/deployments`. If empty, all resources are matched.
+	Resources []string `yaml:"resources"`
+	// Events contains event types. If empty, all event types are matched.
+	Events []EventType `yaml:"events"`
+}
+
+// TemplateTextField defines a text field with a templated value.
+type TemplateTextField struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// TemplateBulletList defines a bullet list with templated items.
+type TemplateBulletList struct {
+	Title string `yaml:"title"`
+	Items string `yaml:"items"`
 }
 
 // UpdateSetting struct defines updateEvent fields specification
@@ -202,13 +377,23 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 		InformerResyncPeriod: 30 * time.Minute,
 		Recommendations: &Recommendations{
 			Pod: PodRecommendations{
-				NoLatestImageTag: ptr.Bool(false),
-				LabelsSet:        ptr.Bool(false),
+				NoLatestImageTag:       ptr.Bool(false),
+				LabelsSet:              ptr.Bool(false),
+				ResourcesSet:           ptr.Bool(false),
+				ProbesSet:              ptr.Bool(false),
+				NoPrivilegedContainers: ptr.Bool(false),
+				NoHostPathVolumes:      ptr.Bool(false),
 			},
 			Ingress: IngressRecommendations{
 				BackendServiceValid: ptr.Bool(false),
 				TLSSecretValid:      ptr.Bool(false),
 			},
+			Deployment: DeploymentRecommendations{
+				PodDisruptionBudgetSet: ptr.Bool(false),
+			},
+			Service: ServiceRecommendations{
+				SelectorMatchesPods: ptr.Bool(false),
+			},
 		},
 		Commands: Commands{
 			Verbs:     []string{"api-resources", "api-versions", "cluster-info", "describe", "explain", "get", "logs", "top"},
@@ -218,6 +403,18 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 			ObjectAnnotationChecker: true,
 			NodeEventsChecker:       true,
 		},
+		OwnerRollup: &OwnerRollup{
+			Enabled: false,
+		},
+		Replay: &Replay{
+			Enabled: false,
+			Window:  time.Hour,
+			Checkpoint: ReplayCheckpoint{
+				Namespace: "botkube",
+				Name:      "botkube-kubernetes-checkpoints",
+				Interval:  10 * time.Second,
+			},
+		},
 	}
 	var out Config
 	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
@@ -36,6 +36,12 @@ type Event struct {
 	Warnings        []string
 	Actions         []Action
 
+	// Owners contains the owner reference chain of the involved object, starting from the direct owner.
+	// It is resolved only if owner rollup is enabled or owner constraints are defined.
+	Owners []k8sutil.OwnerReference `json:",omitempty"`
+	// Children lists objects affected by the event, if it was rolled up to the top-level owner.
+	Children []string `json:",omitempty"`
+
 	// The following fields are ignored when marshalling the event by purpose.
 	// We send the whole Event struct via sink.Elasticsearch integration.
 	// When using ELS dynamic mapping, we should avoid complex, dynamic objects, which could result into type conflicts.
@@ -56,6 +62,35 @@ func (e *Event) HasRecommendationsOrWarnings() bool {
 	return len(e.Recommendations) > 0 || len(e.Warnings) > 0
 }
 
+// TopLevelOwner returns the top-level owner of the involved object, if resolved.
+func (e *Event) TopLevelOwner() (k8sutil.OwnerReference, bool) {
+	if len(e.Owners) == 0 {
+		return k8sutil.OwnerReference{}, false
+	}
+	return e.Owners[len(e.Owners)-1], true
+}
+
+// RollUpToTopLevelOwner reports the event against the top-level owner of the involved object.
+// The involved object and intermediate owners are listed as children.
+func (e *Event) RollUpToTopLevelOwner() {
+	owner, ok := e.TopLevelOwner()
+	if !ok {
+		return
+	}
+
+	// children are listed starting from the one closest to the top-level owner
+	for i := len(e.Owners) - 2; i >= 0; i-- {
+		e.Children = append(e.Children, e.Owners[i].String())
+	}
+	e.Children = append(e.Children, fmt.Sprintf("%s/%s", e.Kind, e.Name))
+
+	e.APIVersion = owner.APIVersion
+	e.Kind = owner.Kind
+	e.Name = owner.Name
+	e.Resource = owner.Resource
+	e.Title = title(owner.Resource, e.Type)
+}
+
 // LevelMap is a map of event type to Level
 var LevelMap = map[config.EventType]config.Level{
 	config.NormalEvent:  config.Info,
@@ -66,6 +101,21 @@ var LevelMap = map[config.EventType]config.Level{
 	config.DeleteEvent:  config.Error,
 	config.ErrorEvent:   config.Error,
 	config.WarningEvent: config.Error,
+
+	config.RolloutStartedEvent:    config.Info,
+	config.RolloutSucceededEvent:  config.Success,
+	config.RolloutStuckEvent:      config.Error,
+	config.RolloutRolledBackEvent: config.Error,
+
+	config.ConditionChangedEvent: config.Info,
+}
+
+// rolloutEventTitles contains human-readable titles for rollout event types.
+var rolloutEventTitles = map[config.EventType]string{
+	config.RolloutStartedEvent:    "rollout started",
+	config.RolloutSucceededEvent:  "rollout succeeded",
+	config.RolloutStuckEvent:      "rollout stuck",
+	config.RolloutRolledBackEvent: "rolled back",
 }
 
 // New extract required details from k8s object and returns new Event object
@@ -99,13 +149,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		}
 	}
 
-	switch eventType {
-	case config.ErrorEvent, config.InfoEvent:
-		event.Title = fmt.Sprintf("%s %s", resource, eventType.String())
-	default:
-		// Events like create, update, delete comes with an extra 'd' at the end
-		event.Title = fmt.Sprintf("%s %sd", resource, eventType.String())
-	}
+	event.Title = title(resource, eventType)
 
 	if typeMeta.Kind == "Event" {
 		var eventObj coreV1.Event
@@ -143,3 +187,17 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 
 	return event, nil
 }
+
+func title(resource string, eventType config.EventType) string {
+	switch eventType {
+	case config.ErrorEvent, config.InfoEvent:
+		return fmt.Sprintf("%s %s", resource, eventType.String())
+	case config.RolloutStartedEvent, config.RolloutSucceededEvent, config.RolloutStuckEvent, config.RolloutRolledBackEvent:
+		return fmt.Sprintf("%s %s", resource, rolloutEventTitles[eventType])
+	case config.ConditionChangedEvent:
+		return fmt.Sprintf("%s condition changed", resource)
+	default:
+		// Events like create, update, delete comes with an extra 'd' at the end
+		return fmt.Sprintf("%s %sd", resource, eventType.String())
+	}
+}
@@ -0,0 +1,103 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+func TestEvent_RollUpToTopLevelOwner(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name          string
+		InputEvent    Event
+		ExpectedEvent Event
+	}{
+		{
+			Name: "Pod owned by Deployment",
+			InputEvent: Event{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "app-5d4f8c-x2x9p",
+				Resource:   "v1/pods",
+				Type:       config.ErrorEvent,
+				Title:      "v1/pods error",
+				Owners: []k8sutil.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Resource: "apps/v1/replicasets"},
+					{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Resource: "apps/v1/deployments"},
+				},
+			},
+			ExpectedEvent: Event{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "app",
+				Resource:   "apps/v1/deployments",
+				Type:       config.ErrorEvent,
+				Title:      "apps/v1/deployments error",
+				Owners: []k8sutil.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Resource: "apps/v1/replicasets"},
+					{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Resource: "apps/v1/deployments"},
+				},
+				Children: []string{"ReplicaSet/app-5d4f8c", "Pod/app-5d4f8c-x2x9p"},
+			},
+		},
+		{
+			Name: "Job owned by CronJob",
+			InputEvent: Event{
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+				Name:       "backup-27891230",
+				Resource:   "batch/v1/jobs",
+				Type:       config.UpdateEvent,
+				Title:      "batch/v1/jobs updated",
+				Owners: []k8sutil.OwnerReference{
+					{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup", Resource: "batch/v1/cronjobs"},
+				},
+			},
+			ExpectedEvent: Event{
+				APIVersion: "batch/v1",
+				Kind:       "CronJob",
+				Name:       "backup",
+				Resource:   "batch/v1/cronjobs",
+				Type:       config.UpdateEvent,
+				Title:      "batch/v1/cronjobs updated",
+				Owners: []k8sutil.OwnerReference{
+					{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup", Resource: "batch/v1/cronjobs"},
+				},
+				Children: []string{"Job/backup-27891230"},
+			},
+		},
+		{
+			Name: "Object without owners",
+			InputEvent: Event{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "cfg",
+				Resource:   "v1/configmaps",
+				Type:       config.CreateEvent,
+				Title:      "v1/configmaps created",
+			},
+			ExpectedEvent: Event{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "cfg",
+				Resource:   "v1/configmaps",
+				Type:       config.CreateEvent,
+				Title:      "v1/configmaps created",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			tc.InputEvent.RollUpToTopLevelOwner()
+
+			// then
+			assert.Equal(t, tc.ExpectedEvent, tc.InputEvent)
+		})
+	}
+}
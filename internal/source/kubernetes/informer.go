@@ -0,0 +1,368 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+)
+
+// informerOptions describes which objects of a given resource are listed, watched and cached.
+type informerOptions struct {
+	// Namespaces contains exact Namespace names to watch. If empty, resource is watched cluster-wide.
+	Namespaces    []string
+	LabelSelector string
+	FieldSelector string
+	// MetadataOnly caches only object metadata instead of the whole object.
+	MetadataOnly bool
+}
+
+func (o informerOptions) key(gvr schema.GroupVersionResource, namespace string) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%t", gvr.String(), namespace, o.LabelSelector, o.FieldSelector, o.MetadataOnly)
+}
+
+// informerGroup groups informers created for the same resource watched in multiple Namespaces.
+type informerGroup []cache.SharedIndexInformer
+
+// AddEventHandler adds a given event handler to all informers within the group.
+func (g informerGroup) AddEventHandler(handler cache.ResourceEventHandler) {
+	for _, informer := range g {
+		informer.AddEventHandler(handler)
+	}
+}
+
+// informerFactory creates informers for resources based on the informerOptions.
+// Informers with the same options are shared.
+type informerFactory struct {
+	log          logrus.FieldLogger
+	dynamicCli   dynamic.Interface
+	metadataCli  metadata.Interface
+	mapper       meta.RESTMapper
+	resyncPeriod time.Duration
+
+	mu        sync.Mutex
+	informers map[string]cache.SharedIndexInformer
+}
+
+func newInformerFactory(log logrus.FieldLogger, dynamicCli dynamic.Interface, metadataCli metadata.Interface, mapper meta.RESTMapper, resyncPeriod time.Duration) *informerFactory {
+	return &informerFactory{
+		log:          log,
+		dynamicCli:   dynamicCli,
+		metadataCli:  metadataCli,
+		mapper:       mapper,
+		resyncPeriod: resyncPeriod,
+		informers:    make(map[string]cache.SharedIndexInformer),
+	}
+}
+
+// ForResource returns informers for a given resource.
+func (f *informerFactory) ForResource(gvr schema.GroupVersionResource, opts informerOptions) (informerGroup, error) {
+	namespaces := opts.Namespaces
+	if len(namespaces) > 0 {
+		namespaced, err := f.isNamespaced(gvr)
+		if err != nil {
+			return nil, err
+		}
+		if !namespaced {
+			namespaces = nil
+		}
+	}
+	if len(namespaces) == 0 {
+		namespaces = []string{metaV1.NamespaceAll}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out informerGroup
+	for _, ns := range namespaces {
+		key := opts.key(gvr, ns)
+		informer, ok := f.informers[key]
+		if !ok {
+			var err error
+			informer, err = f.newInformer(gvr, ns, opts)
+			if err != nil {
+				return nil, err
+			}
+			f.informers[key] = informer
+		}
+		out = append(out, informer)
+	}
+
+	f.log.WithFields(logrus.Fields{
+		"resource":      gvr.String(),
+		"namespaces":    opts.Namespaces,
+		"labelSelector": opts.LabelSelector,
+		"fieldSelector": opts.FieldSelector,
+		"metadataOnly":  opts.MetadataOnly,
+	}).Debug("Using informers for resource")
+
+	return out, nil
+}
+
+// Start starts all created informers.
+func (f *informerFactory) Start(stopCh <-chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, informer := range f.informers {
+		go informer.Run(stopCh)
+	}
+}
+
+// WaitForCacheSync waits for caches of all started informers to be synced.
+// It returns false if the stop channel was closed before that.
+func (f *informerFactory) WaitForCacheSync(stopCh <-chan struct{}) bool {
+	f.mu.Lock()
+	var synced []cache.InformerSynced
+	for _, informer := range f.informers {
+		synced = append(synced, informer.HasSynced)
+	}
+	f.mu.Unlock()
+
+	return cache.WaitForCacheSync(stopCh, synced...)
+}
+
+// WaitForResourceSync waits for caches of started informers of a given resource to be synced.
+// It returns false if the stop channel was closed before that.
+func (f *informerFactory) WaitForResourceSync(stopCh <-chan struct{}, gvr schema.GroupVersionResource) bool {
+	prefix := gvr.String() + "|"
+
+	f.mu.Lock()
+	var synced []cache.InformerSynced
+	for key, informer := range f.informers {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		synced = append(synced, informer.HasSynced)
+	}
+	f.mu.Unlock()
+
+	return cache.WaitForCacheSync(stopCh, synced...)
+}
+
+func (f *informerFactory) newInformer(gvr schema.GroupVersionResource, namespace string, opts informerOptions) (cache.SharedIndexInformer, error) {
+	tweakListOptions := func(listOpts *metaV1.ListOptions) {
+		listOpts.LabelSelector = opts.LabelSelector
+		listOpts.FieldSelector = opts.FieldSelector
+	}
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+
+	if !opts.MetadataOnly {
+		return dynamicinformer.NewFilteredDynamicInformer(f.dynamicCli, gvr, namespace, f.resyncPeriod, indexers, tweakListOptions).Informer(), nil
+	}
+
+	gvk, err := f.mapper.KindFor(gvr)
+	if err != nil {
+		return nil, fmt.Errorf("while getting kind for %q: %w", gvr.String(), err)
+	}
+
+	informer := metadatainformer.NewFilteredMetadataInformer(f.metadataCli, gvr, namespace, f.resyncPeriod, indexers, tweakListOptions).Informer()
+	if err := informer.SetTransform(metadataToUnstructured(gvk)); err != nil {
+		return nil, fmt.Errorf("while setting transform function: %w", err)
+	}
+	return informer, nil
+}
+
+func (f *informerFactory) isNamespaced(gvr schema.GroupVersionResource) (bool, error) {
+	gvk, err := f.mapper.KindFor(gvr)
+	if err != nil {
+		return false, fmt.Errorf("while getting kind for %q: %w", gvr.String(), err)
+	}
+	mapping, err := f.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, fmt.Errorf("while getting REST mapping for %q: %w", gvk.String(), err)
+	}
+	return mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+// metadataToUnstructured converts objects returned by metadata informers into the unstructured form, so
+// they can be handled in the same way as the ones from dynamic informers. The metadata API doesn't return
+// the object type, so it's set based on a given GroupVersionKind.
+func metadataToUnstructured(gvk schema.GroupVersionKind) cache.TransformFunc {
+	var transform cache.TransformFunc
+	transform = func(obj interface{}) (interface{}, error) {
+		switch in := obj.(type) {
+		case *metaV1.PartialObjectMetadata:
+			out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(in)
+			if err != nil {
+				return nil, err
+			}
+			unstr := &unstructured.Unstructured{Object: out}
+			unstr.SetGroupVersionKind(gvk)
+			return unstr, nil
+		case cache.DeletedFinalStateUnknown:
+			finalObj, err := transform(in.Obj)
+			if err != nil {
+				return nil, err
+			}
+			in.Obj = finalObj
+			return in, nil
+		default:
+			return obj, nil
+		}
+	}
+	return transform
+}
+
+// informerOptionsForRoutes returns informer options that narrow down the cached objects, so only the ones
+// which can be matched by any of the given routes are listed and watched.
+// Only metadata is cached if all routes opted in for it, as sinks, actions and filters may use the whole object.
+func informerOptionsForRoutes(routes []route, events []config.EventType, fullObjectRequired bool) informerOptions {
+	return informerOptions{
+		Namespaces:    literalNamespacesForRoutes(routes),
+		LabelSelector: commonLabelSelectorForRoutes(routes),
+		FieldSelector: nameFieldSelectorForRoutes(routes),
+		MetadataOnly:  !fullObjectRequired && onlyCreateOrDeleteEvents(events) && allRoutesMetadataOnly(routes),
+	}
+}
+
+func allRoutesMetadataOnly(routes []route) bool {
+	if len(routes) == 0 {
+		return false
+	}
+	for _, rt := range routes {
+		if !rt.metadataOnly {
+			return false
+		}
+	}
+	return true
+}
+
+// literalNamespacesForRoutes returns Namespaces if all routes have only exact Namespace names defined in the include list.
+func literalNamespacesForRoutes(routes []route) []string {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	set := map[string]struct{}{}
+	for _, rt := range routes {
+		literals, ok := literalValues(rt.namespaces)
+		if !ok {
+			return nil
+		}
+		for _, ns := range literals {
+			set[ns] = struct{}{}
+		}
+	}
+
+	var out []string
+	for ns := range set {
+		out = append(out, ns)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// commonLabelSelectorForRoutes returns label selector for labels required by all routes.
+func commonLabelSelectorForRoutes(routes []route) string {
+	if len(routes) == 0 {
+		return ""
+	}
+
+	var common map[string]string
+	for i, rt := range routes {
+		if rt.labels == nil || len(*rt.labels) == 0 {
+			return ""
+		}
+		if i == 0 {
+			common = make(map[string]string, len(*rt.labels))
+			for k, v := range *rt.labels {
+				common[k] = v
+			}
+			continue
+		}
+		for k, v := range common {
+			if got, ok := (*rt.labels)[k]; !ok || got != v {
+				delete(common, k)
+			}
+		}
+	}
+
+	var parts []string
+	for k, v := range common {
+		parts = append(parts, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// nameFieldSelectorForRoutes returns field selector if all routes include exactly the same single object name.
+func nameFieldSelectorForRoutes(routes []route) string {
+	if len(routes) == 0 {
+		return ""
+	}
+
+	var name string
+	for _, rt := range routes {
+		literals, ok := literalValues(&rt.resourceName)
+		if !ok || len(literals) != 1 {
+			return ""
+		}
+		if name != "" && name != literals[0] {
+			return ""
+		}
+		name = literals[0]
+	}
+	return fmt.Sprintf("metadata.name=%s", name)
+}
+
+// literalValues returns exact names if there are no exclusions and all include values are anchored literals, such as `^default$`.
+// Other values are matched as unanchored regex expressions, e.g. `default` matches also `my-default-ns`, so they can't be used in selectors.
+func literalValues(constraints *config.RegexConstraints) ([]string, bool) {
+	if constraints == nil || len(constraints.Include) == 0 || len(constraints.Exclude) > 0 {
+		return nil, false
+	}
+	out := make([]string, 0, len(constraints.Include))
+	for _, val := range constraints.Include {
+		if len(val) < 3 || !strings.HasPrefix(val, "^") || !strings.HasSuffix(val, "$") {
+			return nil, false
+		}
+		name := val[1 : len(val)-1]
+		if regexp.QuoteMeta(name) != name {
+			return nil, false
+		}
+		out = append(out, name)
+	}
+	return out, true
+}
+
+func onlyCreateOrDeleteEvents(events []config.EventType) bool {
+	if len(events) == 0 {
+		return false
+	}
+	for _, e := range events {
+		if e != config.CreateEvent && e != config.DeleteEvent {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldSelectorForEventType returns field selector for core Events of a given type.
+func fieldSelectorForEventType(eventType config.EventType) string {
+	switch eventType {
+	case config.WarningEvent:
+		return "type=Warning"
+	case config.NormalEvent:
+		return "type=Normal"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,259 @@
+package kubernetes
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+)
+
+func TestInformerOptionsForRoutes(t *testing.T) {
+	tests := []struct {
+		name               string
+		givenRoutes        []route
+		givenEvents        []config.EventType
+		fullObjectRequired bool
+		expected           informerOptions
+	}{
+		{
+			name: "Anchored namespaces, common labels and single name",
+			givenRoutes: []route{
+				{
+					namespaces:   &config.RegexConstraints{Include: []string{"^prod$", "^default$"}},
+					labels:       &map[string]string{"app": "web", "team": "a"},
+					resourceName: config.RegexConstraints{Include: []string{"^web$"}},
+					metadataOnly: true,
+				},
+				{
+					namespaces:   &config.RegexConstraints{Include: []string{"^default$"}},
+					labels:       &map[string]string{"app": "web"},
+					resourceName: config.RegexConstraints{Include: []string{"^web$"}},
+					metadataOnly: true,
+				},
+			},
+			givenEvents: []config.EventType{config.CreateEvent, config.DeleteEvent},
+			expected: informerOptions{
+				Namespaces:    []string{"default", "prod"},
+				LabelSelector: "app=web",
+				FieldSelector: "metadata.name=web",
+				MetadataOnly:  true,
+			},
+		},
+		{
+			name: "Unanchored namespaces and name",
+			givenRoutes: []route{
+				{
+					namespaces:   &config.RegexConstraints{Include: []string{"default"}},
+					resourceName: config.RegexConstraints{Include: []string{"web"}},
+				},
+			},
+			givenEvents: []config.EventType{config.CreateEvent},
+			expected:    informerOptions{},
+		},
+		{
+			name: "Regex namespaces",
+			givenRoutes: []route{
+				{namespaces: &config.RegexConstraints{Include: []string{"^default$"}}},
+				{namespaces: &config.RegexConstraints{Include: []string{"^team-.*$"}}},
+			},
+			givenEvents: []config.EventType{config.CreateEvent, config.UpdateEvent},
+			expected:    informerOptions{},
+		},
+		{
+			name: "Excluded namespaces",
+			givenRoutes: []route{
+				{namespaces: &config.RegexConstraints{Include: []string{"^default$"}, Exclude: []string{"kube-system"}}},
+			},
+			givenEvents: []config.EventType{config.ErrorEvent},
+			expected:    informerOptions{},
+		},
+		{
+			name: "One route without labels",
+			givenRoutes: []route{
+				{labels: &map[string]string{"app": "web"}, metadataOnly: true},
+				{metadataOnly: true},
+			},
+			givenEvents: []config.EventType{config.CreateEvent},
+			expected:    informerOptions{MetadataOnly: true},
+		},
+		{
+			name: "Different names",
+			givenRoutes: []route{
+				{resourceName: config.RegexConstraints{Include: []string{"^web$"}}, metadataOnly: true},
+				{resourceName: config.RegexConstraints{Include: []string{"^api$"}}, metadataOnly: true},
+			},
+			givenEvents: []config.EventType{config.DeleteEvent},
+			expected:    informerOptions{MetadataOnly: true},
+		},
+		{
+			name: "Full object kept for routes used by actions or templates",
+			givenRoutes: []route{
+				{metadataOnly: true},
+				{},
+			},
+			givenEvents: []config.EventType{config.CreateEvent, config.DeleteEvent},
+			expected:    informerOptions{},
+		},
+		{
+			name:               "Full object required by recommendations",
+			givenRoutes:        []route{{metadataOnly: true}},
+			givenEvents:        []config.EventType{config.CreateEvent},
+			fullObjectRequired: true,
+			expected:           informerOptions{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := informerOptionsForRoutes(tc.givenRoutes, tc.givenEvents, tc.fullObjectRequired)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMetadataToUnstructured(t *testing.T) {
+	// given
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	partial := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default", Labels: map[string]string{"app": "web"}},
+	}
+	transform := metadataToUnstructured(gvk)
+
+	// when
+	out, err := transform(partial)
+
+	// then
+	require.NoError(t, err)
+	unstr, ok := out.(*unstructured.Unstructured)
+	require.True(t, ok)
+	assert.Equal(t, "Secret", unstr.GetKind())
+	assert.Equal(t, "v1", unstr.GetAPIVersion())
+	assert.Equal(t, "token", unstr.GetName())
+	assert.Equal(t, map[string]string{"app": "web"}, unstr.GetLabels())
+
+	// when
+	out, err = transform(cache.DeletedFinalStateUnknown{Key: "default/token", Obj: partial})
+
+	// then
+	require.NoError(t, err)
+	tombstone, ok := out.(cache.DeletedFinalStateUnknown)
+	require.True(t, ok)
+	assert.IsType(t, &unstructured.Unstructured{}, tombstone.Obj)
+}
+
+// BenchmarkInformerCache compares memory used by informer caches with whole Pod objects
+// and with metadata-only Pod objects. Run it with:
+//
+//	go test -run=^$ -bench=BenchmarkInformerCache -benchmem ./internal/source/kubernetes/
+func BenchmarkInformerCache(b *testing.B) {
+	const podsCount = 1000
+
+	pods := make([]*corev1.Pod, 0, podsCount)
+	for i := 0; i < podsCount; i++ {
+		pods = append(pods, fixBenchmarkPod(i))
+	}
+
+	b.Run("full objects", func(b *testing.B) {
+		benchmarkCacheSize(b, pods, func(pod *corev1.Pod) (interface{}, error) {
+			out, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(pod)
+			return &unstructured.Unstructured{Object: out}, err
+		})
+	})
+
+	b.Run("metadata only", func(b *testing.B) {
+		transform := metadataToUnstructured(schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+		benchmarkCacheSize(b, pods, func(pod *corev1.Pod) (interface{}, error) {
+			return transform(&metav1.PartialObjectMetadata{ObjectMeta: pod.ObjectMeta})
+		})
+	})
+}
+
+func benchmarkCacheSize(b *testing.B, pods []*corev1.Pod, toCached func(*corev1.Pod) (interface{}, error)) {
+	b.Helper()
+
+	var cachedBytes uint64
+	for i := 0; i < b.N; i++ {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		store := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, pod := range pods {
+			obj, err := toCached(pod)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := store.Add(obj); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		cachedBytes += after.HeapAlloc - before.HeapAlloc
+		runtime.KeepAlive(store)
+	}
+
+	b.ReportMetric(float64(cachedBytes)/float64(b.N)/float64(len(pods)), "cached-B/obj")
+}
+
+func fixBenchmarkPod(idx int) *corev1.Pod {
+	container := corev1.Container{
+		Name:    "app",
+		Image:   "ghcr.io/kubeshop/botkube:v1.0.0",
+		Command: []string{"/app", "--config", "/config/config.yaml"},
+		Env: []corev1.EnvVar{
+			{Name: "LOG_LEVEL", Value: "info"},
+			{Name: "CONFIG_PATH", Value: "/config"},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/config"}},
+	}
+
+	return &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("app-%d", idx),
+			Namespace: "default",
+			UID:       "d6a9b3a2-8f2c-4b5a-9f8e-2b1f0c3e4d5a",
+			Labels:    map[string]string{"app": "botkube"},
+		},
+		Spec: corev1.PodSpec{
+			Containers:     []corev1.Container{container, container},
+			InitContainers: []corev1.Container{container},
+			Volumes: []corev1.Volume{
+				{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"},
+				}}},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+				{Type: corev1.ContainersReady, Status: corev1.ConditionTrue},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", Ready: true, Image: container.Image, ImageID: "sha256:0f1e2d3c4b5a69788796a5b4c3d2e1f0"},
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// maxOwnerChainDepth protects against cyclic or unexpectedly deep owner reference chains.
+	maxOwnerChainDepth = 5
+	// ownerCacheSize is the maximum number of objects with cached owner references.
+	ownerCacheSize = 1000
+	// ownerCacheTTL defines how long owner references of a given object are cached.
+	// Owner references rarely change, so a short-lived cache avoids fetching the same owners for every event.
+	ownerCacheTTL = 5 * time.Minute
+)
+
+// OwnerReference describes a single object from the owner reference chain.
+type OwnerReference struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	// Resource is the owner resource type in the "{group}/{version}/{kind (plural)}" format, such as "apps/v1/deployments".
+	Resource string
+}
+
+// String returns the owner in the "{Kind}/{Name}" format.
+func (o OwnerReference) String() string {
+	return fmt.Sprintf("%s/%s", o.Kind, o.Name)
+}
+
+// OwnerResolver resolves owner reference chains. Owner references of fetched objects are cached by the object UID.
+type OwnerResolver struct {
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	cache      *utilcache.LRUExpireCache
+}
+
+// NewOwnerResolver returns a new OwnerResolver instance.
+func NewOwnerResolver(dynamicCli dynamic.Interface, mapper meta.RESTMapper) *OwnerResolver {
+	return &OwnerResolver{
+		dynamicCli: dynamicCli,
+		mapper:     mapper,
+		cache:      utilcache.NewLRUExpireCache(ownerCacheSize),
+	}
+}
+
+// GetOwnerChain returns the owner reference chain for a given set of owner references.
+// The chain starts from the direct owner and ends with the top-level one, e.g. ReplicaSet, Deployment.
+// If fetching any owner fails, the chain resolved so far is returned together with the error.
+func (r *OwnerResolver) GetOwnerChain(ctx context.Context, namespace string, refs []metaV1.OwnerReference) ([]OwnerReference, error) {
+	var out []OwnerReference
+	for i := 0; i < maxOwnerChainDepth; i++ {
+		ref, ok := controllerOwnerReference(refs)
+		if !ok {
+			return out, nil
+		}
+
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		gvr, err := GetResourceFromKind(r.mapper, gvk)
+		if err != nil {
+			return out, err
+		}
+
+		out = append(out, OwnerReference{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			Resource:   GVRToString(gvr),
+		})
+
+		// the owner could be already deleted, then there are no more owners
+		refs, err = r.GetOwnerReferences(ctx, gvk, namespace, ref.Name, ref.UID)
+		if err != nil {
+			return out, fmt.Errorf("while getting owner %s/%s: %w", ref.Kind, ref.Name, err)
+		}
+	}
+
+	return out, nil
+}
+
+// GetOwnerReferences returns owner references of a given object.
+// It returns nil if the object doesn't exist. If the UID is set, the result is cached.
+func (r *OwnerResolver) GetOwnerReferences(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string, uid types.UID) ([]metaV1.OwnerReference, error) {
+	if uid != "" {
+		if cached, found := r.cache.Get(uid); found {
+			return cached.([]metaV1.OwnerReference), nil
+		}
+	}
+
+	gvr, err := GetResourceFromKind(r.mapper, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []metaV1.OwnerReference
+	obj, err := r.dynamicCli.Resource(gvr).Namespace(namespace).Get(ctx, name, metaV1.GetOptions{})
+	switch {
+	case err == nil:
+		out = obj.GetOwnerReferences()
+	case apierrors.IsNotFound(err):
+	default:
+		return nil, err
+	}
+
+	if uid != "" {
+		r.cache.Add(uid, out, ownerCacheTTL)
+	}
+	return out, nil
+}
+
+// GVRToString converts GVR formats to string.
+func GVRToString(gvr schema.GroupVersionResource) string {
+	if gvr.Group == "" {
+		return fmt.Sprintf("%s/%s", gvr.Version, gvr.Resource)
+	}
+	return fmt.Sprintf("%s/%s/%s", gvr.Group, gvr.Version, gvr.Resource)
+}
+
+// controllerOwnerReference returns the managing controller reference. If there is no controller set,
+// the first owner reference is returned.
+func controllerOwnerReference(refs []metaV1.OwnerReference) (metaV1.OwnerReference, bool) {
+	if len(refs) == 0 {
+		return metaV1.OwnerReference{}, false
+	}
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			return ref, true
+		}
+	}
+	return refs[0], true
+}
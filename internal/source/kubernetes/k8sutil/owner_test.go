@@ -0,0 +1,151 @@
+package k8sutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/pkg/ptr"
+)
+
+func TestGetOwnerChain(t *testing.T) {
+	// given
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+	}
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-5d4f8c",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Controller: ptr.Bool(true)},
+			},
+		},
+	}
+	podOwnerRefs := []metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "Node", Name: "not-a-controller"},
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Controller: ptr.Bool(true)},
+	}
+
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, deploy, rs)
+	mapper := fixRESTMapper()
+
+	expected := []k8sutil.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Resource: "apps/v1/replicasets"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Resource: "apps/v1/deployments"},
+	}
+
+	// when
+	actual, err := k8sutil.NewOwnerResolver(dynamicCli, mapper).GetOwnerChain(context.Background(), "default", podOwnerRefs)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestGetOwnerChain_DeletedOwner(t *testing.T) {
+	// given
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme)
+	refs := []metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "removed"},
+	}
+
+	expected := []k8sutil.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "removed", Resource: "apps/v1/replicasets"},
+	}
+
+	// when
+	actual, err := k8sutil.NewOwnerResolver(dynamicCli, fixRESTMapper()).GetOwnerChain(context.Background(), "default", refs)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestGetOwnerChain_PartialChainOnError(t *testing.T) {
+	// given
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-5d4f8c",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Controller: ptr.Bool(true)},
+			},
+		},
+	}
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, rs)
+	dynamicCli.PrependReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	refs := []metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Controller: ptr.Bool(true)},
+	}
+
+	// when
+	actual, err := k8sutil.NewOwnerResolver(dynamicCli, fixRESTMapper()).GetOwnerChain(context.Background(), "default", refs)
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "while getting owner Deployment/app")
+	assert.Equal(t, []k8sutil.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", Resource: "apps/v1/replicasets"},
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Resource: "apps/v1/deployments"},
+	}, actual)
+}
+
+func TestOwnerResolver_CachesOwnerReferences(t *testing.T) {
+	// given
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-5d4f8c",
+			Namespace: "default",
+			UID:       "rs-uid",
+		},
+	}
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, rs)
+	resolver := k8sutil.NewOwnerResolver(dynamicCli, fixRESTMapper())
+	refs := []metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "app-5d4f8c", UID: "rs-uid"},
+	}
+
+	// when
+	for i := 0; i < 3; i++ {
+		_, err := resolver.GetOwnerChain(context.Background(), "default", refs)
+		require.NoError(t, err)
+	}
+
+	// then
+	assert.Len(t, dynamicCli.Actions(), 1)
+}
+
+func TestGetOwnerChain_NoOwners(t *testing.T) {
+	// when
+	actual, err := k8sutil.NewOwnerResolver(nil, nil).GetOwnerChain(context.Background(), "default", nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func fixRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, meta.RESTScopeNamespace)
+	return mapper
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/mute"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
@@ -21,15 +22,23 @@ var emojiForLevel = map[config.Level]string{
 type EventCommandsGetter interface {
 	GetCommandsForEvent(event event.Event) ([]commander.Command, error)
 }
+
+// SectionRenderer renders notification section based on custom message templates.
+type SectionRenderer interface {
+	Render(e event.Event) (api.Section, bool, error)
+}
+
 type MessageBuilder struct {
 	commandsGetter           EventCommandsGetter
+	sectionRenderer          SectionRenderer
 	log                      logrus.FieldLogger
 	isInteractivitySupported bool
 }
 
-func NewMessageBuilder(isInteractivitySupported bool, log logrus.FieldLogger, commandsGetter EventCommandsGetter) *MessageBuilder {
+func NewMessageBuilder(isInteractivitySupported bool, log logrus.FieldLogger, commandsGetter EventCommandsGetter, sectionRenderer SectionRenderer) *MessageBuilder {
 	return &MessageBuilder{
 		commandsGetter:           commandsGetter,
+		sectionRenderer:          sectionRenderer,
 		log:                      log,
 		isInteractivitySupported: isInteractivitySupported,
 	}
@@ -39,7 +48,7 @@ func (m *MessageBuilder) FromEvent(event event.Event) (api.Message, error) {
 	msg := api.Message{
 		Timestamp: event.TimeStamp,
 		Sections: []api.Section{
-			m.baseNotificationSection(event),
+			m.notificationSection(event),
 		},
 	}
 
@@ -56,9 +65,34 @@ func (m *MessageBuilder) FromEvent(event event.Event) (api.Message, error) {
 		msg.Sections = append(msg.Sections, *cmdSection)
 	}
 
+	if muteSection := m.muteSection(event); muteSection != nil {
+		msg.Sections = append(msg.Sections, *muteSection)
+	}
+
 	return msg, nil
 }
 
+// muteSection returns buttons which mute notifications for the involved object in a given channel.
+func (m *MessageBuilder) muteSection(event event.Event) *api.Section {
+	if event.Kind == "" || event.Name == "" {
+		return nil
+	}
+
+	target := mute.Target{
+		Kind:      event.Kind,
+		Namespace: event.Namespace,
+		Name:      event.Name,
+	}
+	btnBuilder := api.NewMessageButtonBuilder()
+	return &api.Section{
+		Buttons: api.Buttons{
+			btnBuilder.ForCommandWithoutDesc("Mute 1h", fmt.Sprintf("mute object %s 1h", target)),
+			btnBuilder.ForCommandWithoutDesc("Mute 24h", fmt.Sprintf("mute object %s 24h", target)),
+			btnBuilder.ForCommandWithoutDesc("Mute forever", fmt.Sprintf("mute object %s forever", target)),
+		},
+	}
+}
+
 func (m *MessageBuilder) getInteractiveEventSectionIfShould(event event.Event) (*api.Section, error) {
 	commands, err := m.commandsGetter.GetCommandsForEvent(event)
 	if err != nil {
@@ -69,7 +103,8 @@ func (m *MessageBuilder) getInteractiveEventSectionIfShould(event event.Event) (
 		return nil, nil
 	}
 
-	cmdPrefix := fmt.Sprintf("%s kubectl", api.MessageBotNamePlaceholder)
+	// commands contain the executor name, e.g. kubectl or helm, so only the bot name is used as a prefix
+	cmdPrefix := api.MessageBotNamePlaceholder
 	var optionItems []api.OptionItem
 	for _, cmd := range commands {
 		optionItems = append(optionItems, api.OptionItem{
@@ -81,10 +116,36 @@ func (m *MessageBuilder) getInteractiveEventSectionIfShould(event event.Event) (
 	return &section, nil
 }
 
+// notificationSection returns section rendered from a custom message template, if defined for a given event.
+// Otherwise, it returns the built-in layout.
+func (m *MessageBuilder) notificationSection(event event.Event) api.Section {
+	if m.sectionRenderer == nil {
+		return m.baseNotificationSection(event)
+	}
+
+	section, found, err := m.sectionRenderer.Render(event)
+	if err != nil {
+		m.log.Errorf("while rendering message template, falling back to the default layout: %s", err.Error())
+		return m.baseNotificationSection(event)
+	}
+	if !found {
+		return m.baseNotificationSection(event)
+	}
+
+	if section.Header == "" {
+		section.Header = m.baseHeader(event)
+	}
+	return section
+}
+
+func (m *MessageBuilder) baseHeader(event event.Event) string {
+	return fmt.Sprintf("%s %s", emojiForLevel[event.Level], event.Title)
+}
+
 func (m *MessageBuilder) baseNotificationSection(event event.Event) api.Section {
 	section := api.Section{
 		Base: api.Base{
-			Header: fmt.Sprintf("%s %s", emojiForLevel[event.Level], event.Title),
+			Header: m.baseHeader(event),
 		},
 	}
 
@@ -97,6 +158,7 @@ func (m *MessageBuilder) baseNotificationSection(event event.Event) api.Section
 
 	// Messages, Recommendations and Warnings formatted as bullet point lists.
 	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Messages", event.Messages)
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Affected objects", event.Children)
 	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Recommendations", event.Recommendations)
 	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Warnings", event.Warnings)
 
@@ -0,0 +1,69 @@
+package msgtemplate
+
+import (
+	"fmt"
+	"text/template"
+	"time"
+
+	sprig "github.com/go-task/slim-sprig"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+// funcMap returns functions available in message templates: sprig functions extended with Kubernetes helpers.
+func funcMap(now func() time.Time) template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	funcs["age"] = func(in interface{}) (string, error) {
+		return age(in, now())
+	}
+	funcs["owner"] = owner
+	return funcs
+}
+
+// age returns the human-readable time elapsed since a given timestamp, in the same format as kubectl uses.
+func age(in interface{}, now time.Time) (string, error) {
+	var since time.Time
+	switch t := in.(type) {
+	case time.Time:
+		since = t
+	case metaV1.Time:
+		since = t.Time
+	case *metaV1.Time:
+		if t == nil {
+			return "", nil
+		}
+		since = t.Time
+	case string:
+		if t == "" {
+			return "", nil
+		}
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return "", fmt.Errorf("while parsing %q timestamp: %w", t, err)
+		}
+		since = parsed
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("unsupported timestamp type %T", in)
+	}
+
+	if since.IsZero() {
+		return "", nil
+	}
+	return duration.HumanDuration(now.Sub(since)), nil
+}
+
+// owner returns the top-level owner of the event object in the "Kind/Name" form, if resolved.
+// Otherwise, it returns the controller owner of the object, or an empty string if there is none.
+func owner(e event.Event) string {
+	if top, ok := e.TopLevelOwner(); ok {
+		return top.String()
+	}
+	if ref := metaV1.GetControllerOfNoCopy(&e.ObjectMeta); ref != nil {
+		return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+	}
+	return ""
+}
@@ -0,0 +1,237 @@
+package msgtemplate
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// Data holds data available in message templates.
+type Data struct {
+	// Event is the Kubernetes source event.
+	Event event.Event
+	// Object is the involved object in the unstructured form.
+	Object map[string]interface{}
+}
+
+// Renderer renders notification sections based on the configured message templates.
+type Renderer struct {
+	templates []parsedTemplate
+	now       func() time.Time
+}
+
+type parsedTemplate struct {
+	trigger     config.MessageTemplateTrigger
+	header      *template.Template
+	textFields  []parsedTextField
+	bulletLists []parsedBulletList
+	context     []*template.Template
+}
+
+type parsedTextField struct {
+	key   string
+	value *template.Template
+}
+
+type parsedBulletList struct {
+	title string
+	items *template.Template
+}
+
+// NewRenderer parses given message templates. It returns an error if any of the templates is invalid.
+func NewRenderer(templates []config.MessageTemplate) (*Renderer, error) {
+	r := &Renderer{now: time.Now}
+
+	for idx, tpl := range templates {
+		parsed, err := r.parse(idx, tpl)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing message template #%d: %w", idx, err)
+		}
+		r.templates = append(r.templates, parsed)
+	}
+
+	return r, nil
+}
+
+// Render renders notification section for a given event using the first matching template.
+// It returns false if there is no template for a given event, so the built-in layout should be used.
+func (r *Renderer) Render(e event.Event) (api.Section, bool, error) {
+	tpl, found := r.find(e)
+	if !found {
+		return api.Section{}, false, nil
+	}
+
+	data, err := dataForEvent(e)
+	if err != nil {
+		return api.Section{}, false, err
+	}
+
+	var section api.Section
+	section.Header, err = execute(tpl.header, data)
+	if err != nil {
+		return api.Section{}, false, fmt.Errorf("while rendering header: %w", err)
+	}
+
+	for _, field := range tpl.textFields {
+		value, err := execute(field.value, data)
+		if err != nil {
+			return api.Section{}, false, fmt.Errorf("while rendering %q text field: %w", field.key, err)
+		}
+		if value == "" {
+			continue
+		}
+		section.TextFields = append(section.TextFields, api.TextField{Key: field.key, Value: value})
+	}
+
+	for _, list := range tpl.bulletLists {
+		out, err := execute(list.items, data)
+		if err != nil {
+			return api.Section{}, false, fmt.Errorf("while rendering %q bullet list: %w", list.title, err)
+		}
+		items := nonEmptyLines(out)
+		if len(items) == 0 {
+			continue
+		}
+		section.BulletLists = append(section.BulletLists, api.BulletList{Title: list.title, Items: items})
+	}
+
+	for _, ctxTpl := range tpl.context {
+		text, err := execute(ctxTpl, data)
+		if err != nil {
+			return api.Section{}, false, fmt.Errorf("while rendering context: %w", err)
+		}
+		if text == "" {
+			continue
+		}
+		section.Context = append(section.Context, api.ContextItem{Text: text})
+	}
+
+	return section, true, nil
+}
+
+func (r *Renderer) find(e event.Event) (parsedTemplate, bool) {
+	for _, tpl := range r.templates {
+		if !matches(tpl.trigger.Resources, e.Resource) {
+			continue
+		}
+		if !matchesEventType(tpl.trigger.Events, e.Type) {
+			continue
+		}
+		return tpl, true
+	}
+	return parsedTemplate{}, false
+}
+
+func (r *Renderer) parse(idx int, in config.MessageTemplate) (parsedTemplate, error) {
+	out := parsedTemplate{trigger: in.Trigger}
+
+	var err error
+	out.header, err = r.newTemplate(fmt.Sprintf("%d-header", idx), in.Header)
+	if err != nil {
+		return parsedTemplate{}, fmt.Errorf("while parsing header: %w", err)
+	}
+
+	for i, field := range in.TextFields {
+		if field.Key == "" {
+			return parsedTemplate{}, fmt.Errorf("text field #%d: key cannot be empty", i)
+		}
+		value, err := r.newTemplate(fmt.Sprintf("%d-text-field-%d", idx, i), field.Value)
+		if err != nil {
+			return parsedTemplate{}, fmt.Errorf("while parsing %q text field: %w", field.Key, err)
+		}
+		out.textFields = append(out.textFields, parsedTextField{key: field.Key, value: value})
+	}
+
+	for i, list := range in.BulletLists {
+		items, err := r.newTemplate(fmt.Sprintf("%d-bullet-list-%d", idx, i), list.Items)
+		if err != nil {
+			return parsedTemplate{}, fmt.Errorf("while parsing %q bullet list: %w", list.Title, err)
+		}
+		out.bulletLists = append(out.bulletLists, parsedBulletList{title: list.Title, items: items})
+	}
+
+	for i, ctx := range in.Context {
+		ctxTpl, err := r.newTemplate(fmt.Sprintf("%d-context-%d", idx, i), ctx)
+		if err != nil {
+			return parsedTemplate{}, fmt.Errorf("while parsing context #%d: %w", i, err)
+		}
+		out.context = append(out.context, ctxTpl)
+	}
+
+	return out, nil
+}
+
+func (r *Renderer) newTemplate(name, text string) (*template.Template, error) {
+	// now is resolved lazily, so it can be overridden after the templates are parsed
+	now := func() time.Time { return r.now() }
+	return template.New(name).Funcs(funcMap(now)).Parse(text)
+}
+
+func dataForEvent(e event.Event) (Data, error) {
+	data := Data{Event: e}
+	switch obj := e.Object.(type) {
+	case nil:
+	case *unstructured.Unstructured:
+		data.Object = obj.Object
+	default:
+		out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return Data{}, fmt.Errorf("while converting %T into unstructured: %w", obj, err)
+		}
+		data.Object = out
+	}
+	return data, nil
+}
+
+func execute(tpl *template.Template, data Data) (string, error) {
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buff.String()), nil
+}
+
+func nonEmptyLines(in string) []string {
+	var out []string
+	for _, line := range strings.Split(in, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+func matches(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, item := range allowed {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesEventType(allowed []config.EventType, value config.EventType) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, item := range allowed {
+		if item == value || item == config.AllEvent {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package msgtemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	templates := []config.MessageTemplate{
+		{
+			Trigger: config.MessageTemplateTrigger{
+				Resources: []string{"apps/v1/deployments"},
+				Events:    []config.EventType{config.UpdateEvent},
+			},
+			Header: `🚀 {{ .Event.Kind }} {{ .Event.Namespace }}/{{ .Event.Name | upper }} updated`,
+			TextFields: []config.TemplateTextField{
+				{Key: "Image", Value: `{{ (index .Object.spec.template.spec.containers 0).image }}`},
+				{Key: "Age", Value: `{{ age .Event.ObjectMeta.CreationTimestamp }}`},
+				{Key: "Owner", Value: `{{ owner .Event }}`},
+				{Key: "Empty", Value: `{{ .Event.Reason }}`},
+			},
+			BulletLists: []config.TemplateBulletList{
+				{Title: "Changes", Items: "{{ range .Event.Messages }}{{ . }}\n{{ end }}"},
+				{Title: "Skipped", Items: `{{ range .Event.Warnings }}{{ . }}{{ end }}`},
+			},
+			Context: []string{`Cluster: {{ .Event.Cluster }}`},
+		},
+		{
+			Trigger: config.MessageTemplateTrigger{Events: []config.EventType{config.DeleteEvent}},
+			Header:  `{{ .Event.Name }} deleted`,
+		},
+	}
+	renderer, err := NewRenderer(templates)
+	require.NoError(t, err)
+	renderer.now = func() time.Time { return now }
+
+	deployment := event.Event{
+		Kind:      "Deployment",
+		Name:      "web",
+		Namespace: "default",
+		Resource:  "apps/v1/deployments",
+		Type:      config.UpdateEvent,
+		Cluster:   "prod",
+		Messages:  []string{"spec.replicas: 1 -> 3", "spec.paused: true -> false"},
+		ObjectMeta: metaV1.ObjectMeta{
+			CreationTimestamp: metaV1.NewTime(now.Add(-49 * time.Hour)),
+		},
+		Owners: []k8sutil.OwnerReference{{Kind: "Rollout", Name: "web"}},
+		Object: &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "app", "image": "nginx:1.23"},
+						},
+					},
+				},
+			},
+		}},
+	}
+
+	// when
+	section, found, err := renderer.Render(deployment)
+
+	// then
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, api.Section{
+		Base: api.Base{Header: "🚀 Deployment default/WEB updated"},
+		TextFields: api.TextFields{
+			{Key: "Image", Value: "nginx:1.23"},
+			{Key: "Age", Value: "2d1h"},
+			{Key: "Owner", Value: "Rollout/web"},
+		},
+		BulletLists: api.BulletLists{
+			{Title: "Changes", Items: []string{"spec.replicas: 1 -> 3", "spec.paused: true -> false"}},
+		},
+		Context: api.ContextItems{{Text: "Cluster: prod"}},
+	}, section)
+
+	// when
+	section, found, err = renderer.Render(event.Event{Name: "web", Resource: "v1/pods", Type: config.DeleteEvent})
+
+	// then
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "web deleted", section.Header)
+
+	// when
+	_, found, err = renderer.Render(event.Event{Name: "web", Resource: "v1/pods", Type: config.CreateEvent})
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestNewRenderer_InvalidTemplates(t *testing.T) {
+	tests := []struct {
+		name        string
+		given       config.MessageTemplate
+		expectedErr string
+	}{
+		{
+			name:        "Invalid header",
+			given:       config.MessageTemplate{Header: "{{ .Event.Name "},
+			expectedErr: `while parsing message template #0: while parsing header: template: 0-header:1: unclosed action`,
+		},
+		{
+			name: "Unknown function",
+			given: config.MessageTemplate{
+				TextFields: []config.TemplateTextField{{Key: "Age", Value: "{{ since .Event.TimeStamp }}"}},
+			},
+			expectedErr: `while parsing message template #0: while parsing "Age" text field: template: 0-text-field-0:1: function "since" not defined`,
+		},
+		{
+			name: "Missing text field key",
+			given: config.MessageTemplate{
+				TextFields: []config.TemplateTextField{{Value: "{{ .Event.Name }}"}},
+			},
+			expectedErr: `while parsing message template #0: text field #0: key cannot be empty`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := NewRenderer([]config.MessageTemplate{tc.given})
+
+			// then
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const deploymentPodDisruptionBudgetSetName = "DeploymentPodDisruptionBudgetSet"
+
+// DeploymentPodDisruptionBudgetSet adds recommendations if Deployments with more than one replica
+// are not covered by any PodDisruptionBudget.
+type DeploymentPodDisruptionBudgetSet struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewDeploymentPodDisruptionBudgetSet creates a new DeploymentPodDisruptionBudgetSet instance.
+func NewDeploymentPodDisruptionBudgetSet(dynamicCli dynamic.Interface) *DeploymentPodDisruptionBudgetSet {
+	return &DeploymentPodDisruptionBudgetSet{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *DeploymentPodDisruptionBudgetSet) Do(ctx context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Deployment" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var deployment appsv1.Deployment
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &deployment)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, deployment, err)
+	}
+
+	// replicas default to 1 if not set
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas <= 1 {
+		return Result{}, nil
+	}
+
+	covered, err := f.isCoveredByPDB(ctx, deployment)
+	if err != nil {
+		return Result{}, err
+	}
+	if covered {
+		return Result{}, nil
+	}
+
+	recommendationMsg := fmt.Sprintf("Deployment '%s/%s' has %d replicas, but there is no PodDisruptionBudget matching its Pods. Consider creating one, to keep the workload available during voluntary disruptions, such as Node drains.", deployment.Namespace, deployment.Name, *deployment.Spec.Replicas)
+	return Result{
+		Info: []string{recommendationMsg},
+	}, nil
+}
+
+func (f *DeploymentPodDisruptionBudgetSet) isCoveredByPDB(ctx context.Context, deployment appsv1.Deployment) (bool, error) {
+	pdbGVR := schema.GroupVersionResource{
+		Group:    "policy",
+		Version:  "v1",
+		Resource: "poddisruptionbudgets",
+	}
+	list, err := f.dynamicCli.Resource(pdbGVR).Namespace(deployment.Namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("while listing PodDisruptionBudgets: %w", err)
+	}
+
+	podLabels := labels.Set(deployment.Spec.Template.Labels)
+	for i := range list.Items {
+		var pdb policyv1.PodDisruptionBudget
+		err := k8sutil.TransformIntoTypedObject(&list.Items[i], &pdb)
+		if err != nil {
+			return false, fmt.Errorf("while transforming object type %T into type: %T: %w", list.Items[i], pdb, err)
+		}
+
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metaV1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return false, fmt.Errorf("while parsing PodDisruptionBudget %q selector: %w", pdb.Name, err)
+		}
+		// an empty selector matches all Pods in the Namespace
+		if selector.Matches(podLabels) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Name returns the recommendation name.
+func (f *DeploymentPodDisruptionBudgetSet) Name() string {
+	return deploymentPodDisruptionBudgetSetName
+}
@@ -0,0 +1,111 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestDeploymentPodDisruptionBudgetSet_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name         string
+		Replicas     int32
+		ExistingPDBs []runtime.Object
+		Expected     recommendation.Result
+	}{
+		{
+			Name:     "Single replica",
+			Replicas: 1,
+			Expected: recommendation.Result{},
+		},
+		{
+			Name:     "Multiple replicas with matching PDB",
+			Replicas: 3,
+			ExistingPDBs: []runtime.Object{
+				fixPDB("other", map[string]string{"app": "other"}),
+				fixPDB("web", map[string]string{"app": "web"}),
+			},
+			Expected: recommendation.Result{},
+		},
+		{
+			Name:     "Multiple replicas without matching PDB",
+			Replicas: 3,
+			ExistingPDBs: []runtime.Object{
+				fixPDB("other", map[string]string{"app": "other"}),
+			},
+			Expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'default/web' has 3 replicas, but there is no PodDisruptionBudget matching its Pods. Consider creating one, to keep the workload available during voluntary disruptions, such as Node drains.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme, map[schema.GroupVersionResource]string{
+				{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}: "PodDisruptionBudgetList",
+			}, testCase.ExistingPDBs...)
+			recomm := recommendation.NewDeploymentPodDisruptionBudgetSet(dynamicCli)
+
+			deployment := fixDeployment(testCase.Replicas)
+			event := fixEventForObject(t, deployment, deployment.ObjectMeta, config.CreateEvent, "apps/v1/deployments")
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func fixDeployment(replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", "tier": "frontend"}},
+			},
+		},
+	}
+}
+
+func fixPDB(name string, matchLabels map[string]string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: matchLabels},
+		},
+	}
+}
@@ -1,5 +1,7 @@
 package recommendation
 
+import "time"
+
 func (s *AggregatedRunner) Recommendations() []Recommendation {
 	return s.recommendations
 }
@@ -11,3 +13,17 @@ func PodResourceType() string {
 func IngressResourceType() string {
 	return ingressResourceType
 }
+
+func DeploymentResourceType() string {
+	return deploymentsResourceType
+}
+
+func ServiceResourceType() string {
+	return servicesResourceType
+}
+
+func (f *ServiceSelectorMatchesPods) WithGracePeriod(gracePeriod, checkInterval time.Duration) *ServiceSelectorMatchesPods {
+	f.gracePeriod = gracePeriod
+	f.checkInterval = checkInterval
+	return f
+}
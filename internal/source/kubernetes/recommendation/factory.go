@@ -50,6 +50,22 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewPodNoLatestImageTag())
 	}
 
+	if ptr.IsTrue(cfg.Pod.ResourcesSet) {
+		recommendations = append(recommendations, NewPodResourcesSet())
+	}
+
+	if ptr.IsTrue(cfg.Pod.ProbesSet) {
+		recommendations = append(recommendations, NewPodProbesSet())
+	}
+
+	if ptr.IsTrue(cfg.Pod.NoPrivilegedContainers) {
+		recommendations = append(recommendations, NewPodNoPrivilegedContainers())
+	}
+
+	if ptr.IsTrue(cfg.Pod.NoHostPathVolumes) {
+		recommendations = append(recommendations, NewPodNoHostPathVolumes())
+	}
+
 	if ptr.IsTrue(cfg.Ingress.BackendServiceValid) {
 		recommendations = append(recommendations, NewIngressBackendServiceValid(f.dynamicCli))
 	}
@@ -58,5 +74,13 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewIngressTLSSecretValid(f.dynamicCli))
 	}
 
+	if ptr.IsTrue(cfg.Deployment.PodDisruptionBudgetSet) {
+		recommendations = append(recommendations, NewDeploymentPodDisruptionBudgetSet(f.dynamicCli))
+	}
+
+	if ptr.IsTrue(cfg.Service.SelectorMatchesPods) {
+		recommendations = append(recommendations, NewServiceSelectorMatchesPods(f.dynamicCli))
+	}
+
 	return recommendations
 }
@@ -25,11 +25,15 @@ func TestFactory_New(t *testing.T) {
 				BackendServiceValid: ptr.Bool(true),
 				// keep TLSSecretValid not specified
 			},
+			Deployment: config.DeploymentRecommendations{
+				PodDisruptionBudgetSet: ptr.Bool(true),
+			},
 		},
 	}
 	expectedNames := []string{
 		"PodLabelsSet",
 		"IngressBackendServiceValid",
+		"DeploymentPodDisruptionBudgetSet",
 	}
 	expectedRecCfg := config.Recommendations{
 		Pod: config.PodRecommendations{
@@ -40,6 +44,9 @@ func TestFactory_New(t *testing.T) {
 			BackendServiceValid: ptr.Bool(true),
 			TLSSecretValid:      nil,
 		},
+		Deployment: config.DeploymentRecommendations{
+			PodDisruptionBudgetSet: ptr.Bool(true),
+		},
 	}
 
 	factory := recommendation.NewFactory(loggerx.NewNoop(), nil)
@@ -0,0 +1,32 @@
+package recommendation
+
+import (
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+// podForCreateEvent returns Pod for a given Pod create event. It returns false for other events.
+func podForCreateEvent(event event.Event) (coreV1.Pod, bool, error) {
+	if event.Kind != "Pod" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return coreV1.Pod{}, false, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return coreV1.Pod{}, false, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var pod coreV1.Pod
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return coreV1.Pod{}, false, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	return pod, true, nil
+}
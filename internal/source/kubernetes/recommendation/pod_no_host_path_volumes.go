@@ -0,0 +1,44 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podNoHostPathVolumesName = "PodNoHostPathVolumes"
+
+// PodNoHostPathVolumes adds warnings if Pods mount hostPath volumes.
+type PodNoHostPathVolumes struct{}
+
+// NewPodNoHostPathVolumes creates a new PodNoHostPathVolumes instance.
+func NewPodNoHostPathVolumes() *PodNoHostPathVolumes {
+	return &PodNoHostPathVolumes{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoHostPathVolumes) Do(_ context.Context, event event.Event) (Result, error) {
+	pod, ok, err := podForCreateEvent(event)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	var warningMsgs []string
+	for _, vol := range pod.Spec.Volumes {
+		if vol.HostPath == nil {
+			continue
+		}
+		recommendationMsg := fmt.Sprintf("Pod '%s/%s' mounts '%s' host path as volume '%s'. It exposes the Node filesystem to the Pod.", pod.Namespace, pod.Name, vol.HostPath.Path, vol.Name)
+		warningMsgs = append(warningMsgs, recommendationMsg)
+	}
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodNoHostPathVolumes) Name() string {
+	return podNoHostPathVolumesName
+}
@@ -0,0 +1,57 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodNoHostPathVolumes_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Volumes  []v1.Volume
+		Expected recommendation.Result
+	}{
+		{
+			Name: "No hostPath volumes",
+			Volumes: []v1.Volume{
+				{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+			},
+			Expected: recommendation.Result{},
+		},
+		{
+			Name: "HostPath volume",
+			Volumes: []v1.Volume{
+				{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+				{Name: "docker-sock", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}},
+			},
+			Expected: recommendation.Result{
+				Warnings: []string{
+					"Pod 'foo/pod-name' mounts '/var/run/docker.sock' host path as volume 'docker-sock'. It exposes the Node filesystem to the Pod.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recomm := recommendation.NewPodNoHostPathVolumes()
+			pod := fixPodWithContainers(nil, []v1.Container{{Name: "app"}})
+			pod.Spec.Volumes = testCase.Volumes
+			event := fixEventForObject(t, pod, pod.ObjectMeta, config.CreateEvent, "v1/pods")
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
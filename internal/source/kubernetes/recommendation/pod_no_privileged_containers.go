@@ -0,0 +1,84 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podNoPrivilegedContainersName = "PodNoPrivilegedContainers"
+
+// PodNoPrivilegedContainers adds warnings if Pod containers are privileged or run as root.
+type PodNoPrivilegedContainers struct{}
+
+// NewPodNoPrivilegedContainers creates a new PodNoPrivilegedContainers instance.
+func NewPodNoPrivilegedContainers() *PodNoPrivilegedContainers {
+	return &PodNoPrivilegedContainers{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoPrivilegedContainers) Do(_ context.Context, event event.Event) (Result, error) {
+	pod, ok, err := podForCreateEvent(event)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	podIdentifier := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	warningMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, pod.Spec.SecurityContext, podIdentifier)
+	warningMsgs = append(warningMsgs, f.checkContainers("container", pod.Spec.Containers, pod.Spec.SecurityContext, podIdentifier)...)
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+func (f *PodNoPrivilegedContainers) checkContainers(fieldName string, containers []coreV1.Container, podSecCtx *coreV1.PodSecurityContext, podIdentifier string) []string {
+	var recomms []string
+	for _, c := range containers {
+		if c.SecurityContext != nil && c.SecurityContext.Privileged != nil && *c.SecurityContext.Privileged {
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' runs in privileged mode. It has access to all devices on the host.", podIdentifier, fieldName, c.Name))
+		}
+		runsAsRoot, mayRunAsRoot := f.rootUser(c.SecurityContext, podSecCtx)
+		switch {
+		case runsAsRoot:
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' runs as root user. Consider setting 'runAsNonRoot' and a non-zero 'runAsUser' in its security context.", podIdentifier, fieldName, c.Name))
+		case mayRunAsRoot:
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' may run as root user, as 'runAsNonRoot' isn't enabled and 'runAsUser' isn't set. Consider setting them in its security context.", podIdentifier, fieldName, c.Name))
+		}
+	}
+
+	return recomms
+}
+
+// rootUser checks if a given container runs as root. The first value is true if the root user is explicitly set.
+// The second one is true if the user isn't set and running as root is not forbidden, so the image user is used, which is often root.
+// Container security context takes precedence over the Pod one.
+func (f *PodNoPrivilegedContainers) rootUser(secCtx *coreV1.SecurityContext, podSecCtx *coreV1.PodSecurityContext) (bool, bool) {
+	var (
+		runAsUser    *int64
+		runAsNonRoot *bool
+	)
+	if podSecCtx != nil {
+		runAsUser, runAsNonRoot = podSecCtx.RunAsUser, podSecCtx.RunAsNonRoot
+	}
+	if secCtx != nil && secCtx.RunAsUser != nil {
+		runAsUser = secCtx.RunAsUser
+	}
+	if secCtx != nil && secCtx.RunAsNonRoot != nil {
+		runAsNonRoot = secCtx.RunAsNonRoot
+	}
+
+	if runAsUser != nil {
+		return *runAsUser == 0, false
+	}
+	return false, runAsNonRoot == nil || !*runAsNonRoot
+}
+
+// Name returns the recommendation name.
+func (f *PodNoPrivilegedContainers) Name() string {
+	return podNoPrivilegedContainersName
+}
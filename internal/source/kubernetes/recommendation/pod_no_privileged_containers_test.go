@@ -0,0 +1,99 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+	"github.com/kubeshop/botkube/pkg/ptr"
+)
+
+func TestPodNoPrivilegedContainers_Do(t *testing.T) {
+	// given
+	root := int64(0)
+	nonRoot := int64(1000)
+
+	testCases := []struct {
+		Name      string
+		Pod       *v1.Pod
+		PodSecCtx *v1.PodSecurityContext
+		Expected  recommendation.Result
+	}{
+		{
+			Name: "Unprivileged non-root containers",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app", SecurityContext: &v1.SecurityContext{RunAsUser: &nonRoot, Privileged: ptr.Bool(false)}},
+				{Name: "sidecar", SecurityContext: &v1.SecurityContext{RunAsNonRoot: ptr.Bool(true)}},
+			}),
+			Expected: recommendation.Result{},
+		},
+		{
+			Name: "Non-root enforced on Pod level",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app"},
+			}),
+			PodSecCtx: &v1.PodSecurityContext{RunAsNonRoot: ptr.Bool(true)},
+			Expected:  recommendation.Result{},
+		},
+		{
+			Name: "User not set",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app"},
+				{Name: "sidecar", SecurityContext: &v1.SecurityContext{RunAsNonRoot: ptr.Bool(false)}},
+			}),
+			Expected: recommendation.Result{
+				Warnings: []string{
+					"Pod 'foo/pod-name' container 'app' may run as root user, as 'runAsNonRoot' isn't enabled and 'runAsUser' isn't set. Consider setting them in its security context.",
+					"Pod 'foo/pod-name' container 'sidecar' may run as root user, as 'runAsNonRoot' isn't enabled and 'runAsUser' isn't set. Consider setting them in its security context.",
+				},
+			},
+		},
+		{
+			Name: "Privileged and root containers",
+			Pod: fixPodWithContainers(
+				[]v1.Container{{Name: "init", SecurityContext: &v1.SecurityContext{Privileged: ptr.Bool(true), RunAsUser: &nonRoot}}},
+				[]v1.Container{
+					{Name: "app", SecurityContext: &v1.SecurityContext{RunAsUser: &root}},
+				},
+			),
+			Expected: recommendation.Result{
+				Warnings: []string{
+					"Pod 'foo/pod-name' initContainer 'init' runs in privileged mode. It has access to all devices on the host.",
+					"Pod 'foo/pod-name' container 'app' runs as root user. Consider setting 'runAsNonRoot' and a non-zero 'runAsUser' in its security context.",
+				},
+			},
+		},
+		{
+			Name: "Root user inherited from Pod security context",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app"},
+				{Name: "sidecar", SecurityContext: &v1.SecurityContext{RunAsUser: &nonRoot}},
+			}),
+			PodSecCtx: &v1.PodSecurityContext{RunAsUser: &root},
+			Expected: recommendation.Result{
+				Warnings: []string{
+					"Pod 'foo/pod-name' container 'app' runs as root user. Consider setting 'runAsNonRoot' and a non-zero 'runAsUser' in its security context.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recomm := recommendation.NewPodNoPrivilegedContainers()
+			testCase.Pod.Spec.SecurityContext = testCase.PodSecCtx
+			event := fixEventForObject(t, testCase.Pod, testCase.Pod.ObjectMeta, config.CreateEvent, "v1/pods")
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodProbesSet_Do(t *testing.T) {
+	// given
+	probe := &v1.Probe{ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}}}
+
+	testCases := []struct {
+		Name     string
+		Pod      *v1.Pod
+		Expected recommendation.Result
+	}{
+		{
+			Name: "Both probes set",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app", LivenessProbe: probe, ReadinessProbe: probe},
+			}),
+			Expected: recommendation.Result{},
+		},
+		{
+			Name: "Missing probes",
+			Pod: fixPodWithContainers(
+				[]v1.Container{{Name: "init"}},
+				[]v1.Container{
+					{Name: "app", LivenessProbe: probe},
+					{Name: "sidecar"},
+				},
+			),
+			Expected: recommendation.Result{
+				Info: []string{
+					"Pod 'foo/pod-name' container 'app' doesn't define readiness probe. Consider defining it, so Kubernetes can detect unhealthy containers.",
+					"Pod 'foo/pod-name' container 'sidecar' doesn't define liveness and readiness probe. Consider defining it, so Kubernetes can detect unhealthy containers.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recomm := recommendation.NewPodProbesSet()
+			event := fixEventForObject(t, testCase.Pod, testCase.Pod.ObjectMeta, config.CreateEvent, "v1/pods")
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
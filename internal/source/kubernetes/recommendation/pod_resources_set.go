@@ -0,0 +1,70 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podResourcesSetName = "PodResourcesSet"
+
+// PodResourcesSet adds recommendations if Pod containers don't define CPU and memory requests or limits.
+type PodResourcesSet struct{}
+
+// NewPodResourcesSet creates a new PodResourcesSet instance.
+func NewPodResourcesSet() *PodResourcesSet {
+	return &PodResourcesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodResourcesSet) Do(_ context.Context, event event.Event) (Result, error) {
+	pod, ok, err := podForCreateEvent(event)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	podIdentifier := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	infoMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, podIdentifier)
+	infoMsgs = append(infoMsgs, f.checkContainers("container", pod.Spec.Containers, podIdentifier)...)
+
+	return Result{
+		Info: infoMsgs,
+	}, nil
+}
+
+func (f *PodResourcesSet) checkContainers(fieldName string, containers []coreV1.Container, podIdentifier string) []string {
+	var recomms []string
+	for _, c := range containers {
+		var missing []string
+		if _, ok := c.Resources.Requests[coreV1.ResourceCPU]; !ok {
+			missing = append(missing, "CPU request")
+		}
+		if _, ok := c.Resources.Requests[coreV1.ResourceMemory]; !ok {
+			missing = append(missing, "memory request")
+		}
+		if _, ok := c.Resources.Limits[coreV1.ResourceCPU]; !ok {
+			missing = append(missing, "CPU limit")
+		}
+		if _, ok := c.Resources.Limits[coreV1.ResourceMemory]; !ok {
+			missing = append(missing, "memory limit")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		recommendationMsg := fmt.Sprintf("Pod '%s' %s '%s' doesn't define %s. Consider setting them, to make scheduling and resource usage predictable.", podIdentifier, fieldName, c.Name, strings.Join(missing, ", "))
+		recomms = append(recomms, recommendationMsg)
+	}
+
+	return recomms
+}
+
+// Name returns the recommendation name.
+func (f *PodResourcesSet) Name() string {
+	return podResourcesSetName
+}
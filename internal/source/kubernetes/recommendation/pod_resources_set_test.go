@@ -0,0 +1,117 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodResourcesSet_Do(t *testing.T) {
+	// given
+	fullResources := v1.ResourceRequirements{
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("100m"),
+			v1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("200m"),
+			v1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+	}
+
+	testCases := []struct {
+		Name      string
+		Pod       *v1.Pod
+		EventType config.EventType
+		Expected  recommendation.Result
+	}{
+		{
+			Name: "All resources set",
+			Pod: fixPodWithContainers(nil, []v1.Container{
+				{Name: "app", Resources: fullResources},
+			}),
+			EventType: config.CreateEvent,
+			Expected:  recommendation.Result{},
+		},
+		{
+			Name: "Missing requests and limits",
+			Pod: fixPodWithContainers(
+				[]v1.Container{{Name: "init"}},
+				[]v1.Container{
+					{Name: "app", Resources: fullResources},
+					{Name: "sidecar", Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+					}},
+				},
+			),
+			EventType: config.CreateEvent,
+			Expected: recommendation.Result{
+				Info: []string{
+					"Pod 'foo/pod-name' initContainer 'init' doesn't define CPU request, memory request, CPU limit, memory limit. Consider setting them, to make scheduling and resource usage predictable.",
+					"Pod 'foo/pod-name' container 'sidecar' doesn't define memory request, CPU limit, memory limit. Consider setting them, to make scheduling and resource usage predictable.",
+				},
+			},
+		},
+		{
+			Name:      "Update event",
+			Pod:       fixPodWithContainers(nil, []v1.Container{{Name: "app"}}),
+			EventType: config.UpdateEvent,
+			Expected:  recommendation.Result{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recomm := recommendation.NewPodResourcesSet()
+			event := fixEventForObject(t, testCase.Pod, testCase.Pod.ObjectMeta, testCase.EventType, "v1/pods")
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func fixPodWithContainers(initContainers, containers []v1.Container) *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-name",
+			Namespace: "foo",
+		},
+		Spec: v1.PodSpec{
+			InitContainers: initContainers,
+			Containers:     containers,
+		},
+	}
+}
+
+func fixEventForObject(t *testing.T, obj runtime.Object, objMeta metav1.ObjectMeta, eventType config.EventType, resource string) event.Event {
+	t.Helper()
+
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(objMeta, unstr, eventType, resource)
+	require.NoError(t, err)
+
+	return event
+}
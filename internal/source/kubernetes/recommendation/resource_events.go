@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	podsResourceType    = "v1/pods"
-	ingressResourceType = "networking.k8s.io/v1/ingresses"
+	podsResourceType        = "v1/pods"
+	ingressResourceType     = "networking.k8s.io/v1/ingresses"
+	deploymentsResourceType = "apps/v1/deployments"
+	servicesResourceType    = "v1/services"
 )
 
 // ResourceEventsForConfig returns the resource event map for a given source recommendations config.
@@ -22,10 +24,20 @@ func ResourceEventsForConfig(recCfg *config.Recommendations) map[string]config.E
 		resTypes[ingressResourceType] = config.CreateEvent
 	}
 
-	if ptr.IsTrue(recCfg.Pod.NoLatestImageTag) || ptr.IsTrue(recCfg.Pod.LabelsSet) {
+	if ptr.IsTrue(recCfg.Pod.NoLatestImageTag) || ptr.IsTrue(recCfg.Pod.LabelsSet) ||
+		ptr.IsTrue(recCfg.Pod.ResourcesSet) || ptr.IsTrue(recCfg.Pod.ProbesSet) ||
+		ptr.IsTrue(recCfg.Pod.NoPrivilegedContainers) || ptr.IsTrue(recCfg.Pod.NoHostPathVolumes) {
 		resTypes[podsResourceType] = config.CreateEvent
 	}
 
+	if ptr.IsTrue(recCfg.Deployment.PodDisruptionBudgetSet) {
+		resTypes[deploymentsResourceType] = config.CreateEvent
+	}
+
+	if ptr.IsTrue(recCfg.Service.SelectorMatchesPods) {
+		resTypes[servicesResourceType] = config.CreateEvent
+	}
+
 	return resTypes
 }
 
@@ -62,6 +62,39 @@ func TestResourceEventsForConfig(t *testing.T) {
 				recommendation.IngressResourceType(): config.CreateEvent,
 			},
 		},
+		{
+			Name: "Pod Probes Set",
+			RecCfg: config.Recommendations{
+				Pod: config.PodRecommendations{
+					ProbesSet: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.PodResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Deployment PodDisruptionBudget Set",
+			RecCfg: config.Recommendations{
+				Deployment: config.DeploymentRecommendations{
+					PodDisruptionBudgetSet: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.DeploymentResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Service Selector Matches Pods",
+			RecCfg: config.Recommendations{
+				Service: config.ServiceRecommendations{
+					SelectorMatchesPods: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.ServiceResourceType(): config.CreateEvent,
+			},
+		},
 		{
 			Name: "All",
 			RecCfg: config.Recommendations{
@@ -0,0 +1,139 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const (
+	serviceSelectorMatchesPodsName = "ServiceSelectorMatchesPods"
+
+	// serviceSelectorGracePeriod is the time given to create Pods matching a new Service.
+	// Services are often applied together with, or even before, their workloads, e.g. with `kubectl apply -f dir/`.
+	serviceSelectorGracePeriod   = 15 * time.Second
+	serviceSelectorCheckInterval = 3 * time.Second
+)
+
+// podTemplateWorkloads contains workloads which Pod templates are checked, as they create matching Pods eventually.
+var podTemplateWorkloads = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+}
+
+// ServiceSelectorMatchesPods adds warnings if Service selector doesn't match any Pod.
+// A new Service is reported only if neither Pods nor workload Pod templates match its selector within the grace period.
+type ServiceSelectorMatchesPods struct {
+	dynamicCli    dynamic.Interface
+	gracePeriod   time.Duration
+	checkInterval time.Duration
+}
+
+// NewServiceSelectorMatchesPods creates a new ServiceSelectorMatchesPods instance.
+func NewServiceSelectorMatchesPods(dynamicCli dynamic.Interface) *ServiceSelectorMatchesPods {
+	return &ServiceSelectorMatchesPods{
+		dynamicCli:    dynamicCli,
+		gracePeriod:   serviceSelectorGracePeriod,
+		checkInterval: serviceSelectorCheckInterval,
+	}
+}
+
+// Do executes the recommendation checks.
+func (f *ServiceSelectorMatchesPods) Do(ctx context.Context, event event.Event) (Result, error) {
+	if event.Kind != "Service" || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var svc coreV1.Service
+	err := k8sutil.TransformIntoTypedObject(unstrObj, &svc)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, svc, err)
+	}
+
+	// Services without selector have manually managed endpoints, e.g. ExternalName ones.
+	if len(svc.Spec.Selector) == 0 {
+		return Result{}, nil
+	}
+
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	deadline := time.Now().Add(f.gracePeriod)
+	for {
+		matched, err := f.matchesAnyPod(ctx, svc.Namespace, selector)
+		if err != nil {
+			return Result{}, err
+		}
+		if matched {
+			return Result{}, nil
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+
+		select {
+		case <-time.After(f.checkInterval):
+		case <-ctx.Done():
+			return Result{}, ctx.Err()
+		}
+	}
+
+	warningMsg := fmt.Sprintf("Service '%s/%s' selector '%s' doesn't match any Pod.", svc.Namespace, svc.Name, selector.String())
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+// matchesAnyPod returns true if the selector matches an existing Pod or a Pod template of a workload.
+func (f *ServiceSelectorMatchesPods) matchesAnyPod(ctx context.Context, namespace string, selector labels.Selector) (bool, error) {
+	podGVR := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+	pods, err := f.dynamicCli.Resource(podGVR).Namespace(namespace).List(ctx, metaV1.ListOptions{
+		LabelSelector: selector.String(),
+		Limit:         1,
+	})
+	if err != nil {
+		return false, fmt.Errorf("while listing Pods: %w", err)
+	}
+	if len(pods.Items) > 0 {
+		return true, nil
+	}
+
+	for _, gvr := range podTemplateWorkloads {
+		list, err := f.dynamicCli.Resource(gvr).Namespace(namespace).List(ctx, metaV1.ListOptions{})
+		if err != nil {
+			// workloads are checked only to avoid false positives, so they are skipped if they can't be listed
+			continue
+		}
+		for _, item := range list.Items {
+			templateLabels, _, _ := unstructured.NestedStringMap(item.Object, "spec", "template", "metadata", "labels")
+			if len(templateLabels) > 0 && selector.Matches(labels.Set(templateLabels)) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Name returns the recommendation name.
+func (f *ServiceSelectorMatchesPods) Name() string {
+	return serviceSelectorMatchesPodsName
+}
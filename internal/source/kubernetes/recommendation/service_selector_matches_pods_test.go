@@ -0,0 +1,145 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestServiceSelectorMatchesPods_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name         string
+		Selector     map[string]string
+		ExistingPods []runtime.Object
+		Expected     recommendation.Result
+	}{
+		{
+			Name:     "Service without selector",
+			Selector: nil,
+			Expected: recommendation.Result{},
+		},
+		{
+			Name:     "Selector matches Pod",
+			Selector: map[string]string{"app": "web"},
+			ExistingPods: []runtime.Object{
+				fixPodWithLabels("web-1", "default", map[string]string{"app": "web", "tier": "frontend"}),
+			},
+			Expected: recommendation.Result{},
+		},
+		{
+			Name:     "Selector matches Deployment Pod template",
+			Selector: map[string]string{"app": "web"},
+			ExistingPods: []runtime.Object{
+				fixDeploymentWithTemplateLabels("web", "default", map[string]string{"app": "web", "tier": "frontend"}),
+			},
+			Expected: recommendation.Result{},
+		},
+		{
+			Name:     "Selector doesn't match any Pod",
+			Selector: map[string]string{"app": "web"},
+			ExistingPods: []runtime.Object{
+				fixPodWithLabels("api-1", "default", map[string]string{"app": "api"}),
+				fixPodWithLabels("web-1", "other", map[string]string{"app": "web"}),
+				fixDeploymentWithTemplateLabels("api", "default", map[string]string{"app": "api"}),
+			},
+			Expected: recommendation.Result{
+				Warnings: []string{
+					"Service 'default/web' selector 'app=web' doesn't match any Pod.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fixServiceSelectorDynamicClient(testCase.ExistingPods...)
+			recomm := recommendation.NewServiceSelectorMatchesPods(dynamicCli).WithGracePeriod(0, 0)
+			event := fixServiceEvent(t, testCase.Selector)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func TestServiceSelectorMatchesPods_DoWaitsForPods(t *testing.T) {
+	// given
+	dynamicCli := fixServiceSelectorDynamicClient()
+	recomm := recommendation.NewServiceSelectorMatchesPods(dynamicCli).WithGracePeriod(5*time.Second, 10*time.Millisecond)
+	event := fixServiceEvent(t, map[string]string{"app": "web"})
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		err := dynamicCli.Tracker().Add(fixPodWithLabels("web-1", "default", map[string]string{"app": "web"}))
+		assert.NoError(t, err)
+	}()
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, recommendation.Result{}, actual)
+}
+
+func fixServiceSelectorDynamicClient(objects ...runtime.Object) *fake.FakeDynamicClient {
+	return fake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme, map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "pods"}:                        "PodList",
+		{Group: "apps", Version: "v1", Resource: "deployments"}:  "DeploymentList",
+		{Group: "apps", Version: "v1", Resource: "statefulsets"}: "StatefulSetList",
+		{Group: "apps", Version: "v1", Resource: "daemonsets"}:   "DaemonSetList",
+		{Group: "apps", Version: "v1", Resource: "replicasets"}:  "ReplicaSetList",
+	}, objects...)
+}
+
+func fixServiceEvent(t *testing.T, selector map[string]string) event.Event {
+	t.Helper()
+
+	svc := &v1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       v1.ServiceSpec{Selector: selector},
+	}
+	return fixEventForObject(t, svc, svc.ObjectMeta, config.CreateEvent, "v1/services")
+}
+
+func fixDeploymentWithTemplateLabels(name, namespace string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			},
+		},
+	}
+}
+
+func fixPodWithLabels(name, namespace string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+	}
+}
@@ -11,23 +11,28 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/kubeshop/botkube/internal/source/kubernetes/condition"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/rollout"
 	"github.com/kubeshop/botkube/pkg/multierror"
 )
 
 type registration struct {
-	informer        cache.SharedIndexInformer
+	informer        informerGroup
 	log             logrus.FieldLogger
 	mapper          meta.RESTMapper
 	dynamicCli      dynamic.Interface
 	events          []config.EventType
 	mappedResources []string
 	mappedEvent     config.EventType
+	resolveOwners   bool
+	ownerResolver   *k8sutil.OwnerResolver
 }
 
 func (r registration) handleEvent(ctx context.Context, s Source, resource string, eventType config.EventType, routes []route, fn eventHandler) {
@@ -44,7 +49,7 @@ func (r registration) handleEvent(ctx context.Context, s Source, resource string
 			return
 		}
 
-		ok, diffs, err := r.qualifyEvent(event, newObj, oldObj, routes)
+		ok, diffs, err := r.qualifyEvent(ctx, &event, newObj, oldObj, routes)
 		if err != nil {
 			logger.Errorf("while getting sources for event: %s", err.Error())
 			// continue anyway, there could be still some sources to handle
@@ -94,7 +99,7 @@ func (r registration) handleMapped(ctx context.Context, s Source, eventType conf
 				return
 			}
 
-			gvrString := gvrToString(gvr)
+			gvrString := k8sutil.GVRToString(gvr)
 			if !r.includesSrcResource(gvrString) {
 				return
 			}
@@ -106,7 +111,7 @@ func (r registration) handleMapped(ctx context.Context, s Source, eventType conf
 			}
 
 			routes := eventRoutes(routeTable, gvrString, eventType)
-			ok, err := r.matchEvent(routes, event)
+			ok, err := r.matchEvent(ctx, routes, &event)
 			if err != nil {
 				r.log.Errorf("cannot calculate event for observed mapped resource event: %q in Add event handler: %s", eventType, err.Error())
 				// continue anyway, there could be still some sources to handle
@@ -119,6 +124,155 @@ func (r registration) handleMapped(ctx context.Context, s Source, eventType conf
 	})
 }
 
+// handleRolloutEvents registers a single update handler for all rollout event types,
+// as the rollout state needs to be tracked once per object.
+func (r registration) handleRolloutEvents(ctx context.Context, s Source, resource string, routeTable map[string][]entry, fn eventHandler) {
+	tracker := rollout.NewTracker()
+
+	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldUnstruct, oldOK := oldObj.(*unstructured.Unstructured)
+			newUnstruct, newOK := newObj.(*unstructured.Unstructured)
+			if !oldOK || !newOK {
+				r.log.Errorf("Unable to detect rollout for object types: %T, %T", oldObj, newObj)
+				return
+			}
+
+			res, detected := tracker.Detect(oldUnstruct, newUnstruct)
+			if !detected || !r.canHandleEvent(res.Type.String()) {
+				return
+			}
+
+			event, err := r.eventForObj(ctx, newObj, res.Type, resource)
+			if err != nil {
+				r.log.Errorf("while creating new event: %s", err.Error())
+				return
+			}
+			event.Messages = append(event.Messages, res.Messages...)
+
+			routes := eventRoutes(routeTable, resource, res.Type)
+			ok, err := r.matchEvent(ctx, routes, &event)
+			if err != nil {
+				r.log.Errorf("while matching rollout event %q: %s", res.Type, err.Error())
+				// continue anyway, there could be still some sources to handle
+			}
+			if !ok {
+				return
+			}
+			fn(ctx, s, event, nil)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if unstruct, ok := obj.(*unstructured.Unstructured); ok {
+				tracker.Forget(unstruct)
+			}
+		},
+	})
+}
+
+// handleConditionEvents registers a single update handler which reports status condition transitions.
+// Each transition is reported separately and only for routes which watch a given condition type.
+func (r registration) handleConditionEvents(ctx context.Context, s Source, resource string, routes []route, fn eventHandler) {
+	condTypes := conditionTypesForRoutes(routes)
+
+	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldUnstruct, oldOK := oldObj.(*unstructured.Unstructured)
+			newUnstruct, newOK := newObj.(*unstructured.Unstructured)
+			if !oldOK || !newOK {
+				r.log.Errorf("Unable to detect condition changes for object types: %T, %T", oldObj, newObj)
+				return
+			}
+
+			for _, tr := range condition.Detect(oldUnstruct, newUnstruct, condTypes) {
+				condRoutes, cond := routesForCondition(routes, tr.Type)
+
+				event, err := r.eventForObj(ctx, newObj, config.ConditionChangedEvent, resource)
+				if err != nil {
+					r.log.Errorf("while creating new event for %q condition: %s", tr.Type, err.Error())
+					continue
+				}
+				event.Title = fmt.Sprintf("%s %s condition changed to %s", resource, tr.Type, tr.NewStatus)
+				event.Reason = tr.Reason
+				event.Messages = append(event.Messages, tr.Messages()...)
+				event.Level = levelForCondition(cond, tr.NewStatus)
+
+				ok, err := r.matchEvent(ctx, condRoutes, &event)
+				if err != nil {
+					r.log.Errorf("while matching condition event for %q: %s", tr.Type, err.Error())
+					// continue anyway, there could be still some sources to handle
+				}
+				if !ok {
+					continue
+				}
+				fn(ctx, s, event, nil)
+			}
+		},
+	})
+}
+
+// conditionTypesForRoutes returns unique condition types watched by any of the routes.
+func conditionTypesForRoutes(routes []route) []string {
+	var out []string
+	seen := map[string]struct{}{}
+	for _, rt := range routes {
+		for _, cond := range rt.conditions {
+			if _, ok := seen[cond.Type]; ok {
+				continue
+			}
+			seen[cond.Type] = struct{}{}
+			out = append(out, cond.Type)
+		}
+	}
+	return out
+}
+
+// routesForCondition returns routes which watch a given condition type, together with the condition configuration of the first one.
+func routesForCondition(routes []route, condType string) ([]route, config.ResourceCondition) {
+	var (
+		out   []route
+		first *config.ResourceCondition
+	)
+	for _, rt := range routes {
+		for i := range rt.conditions {
+			if rt.conditions[i].Type != condType {
+				continue
+			}
+			out = append(out, rt)
+			if first == nil {
+				first = &rt.conditions[i]
+			}
+			break
+		}
+	}
+	if first == nil {
+		return nil, config.ResourceCondition{Type: condType}
+	}
+	return out, *first
+}
+
+func levelForCondition(cond config.ResourceCondition, status string) config.Level {
+	switch {
+	case strings.EqualFold(status, "Unknown"):
+		return config.Info
+	case cond.IsHealthy(status):
+		return config.Success
+	default:
+		return config.Error
+	}
+}
+
+func (r registration) canHandleAnyEvent(targets []config.EventType) bool {
+	for _, target := range targets {
+		if r.canHandleEvent(target.String()) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r registration) canHandleEvent(target string) bool {
 	for _, e := range r.events {
 		if strings.EqualFold(target, e.String()) {
@@ -137,7 +291,18 @@ func (r registration) includesSrcResource(resource string) bool {
 	return false
 }
 
-func (r registration) matchEvent(routes []route, event event.Event) (bool, error) {
+// matchEvent returns true if the event matches any of the routes. The owner reference chain is resolved only
+// for routes with owner constraints and for matched events, so dropped events don't cost additional API calls.
+func (r registration) matchEvent(ctx context.Context, routes []route, event *event.Event) (bool, error) {
+	ownersResolved := false
+	resolveOwners := func() {
+		if ownersResolved {
+			return
+		}
+		ownersResolved = true
+		r.resolveEventOwners(ctx, event)
+	}
+
 	errs := multierror.New()
 	for _, rt := range routes {
 		// event reason
@@ -190,6 +355,19 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 			}
 		}
 
+		// top-level owner
+		if rt.owner.AreConstraintsDefined() {
+			resolveOwners()
+			match, err := matchOwner(rt.owner, event)
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				r.log.Debugf("Ignoring as owner %+v doesn't match constraints %+v", event.Owners, rt.owner)
+				return false, nil
+			}
+		}
+
 		// namespace
 		if rt.namespaces.AreConstraintsDefined() {
 			match, err := rt.namespaces.IsAllowed(event.Namespace)
@@ -211,12 +389,36 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 		if !kvsSatisfiedForMap(rt.labels, event.ObjectMeta.Labels) {
 			continue
 		}
+
+		resolveOwners()
 		return true, nil
 	}
 
 	return false, errs.ErrorOrNil()
 }
 
+// matchOwner checks whether the top-level owner of the involved object matches the constraints.
+// Objects without owners are matched against empty kind and name.
+func matchOwner(constraints config.OwnerConstraints, event *event.Event) (bool, error) {
+	owner, _ := event.TopLevelOwner()
+
+	if constraints.Kind.AreConstraintsDefined() {
+		match, err := constraints.Kind.IsAllowed(owner.Kind)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+
+	if constraints.Name.AreConstraintsDefined() {
+		match, err := constraints.Name.IsAllowed(owner.Name)
+		if err != nil || !match {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
 func kvsSatisfiedForMap(expectedKV *map[string]string, obj map[string]string) bool {
 	if expectedKV == nil || len(*expectedKV) == 0 {
 		return true
@@ -254,12 +456,46 @@ func (r registration) eventForObj(ctx context.Context, obj interface{}, eventTyp
 	return e, nil
 }
 
+// resolveEventOwners sets the owner reference chain of the involved object, if it's needed.
+func (r registration) resolveEventOwners(ctx context.Context, e *event.Event) {
+	if !r.resolveOwners {
+		return
+	}
+
+	owners, err := r.ownerChainForEvent(ctx, e)
+	if err != nil {
+		// the event is still valuable without owners, or with the owners resolved so far
+		r.log.Errorf("while resolving owners for %s/%s: %s", e.Kind, e.Name, err.Error())
+	}
+	e.Owners = owners
+}
+
+func (r registration) ownerChainForEvent(ctx context.Context, e *event.Event) ([]k8sutil.OwnerReference, error) {
+	refs := e.ObjectMeta.OwnerReferences
+	if k8sutil.GetObjectTypeMetaData(e.Object).Kind == "Event" {
+		// for core Events, the owners are taken from the involved object
+		var uid string
+		if unstruct, ok := e.Object.(*unstructured.Unstructured); ok {
+			uid, _, _ = unstructured.NestedString(unstruct.Object, "involvedObject", "uid")
+		}
+		gvk := schema.FromAPIVersionAndKind(e.APIVersion, e.Kind)
+		involvedObjRefs, err := r.ownerResolver.GetOwnerReferences(ctx, gvk, e.Namespace, e.Name, types.UID(uid))
+		if err != nil {
+			return nil, fmt.Errorf("while getting owner references of involved object: %w", err)
+		}
+		refs = involvedObjRefs
+	}
+
+	return r.ownerResolver.GetOwnerChain(ctx, e.Namespace, refs)
+}
+
 func (r registration) qualifyEvent(
-	event event.Event,
+	ctx context.Context,
+	event *event.Event,
 	newObj, oldObj interface{},
 	routes []route,
 ) (bool, []string, error) {
-	ok, err := r.matchEvent(routes, event)
+	ok, err := r.matchEvent(ctx, routes, event)
 	if err != nil {
 		return false, nil, fmt.Errorf("while matching event: %w", err)
 	}
@@ -311,11 +547,3 @@ func (r registration) qualifyEventForUpdate(
 
 	return true, diffs, nil
 }
-
-// gvrToString converts GVR formats to string.
-func gvrToString(gvr schema.GroupVersionResource) string {
-	if gvr.Group == "" {
-		return fmt.Sprintf("%s/%s", gvr.Version, gvr.Resource)
-	}
-	return fmt.Sprintf("%s/%s/%s", gvr.Group, gvr.Version, gvr.Resource)
-}
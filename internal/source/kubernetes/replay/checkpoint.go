@@ -0,0 +1,107 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// Checkpoint describes the last delivered event.
+type Checkpoint struct {
+	LastSeen time.Time `json:"lastSeen"`
+	// Delivered lists IDs of events delivered at LastSeen, as event timestamps have the second precision.
+	Delivered []string `json:"delivered,omitempty"`
+}
+
+// Store persists checkpoints.
+type Store interface {
+	Load(ctx context.Context) (Checkpoint, bool, error)
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// ConfigMapStore persists the checkpoint in a ConfigMap, under a given data key.
+// Multiple sources can share the same ConfigMap, as long as they use different keys.
+type ConfigMapStore struct {
+	k8sCli    kubernetes.Interface
+	namespace string
+	name      string
+	key       string
+}
+
+// NewConfigMapStore returns a new ConfigMapStore instance.
+func NewConfigMapStore(k8sCli kubernetes.Interface, namespace, name, key string) *ConfigMapStore {
+	return &ConfigMapStore{
+		k8sCli:    k8sCli,
+		namespace: namespace,
+		name:      name,
+		key:       key,
+	}
+}
+
+// Load returns the persisted checkpoint. It returns false if the checkpoint wasn't persisted yet.
+func (s *ConfigMapStore) Load(ctx context.Context) (Checkpoint, bool, error) {
+	cm, err := s.k8sCli.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return Checkpoint{}, false, nil
+	}
+	if err != nil {
+		return Checkpoint{}, false, fmt.Errorf("while getting ConfigMap %s/%s: %w", s.namespace, s.name, err)
+	}
+
+	raw, ok := cm.Data[s.key]
+	if !ok {
+		return Checkpoint{}, false, nil
+	}
+	var out Checkpoint
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		return Checkpoint{}, false, fmt.Errorf("while unmarshaling checkpoint %q: %w", s.key, err)
+	}
+	return out, true, nil
+}
+
+// Save persists the checkpoint. The ConfigMap is created if it doesn't exist.
+func (s *ConfigMapStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("while marshaling checkpoint: %w", err)
+	}
+
+	cli := s.k8sCli.CoreV1().ConfigMaps(s.namespace)
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := cli.Get(ctx, s.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.name,
+					Namespace: s.namespace,
+				},
+				Data: map[string]string{s.key: string(raw)},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("while creating ConfigMap %s/%s: %w", s.namespace, s.name, err)
+			}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("while getting ConfigMap %s/%s: %w", s.namespace, s.name, err)
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[s.key] = string(raw)
+		_, err = cli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("while saving checkpoint %q: %w", s.key, err)
+	}
+	return nil
+}
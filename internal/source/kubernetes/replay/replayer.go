@@ -0,0 +1,214 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// saveOnStopTimeout is the time given to persist the checkpoint once the source is stopped.
+	saveOnStopTimeout = 5 * time.Second
+
+	// maxBufferedEvents limits the number of buffered events. Once it's reached, buffered events are flushed.
+	maxBufferedEvents = 1000
+)
+
+// Item is an event delivered through the Replayer.
+type Item struct {
+	ID        string
+	TimeStamp time.Time
+	Event     source.Event
+}
+
+// Replayer replays events emitted while the source wasn't running.
+// Events newer than the persisted checkpoint are buffered until Flush is called or the buffer is full,
+// so the replayed and new events are delivered in the chronological order.
+type Replayer struct {
+	log    logrus.FieldLogger
+	store  Store
+	cutoff time.Time
+	// delivered holds IDs of events delivered at the persisted checkpoint time.
+	delivered map[string]struct{}
+	lastSeen  time.Time
+
+	mu           sync.Mutex
+	buffering    bool
+	buffer       []Item
+	lastBuffered time.Time
+	current      Checkpoint
+	dirty        bool
+}
+
+// New returns a new Replayer instance. Events older than the window are never replayed.
+// If the checkpoint wasn't persisted yet, only events emitted after the source started are delivered.
+func New(ctx context.Context, log logrus.FieldLogger, store Store, startTime time.Time, window time.Duration) (*Replayer, error) {
+	checkpoint, found, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("while loading checkpoint: %w", err)
+	}
+
+	r := &Replayer{
+		log:       log,
+		store:     store,
+		cutoff:    startTime,
+		delivered: map[string]struct{}{},
+		buffering: true,
+		current:   checkpoint,
+	}
+	if !found {
+		log.Info("Checkpoint not found. Only new events are delivered.")
+		return r, nil
+	}
+
+	r.lastSeen = checkpoint.LastSeen
+	r.cutoff = checkpoint.LastSeen
+	if windowStart := startTime.Add(-window); r.cutoff.Before(windowStart) {
+		r.cutoff = windowStart
+	}
+	for _, id := range checkpoint.Delivered {
+		r.delivered[id] = struct{}{}
+	}
+	log.Infof("Replaying events emitted since %s.", r.cutoff.Format(time.RFC3339))
+	return r, nil
+}
+
+// ShouldReplay returns true if the event emitted before the source started wasn't delivered yet.
+func (r *Replayer) ShouldReplay(id string, timeStamp time.Time) bool {
+	if timeStamp.Before(r.cutoff) {
+		return false
+	}
+	if _, delivered := r.delivered[id]; delivered && timeStamp.Equal(r.lastSeen) {
+		return false
+	}
+	return true
+}
+
+// Deliver sends the event to a given channel, or buffers it until Flush is called.
+// If the buffer is full, all buffered events are flushed.
+func (r *Replayer) Deliver(ctx context.Context, item Item, ch chan<- source.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.buffering {
+		r.send(ctx, item, ch)
+		return
+	}
+
+	r.buffer = append(r.buffer, item)
+	r.lastBuffered = time.Now()
+	if len(r.buffer) >= maxBufferedEvents {
+		r.log.Warnf("Reached the limit of %d buffered events. Flushing...", maxBufferedEvents)
+		r.flush(ctx, ch)
+	}
+}
+
+// WaitForIdle blocks until no event is buffered for a given period, or the context is canceled.
+// The period is measured from the call at the latest.
+func (r *Replayer) WaitForIdle(ctx context.Context, period time.Duration) {
+	since := time.Now()
+	for {
+		r.mu.Lock()
+		if r.lastBuffered.After(since) {
+			since = r.lastBuffered
+		}
+		r.mu.Unlock()
+
+		wait := period - time.Since(since)
+		if wait <= 0 {
+			return
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Flush sends all buffered events in the chronological order and stops buffering.
+func (r *Replayer) Flush(ctx context.Context, ch chan<- source.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.flush(ctx, ch)
+}
+
+func (r *Replayer) flush(ctx context.Context, ch chan<- source.Event) {
+	if !r.buffering {
+		return
+	}
+
+	sort.SliceStable(r.buffer, func(i, j int) bool {
+		return r.buffer[i].TimeStamp.Before(r.buffer[j].TimeStamp)
+	})
+	r.log.Debugf("Flushing %d buffered events...", len(r.buffer))
+	for _, item := range r.buffer {
+		r.send(ctx, item, ch)
+	}
+	r.buffer = nil
+	r.buffering = false
+}
+
+// Run persists the checkpoint periodically, and once the context is canceled.
+func (r *Replayer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.save(ctx)
+		case <-ctx.Done():
+			stopCtx, cancel := context.WithTimeout(context.Background(), saveOnStopTimeout)
+			r.save(stopCtx)
+			cancel()
+			return
+		}
+	}
+}
+
+func (r *Replayer) send(ctx context.Context, item Item, ch chan<- source.Event) {
+	select {
+	case ch <- item.Event:
+	case <-ctx.Done():
+		return
+	}
+
+	switch {
+	case item.TimeStamp.After(r.current.LastSeen):
+		r.current = Checkpoint{LastSeen: item.TimeStamp, Delivered: []string{item.ID}}
+	case item.TimeStamp.Equal(r.current.LastSeen):
+		r.current.Delivered = append(r.current.Delivered, item.ID)
+	default:
+		return
+	}
+	r.dirty = true
+}
+
+func (r *Replayer) save(ctx context.Context) {
+	r.mu.Lock()
+	if !r.dirty {
+		r.mu.Unlock()
+		return
+	}
+	checkpoint := Checkpoint{
+		LastSeen:  r.current.LastSeen,
+		Delivered: append([]string(nil), r.current.Delivered...),
+	}
+	r.dirty = false
+	r.mu.Unlock()
+
+	if err := r.store.Save(ctx, checkpoint); err != nil {
+		r.log.Errorf("while persisting checkpoint: %s", err.Error())
+		r.mu.Lock()
+		r.dirty = true
+		r.mu.Unlock()
+	}
+}
@@ -0,0 +1,174 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var fixStartTime = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestReplayer_ShouldReplay(t *testing.T) {
+	// given
+	lastSeen := fixStartTime.Add(-10 * time.Minute)
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "botkube", "checkpoints", "k8s-err-events")
+	require.NoError(t, store.Save(context.Background(), Checkpoint{LastSeen: lastSeen, Delivered: []string{"delivered/1"}}))
+
+	tests := []struct {
+		name      string
+		window    time.Duration
+		id        string
+		timeStamp time.Time
+		expected  bool
+	}{
+		{name: "Emitted after checkpoint", window: time.Hour, id: "new/1", timeStamp: lastSeen.Add(time.Second), expected: true},
+		{name: "Not delivered at checkpoint time", window: time.Hour, id: "new/1", timeStamp: lastSeen, expected: true},
+		{name: "Delivered at checkpoint time", window: time.Hour, id: "delivered/1", timeStamp: lastSeen, expected: false},
+		{name: "Recurred after checkpoint", window: time.Hour, id: "delivered/1", timeStamp: lastSeen.Add(time.Minute), expected: true},
+		{name: "Emitted before checkpoint", window: time.Hour, id: "old/1", timeStamp: lastSeen.Add(-time.Second), expected: false},
+		{name: "Emitted before window", window: 5 * time.Minute, id: "new/1", timeStamp: lastSeen.Add(time.Second), expected: false},
+		{name: "Emitted within window", window: 5 * time.Minute, id: "new/1", timeStamp: fixStartTime.Add(-5 * time.Minute), expected: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := New(context.Background(), loggerx.NewNoop(), store, fixStartTime, tc.window)
+			require.NoError(t, err)
+
+			// when
+			actual := r.ShouldReplay(tc.id, tc.timeStamp)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReplayer_WithoutCheckpoint(t *testing.T) {
+	// given
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "botkube", "checkpoints", "k8s-err-events")
+
+	// when
+	r, err := New(context.Background(), loggerx.NewNoop(), store, fixStartTime, time.Hour)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, r.ShouldReplay("new/1", fixStartTime.Add(-time.Second)))
+	assert.True(t, r.ShouldReplay("new/1", fixStartTime))
+}
+
+func TestReplayer_DeliverAndPersist(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	k8sCli := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkpoints", Namespace: "botkube"},
+		Data:       map[string]string{"k8s-all-events": `{"lastSeen":"2023-03-01T11:00:00Z"}`},
+	})
+	store := NewConfigMapStore(k8sCli, "botkube", "checkpoints", "k8s-err-events")
+	require.NoError(t, store.Save(ctx, Checkpoint{LastSeen: fixStartTime.Add(-time.Hour)}))
+
+	r, err := New(ctx, loggerx.NewNoop(), store, fixStartTime, time.Hour)
+	require.NoError(t, err)
+
+	ch := make(chan source.Event, 10)
+
+	// when
+	r.Deliver(ctx, fixItem("new/1", fixStartTime.Add(time.Second)), ch)
+	r.Deliver(ctx, fixItem("replayed/2", fixStartTime.Add(-time.Minute)), ch)
+	r.Deliver(ctx, fixItem("replayed/1", fixStartTime.Add(-2*time.Minute)), ch)
+
+	// then
+	assert.Empty(t, ch, "events should be buffered until flushed")
+
+	// when
+	r.Flush(ctx, ch)
+	r.Deliver(ctx, fixItem("new/2", fixStartTime.Add(time.Second)), ch)
+
+	// then
+	close(ch)
+	var actual []interface{}
+	for event := range ch {
+		actual = append(actual, event.RawObject)
+	}
+	assert.Equal(t, []interface{}{"replayed/1", "replayed/2", "new/1", "new/2"}, actual)
+
+	// when
+	cancel()
+	r.Run(ctx, time.Minute)
+
+	// then
+	checkpoint, found, err := store.Load(context.Background())
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.True(t, checkpoint.LastSeen.Equal(fixStartTime.Add(time.Second)))
+	assert.Equal(t, []string{"new/1", "new/2"}, checkpoint.Delivered)
+
+	cm, err := k8sCli.CoreV1().ConfigMaps("botkube").Get(context.Background(), "checkpoints", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"lastSeen":"2023-03-01T11:00:00Z"}`, cm.Data["k8s-all-events"], "checkpoints of other sources should be preserved")
+}
+
+func TestReplayer_DeliverFlushesFullBuffer(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "botkube", "checkpoints", "k8s-err-events")
+	r, err := New(ctx, loggerx.NewNoop(), store, fixStartTime, time.Hour)
+	require.NoError(t, err)
+
+	ch := make(chan source.Event, maxBufferedEvents+1)
+
+	// when
+	for i := 0; i < maxBufferedEvents-1; i++ {
+		r.Deliver(ctx, fixItem(fmt.Sprintf("new/%d", i), fixStartTime.Add(time.Second)), ch)
+	}
+
+	// then
+	assert.Empty(t, ch)
+
+	// when
+	r.Deliver(ctx, fixItem("new/last", fixStartTime.Add(time.Second)), ch)
+	r.Deliver(ctx, fixItem("new/after-flush", fixStartTime.Add(time.Second)), ch)
+
+	// then
+	assert.Len(t, ch, maxBufferedEvents+1)
+}
+
+func TestReplayer_WaitForIdle(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "botkube", "checkpoints", "k8s-err-events")
+	r, err := New(ctx, loggerx.NewNoop(), store, fixStartTime, time.Hour)
+	require.NoError(t, err)
+
+	const idlePeriod = 100 * time.Millisecond
+	go func() {
+		time.Sleep(idlePeriod / 2)
+		r.Deliver(ctx, fixItem("new/1", fixStartTime), make(chan source.Event))
+	}()
+	start := time.Now()
+
+	// when
+	r.WaitForIdle(ctx, idlePeriod)
+
+	// then
+	assert.GreaterOrEqual(t, time.Since(start), idlePeriod*3/2)
+}
+
+func fixItem(id string, timeStamp time.Time) Item {
+	return Item{
+		ID:        id,
+		TimeStamp: timeStamp,
+		Event:     source.Event{RawObject: id},
+	}
+}
@@ -0,0 +1,74 @@
+package rollout
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	progressingConditionType = "Progressing"
+	progressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// isComplete returns true if all replicas were updated and are available.
+// It follows the same logic as the `kubectl rollout status` command.
+func isComplete(obj *unstructured.Unstructured) bool {
+	if statusInt(obj, "observedGeneration") < obj.GetGeneration() {
+		return false
+	}
+
+	switch obj.GetKind() {
+	case "Deployment":
+		replicas := specReplicas(obj)
+		return statusInt(obj, "updatedReplicas") == replicas &&
+			statusInt(obj, "replicas") == replicas &&
+			statusInt(obj, "availableReplicas") == replicas
+	case "StatefulSet":
+		replicas := specReplicas(obj)
+		updateRevision, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+		currentRevision, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+		return statusInt(obj, "readyReplicas") == replicas &&
+			(updateRevision == currentRevision || statusInt(obj, "updatedReplicas") == replicas)
+	case "DaemonSet":
+		desired := statusInt(obj, "desiredNumberScheduled")
+		return statusInt(obj, "updatedNumberScheduled") == desired &&
+			statusInt(obj, "numberAvailable") == desired
+	}
+
+	return false
+}
+
+// isStuck returns true with the condition message if the Deployment exceeded its progress deadline.
+func isStuck(obj *unstructured.Unstructured) (string, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		condType, _, _ := unstructured.NestedString(cond, "type")
+		reason, _, _ := unstructured.NestedString(cond, "reason")
+		if condType != progressingConditionType || reason != progressDeadlineExceeded {
+			continue
+		}
+		msg, _, _ := unstructured.NestedString(cond, "message")
+		if msg == "" {
+			msg = progressDeadlineExceeded
+		}
+		return msg, true
+	}
+	return "", false
+}
+
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	replicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if err != nil || !found {
+		// defaulted by API server
+		return 1
+	}
+	return replicas
+}
+
+func statusInt(obj *unstructured.Unstructured, field string) int64 {
+	val, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+	return val
+}
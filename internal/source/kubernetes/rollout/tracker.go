@@ -0,0 +1,223 @@
+package rollout
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+)
+
+// maxTemplateHistory is the number of pod template revisions remembered per object to detect rollbacks.
+const maxTemplateHistory = 10
+
+// Result describes a detected rollout transition.
+type Result struct {
+	Type     config.EventType
+	Messages []string
+}
+
+// Tracker derives high-level rollout events from Deployment, StatefulSet and DaemonSet status transitions.
+// It remembers the pod template history and rollout start time per object, so it must be used for a single informer.
+type Tracker struct {
+	mu     sync.Mutex
+	now    func() time.Time
+	states map[types.UID]*objectState
+}
+
+type objectState struct {
+	templates []string
+	startedAt time.Time
+	oldImages map[string]string
+	newImages map[string]string
+	stuck     bool
+}
+
+func (s *objectState) inProgress() bool {
+	return !s.startedAt.IsZero()
+}
+
+// NewTracker returns a new Tracker instance.
+func NewTracker() *Tracker {
+	return &Tracker{
+		now:    time.Now,
+		states: make(map[types.UID]*objectState),
+	}
+}
+
+// Detect returns the rollout event for a given object update, if any.
+func (t *Tracker) Detect(oldObj, newObj *unstructured.Unstructured) (Result, bool) {
+	if oldObj == nil || newObj == nil || !IsSupportedKind(newObj.GetKind()) {
+		return Result{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.states[newObj.GetUID()]
+	if !ok {
+		state = &objectState{}
+		t.states[newObj.GetUID()] = state
+	}
+
+	oldTpl, newTpl := templateFingerprint(oldObj), templateFingerprint(newObj)
+	if oldTpl != newTpl {
+		return t.startRollout(state, oldObj, newObj, oldTpl, newTpl), true
+	}
+
+	if !state.inProgress() {
+		return Result{}, false
+	}
+
+	if reason, stuck := isStuck(newObj); stuck && !state.stuck {
+		state.stuck = true
+		return Result{
+			Type: config.RolloutStuckEvent,
+			Messages: append(imageChangeMessages(state.oldImages, state.newImages),
+				fmt.Sprintf("Rollout is stuck after %s: %s", t.elapsed(state), reason),
+			),
+		}, true
+	}
+
+	if isComplete(newObj) {
+		res := Result{
+			Type: config.RolloutSucceededEvent,
+			Messages: append(imageChangeMessages(state.oldImages, state.newImages),
+				fmt.Sprintf("Rollout took %s", t.elapsed(state)),
+			),
+		}
+		state.startedAt = time.Time{}
+		state.stuck = false
+		return res, true
+	}
+
+	return Result{}, false
+}
+
+// Forget removes all data stored for a given object.
+func (t *Tracker) Forget(obj *unstructured.Unstructured) {
+	if obj == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.states, obj.GetUID())
+}
+
+func (t *Tracker) startRollout(state *objectState, oldObj, newObj *unstructured.Unstructured, oldTpl, newTpl string) Result {
+	if len(state.templates) == 0 {
+		state.templates = append(state.templates, oldTpl)
+	}
+
+	eventType := config.RolloutStartedEvent
+	if indexOf(state.templates, newTpl) >= 0 {
+		// pod template was already observed before, so it's a rollback to one of the previous revisions
+		eventType = config.RolloutRolledBackEvent
+	}
+
+	state.templates = appendTemplate(state.templates, newTpl)
+	state.startedAt = t.now()
+	state.stuck = false
+	state.oldImages = containerImages(oldObj)
+	state.newImages = containerImages(newObj)
+
+	return Result{
+		Type:     eventType,
+		Messages: imageChangeMessages(state.oldImages, state.newImages),
+	}
+}
+
+func (t *Tracker) elapsed(state *objectState) time.Duration {
+	return t.now().Sub(state.startedAt).Round(time.Second)
+}
+
+// IsSupportedKind returns true if rollout events can be derived for a given object kind.
+func IsSupportedKind(kind string) bool {
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet":
+		return true
+	}
+	return false
+}
+
+func appendTemplate(templates []string, tpl string) []string {
+	if idx := indexOf(templates, tpl); idx >= 0 {
+		templates = append(templates[:idx], templates[idx+1:]...)
+	}
+	templates = append(templates, tpl)
+	if len(templates) > maxTemplateHistory {
+		templates = templates[len(templates)-maxTemplateHistory:]
+	}
+	return templates
+}
+
+func indexOf(items []string, item string) int {
+	for i, it := range items {
+		if it == item {
+			return i
+		}
+	}
+	return -1
+}
+
+func templateFingerprint(obj *unstructured.Unstructured) string {
+	tpl, found, err := unstructured.NestedMap(obj.Object, "spec", "template")
+	if err != nil || !found {
+		return ""
+	}
+
+	// template hash label is set by the controller and doesn't describe the desired state
+	if labels, ok, _ := unstructured.NestedMap(tpl, "metadata", "labels"); ok {
+		delete(labels, "pod-template-hash")
+		_ = unstructured.SetNestedMap(tpl, labels, "metadata", "labels")
+	}
+
+	raw, err := json.Marshal(tpl)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func containerImages(obj *unstructured.Unstructured) map[string]string {
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	out := make(map[string]string, len(containers))
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(container, "name")
+		image, _, _ := unstructured.NestedString(container, "image")
+		out[name] = image
+	}
+	return out
+}
+
+func imageChangeMessages(oldImages, newImages map[string]string) []string {
+	names := make([]string, 0, len(newImages))
+	for name := range newImages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var out []string
+	for _, name := range names {
+		oldImage, newImage := oldImages[name], newImages[name]
+		switch {
+		case oldImage == "":
+			out = append(out, fmt.Sprintf("Container %q added with image %q", name, newImage))
+		case oldImage != newImage:
+			out = append(out, fmt.Sprintf("Container %q image changed from %q to %q", name, oldImage, newImage))
+		}
+	}
+	return out
+}
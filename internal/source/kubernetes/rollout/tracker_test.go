@@ -0,0 +1,187 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+)
+
+func TestTracker_Detect_DeploymentLifecycle(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	v1Complete := fixDeployment(t, 1, "nginx:1.21", func(d *appsv1.Deployment) {
+		d.Status = completeStatus(1, 3)
+	})
+	v2Started := fixDeployment(t, 2, "nginx:1.23", func(d *appsv1.Deployment) {
+		d.Status = completeStatus(1, 3)
+	})
+	v2Progressing := fixDeployment(t, 2, "nginx:1.23", func(d *appsv1.Deployment) {
+		d.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3}
+	})
+	v2Stuck := fixDeployment(t, 2, "nginx:1.23", func(d *appsv1.Deployment) {
+		d.Status = appsv1.DeploymentStatus{
+			ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:    appsv1.DeploymentProgressing,
+					Status:  corev1.ConditionFalse,
+					Reason:  "ProgressDeadlineExceeded",
+					Message: `ReplicaSet "app-7c9f" has timed out progressing.`,
+				},
+			},
+		}
+	})
+	v2Complete := fixDeployment(t, 2, "nginx:1.23", func(d *appsv1.Deployment) {
+		d.Status = completeStatus(2, 3)
+	})
+	v3RolledBack := fixDeployment(t, 3, "nginx:1.21", func(d *appsv1.Deployment) {
+		d.Status = completeStatus(2, 3)
+	})
+
+	// when
+	_, detected := tracker.Detect(v1Complete, v1Complete)
+	// then
+	assert.False(t, detected)
+
+	// when
+	res, detected := tracker.Detect(v1Complete, v2Started)
+	// then
+	require.True(t, detected)
+	assert.Equal(t, Result{
+		Type:     config.RolloutStartedEvent,
+		Messages: []string{`Container "app" image changed from "nginx:1.21" to "nginx:1.23"`},
+	}, res)
+
+	// when
+	now = now.Add(time.Minute)
+	_, detected = tracker.Detect(v2Started, v2Progressing)
+	// then
+	assert.False(t, detected)
+
+	// when
+	now = now.Add(9 * time.Minute)
+	res, detected = tracker.Detect(v2Progressing, v2Stuck)
+	// then
+	require.True(t, detected)
+	assert.Equal(t, Result{
+		Type: config.RolloutStuckEvent,
+		Messages: []string{
+			`Container "app" image changed from "nginx:1.21" to "nginx:1.23"`,
+			`Rollout is stuck after 10m0s: ReplicaSet "app-7c9f" has timed out progressing.`,
+		},
+	}, res)
+
+	// when
+	_, detected = tracker.Detect(v2Stuck, v2Stuck)
+	// then
+	assert.False(t, detected, "stuck rollout should be reported only once")
+
+	// when
+	now = now.Add(2 * time.Minute)
+	res, detected = tracker.Detect(v2Stuck, v2Complete)
+	// then
+	require.True(t, detected)
+	assert.Equal(t, Result{
+		Type: config.RolloutSucceededEvent,
+		Messages: []string{
+			`Container "app" image changed from "nginx:1.21" to "nginx:1.23"`,
+			"Rollout took 12m0s",
+		},
+	}, res)
+
+	// when
+	_, detected = tracker.Detect(v2Complete, v2Complete)
+	// then
+	assert.False(t, detected, "succeeded rollout should be reported only once")
+
+	// when
+	res, detected = tracker.Detect(v2Complete, v3RolledBack)
+	// then
+	require.True(t, detected)
+	assert.Equal(t, Result{
+		Type:     config.RolloutRolledBackEvent,
+		Messages: []string{`Container "app" image changed from "nginx:1.23" to "nginx:1.21"`},
+	}, res)
+}
+
+func TestTracker_Detect_IgnoresScaling(t *testing.T) {
+	// given
+	tracker := NewTracker()
+	scaledDown := fixDeployment(t, 1, "nginx:1.21", func(d *appsv1.Deployment) {
+		d.Status = appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	})
+	scaledUp := fixDeployment(t, 2, "nginx:1.21", func(d *appsv1.Deployment) {
+		d.Status = completeStatus(2, 3)
+	})
+
+	// when
+	_, detected := tracker.Detect(scaledDown, scaledUp)
+
+	// then
+	assert.False(t, detected)
+}
+
+func TestTracker_Detect_UnsupportedKind(t *testing.T) {
+	// given
+	tracker := NewTracker()
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Pod",
+		"spec": map[string]interface{}{"containers": []interface{}{}},
+	}}
+
+	// when
+	_, detected := tracker.Detect(pod, pod)
+
+	// then
+	assert.False(t, detected)
+}
+
+func completeStatus(observedGeneration int64, replicas int32) appsv1.DeploymentStatus {
+	return appsv1.DeploymentStatus{
+		ObservedGeneration: observedGeneration,
+		Replicas:           replicas,
+		UpdatedReplicas:    replicas,
+		AvailableReplicas:  replicas,
+		ReadyReplicas:      replicas,
+	}
+}
+
+func fixDeployment(t *testing.T, generation int64, image string, mutateFn func(d *appsv1.Deployment)) *unstructured.Unstructured {
+	t.Helper()
+
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "app",
+			Namespace:  "default",
+			UID:        "3f8e5d2c",
+			Generation: generation,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: image}},
+				},
+			},
+		},
+	}
+	mutateFn(deploy)
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
@@ -6,17 +6,17 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
 )
 
 const eventsResource = "v1/events"
 
 type mergedEvents map[string]map[config.EventType]struct{}
-type registrationHandler func(resource string) (cache.SharedIndexInformer, error)
+type registrationHandler func(resource string, opts informerOptions) (informerGroup, error)
 type eventHandler func(ctx context.Context, source Source, event event.Event, updateDiffs []string)
 
 type route struct {
@@ -26,6 +26,9 @@ type route struct {
 	namespaces    *config.RegexConstraints
 	updateSetting *config.UpdateSetting
 	event         *config.KubernetesEvent
+	owner         config.OwnerConstraints
+	conditions    []config.ResourceCondition
+	metadataOnly  bool
 }
 
 func (r route) hasActionableUpdateSetting() bool {
@@ -45,6 +48,10 @@ type Router struct {
 	dynamicCli    dynamic.Interface
 	table         map[string][]entry
 	registrations map[string]registration
+	resolveOwners bool
+	ownerResolver *k8sutil.OwnerResolver
+	// fullObjectResources contains resources which require whole objects to be cached, e.g. to run recommendations.
+	fullObjectResources map[string]struct{}
 }
 
 // NewRouter creates a new router to use for routing event types to registered informers.
@@ -55,6 +62,7 @@ func NewRouter(mapper meta.RESTMapper, dynamicCli dynamic.Interface, log logrus.
 		dynamicCli:    dynamicCli,
 		table:         make(map[string][]entry),
 		registrations: make(map[string]registration),
+		ownerResolver: k8sutil.NewOwnerResolver(dynamicCli, mapper),
 	}
 }
 
@@ -62,6 +70,14 @@ func NewRouter(mapper meta.RESTMapper, dynamicCli dynamic.Interface, log logrus.
 // to register, map and handle informer events.
 func (r *Router) BuildTable(cfg *config.Config) *Router {
 	mergedEvents := mergeResourceEvents(cfg)
+	r.resolveOwners = shouldResolveOwners(cfg)
+	r.fullObjectResources = make(map[string]struct{})
+	for resource := range recommendation.ResourceEventsForConfig(cfg.Recommendations) {
+		r.fullObjectResources[resource] = struct{}{}
+	}
+	for resource := range templateResources(cfg.Templates, mergedEvents) {
+		r.fullObjectResources[resource] = struct{}{}
+	}
 
 	for resource, resourceEvents := range mergedEvents {
 		eventRoutes := r.mergeEventRoutes(resource, cfg)
@@ -77,16 +93,22 @@ func (r *Router) BuildTable(cfg *config.Config) *Router {
 func (r *Router) RegisterInformers(targetEvents []config.EventType, handler registrationHandler) error {
 	resources := r.resourcesForEvents(targetEvents)
 	for _, resource := range resources {
-		informer, err := handler(resource)
+		events := r.resourceEvents(resource)
+		_, fullObjectRequired := r.fullObjectResources[resource]
+		opts := informerOptionsForRoutes(r.resourceRoutes(resource), events, fullObjectRequired)
+
+		informer, err := handler(resource, opts)
 		if err != nil {
 			return err
 		}
 		r.registrations[resource] = registration{
-			informer:   informer,
-			events:     r.resourceEvents(resource),
-			log:        r.log,
-			mapper:     r.mapper,
-			dynamicCli: r.dynamicCli,
+			informer:      informer,
+			events:        events,
+			log:           r.log,
+			mapper:        r.mapper,
+			dynamicCli:    r.dynamicCli,
+			resolveOwners: r.resolveOwners,
+			ownerResolver: r.ownerResolver,
 		}
 	}
 	return nil
@@ -105,7 +127,9 @@ func (r *Router) MapWithEventsInformer(srcEvent config.EventType, dstEvent confi
 		return nil
 	}
 
-	informer, err := handler(eventsResource)
+	informer, err := handler(eventsResource, informerOptions{
+		FieldSelector: fieldSelectorForEventType(dstEvent),
+	})
 	if err != nil {
 		return err
 	}
@@ -117,6 +141,8 @@ func (r *Router) MapWithEventsInformer(srcEvent config.EventType, dstEvent confi
 		log:             r.log,
 		mapper:          r.mapper,
 		dynamicCli:      r.dynamicCli,
+		resolveOwners:   r.resolveOwners,
+		ownerResolver:   r.ownerResolver,
 	}
 	return nil
 }
@@ -133,6 +159,29 @@ func (r *Router) RegisterEventHandler(ctx context.Context, s Source, eventType c
 	}
 }
 
+// RegisterRolloutEventHandler allows router clients to create handlers that are
+// triggered for rollout events derived from status transitions of the watched resources.
+func (r *Router) RegisterRolloutEventHandler(ctx context.Context, s Source, handlerFn eventHandler) {
+	for resource, reg := range r.registrations {
+		if !reg.canHandleAnyEvent(config.RolloutEventTypes) {
+			continue
+		}
+		reg.handleRolloutEvents(ctx, s, resource, r.table, handlerFn)
+	}
+}
+
+// RegisterConditionEventHandler allows router clients to create handlers that are
+// triggered for status condition transitions of the watched resources.
+func (r *Router) RegisterConditionEventHandler(ctx context.Context, s Source, handlerFn eventHandler) {
+	for resource, reg := range r.registrations {
+		if !reg.canHandleEvent(config.ConditionChangedEvent.String()) {
+			continue
+		}
+		routes := r.getSourceRoutes(resource, config.ConditionChangedEvent)
+		reg.handleConditionEvents(ctx, s, resource, routes, handlerFn)
+	}
+}
+
 // HandleMappedEvent allows router clients to create handlers that are
 // triggered for a target mapped event.
 func (r *Router) HandleMappedEvent(ctx context.Context, s Source, targetEvent config.EventType, handlerFn eventHandler) {
@@ -167,6 +216,37 @@ func mergeResourceEvents(cfg *config.Config) mergedEvents {
 	return out
 }
 
+// shouldResolveOwners returns true if the owner reference chain is needed to either roll up events
+// or to match resource owner constraints.
+func shouldResolveOwners(cfg *config.Config) bool {
+	if cfg.OwnerRollup != nil && cfg.OwnerRollup.Enabled {
+		return true
+	}
+	for _, r := range cfg.Resources {
+		if r.Owner.AreConstraintsDefined() {
+			return true
+		}
+	}
+	return false
+}
+
+// templateResources returns resources rendered with message templates, as templates can use the whole object.
+func templateResources(templates []config.MessageTemplate, events mergedEvents) map[string]struct{} {
+	out := map[string]struct{}{}
+	for _, tpl := range templates {
+		if len(tpl.Trigger.Resources) == 0 {
+			for resource := range events {
+				out[resource] = struct{}{}
+			}
+			continue
+		}
+		for _, resource := range tpl.Trigger.Resources {
+			out[resource] = struct{}{}
+		}
+	}
+	return out
+}
+
 func (r *Router) mergeEventRoutes(resource string, cfg *config.Config) map[config.EventType][]route {
 	out := make(map[config.EventType][]route)
 	for _, r := range cfg.Resources {
@@ -181,6 +261,8 @@ func (r *Router) mergeEventRoutes(resource string, cfg *config.Config) map[confi
 				labels:       resourceStringMap(cfg.Labels, r.Labels),
 				resourceName: r.Name,
 				event:        resourceEvent(*cfg.Event, r.Event),
+				owner:        r.Owner,
+				metadataOnly: r.MetadataOnly,
 			}
 			if e == config.UpdateEvent {
 				route.updateSetting = &config.UpdateSetting{
@@ -188,6 +270,12 @@ func (r *Router) mergeEventRoutes(resource string, cfg *config.Config) map[confi
 					IncludeDiff: r.UpdateSetting.IncludeDiff,
 				}
 			}
+			if e == config.ConditionChangedEvent {
+				route.conditions = r.Conditions
+				if len(route.conditions) == 0 {
+					route.conditions = config.DefaultResourceConditions
+				}
+			}
 			out[e] = append(out[e], route)
 		}
 	}
@@ -251,6 +339,15 @@ func (r *Router) resourceEvents(resource string) []config.EventType {
 	return out
 }
 
+// resourceRoutes returns all routes for a given resource, regardless of the event type.
+func (r *Router) resourceRoutes(resource string) []route {
+	var out []route
+	for _, routedEvent := range r.table[resource] {
+		out = append(out, routedEvent.routes...)
+	}
+	return out
+}
+
 func (r *Router) resourcesForEvents(targets []config.EventType) []string {
 	var out []string
 	for _, target := range targets {
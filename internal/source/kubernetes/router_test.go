@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
@@ -61,3 +62,92 @@ func TestRouter_BuildTable_CreatesRoutesWithProperEventsList(t *testing.T) {
 		})
 	}
 }
+
+func TestRouter_BuildTable_SetsConditionsForConditionChangedRoutes(t *testing.T) {
+	// given
+	const resource = "cert-manager.io/v1/certificates"
+	cfg := config.Config{
+		Event: &config.KubernetesEvent{},
+		Resources: []config.Resource{
+			{
+				Type: resource,
+				Event: config.KubernetesEvent{
+					Types: []config.EventType{config.ConditionChangedEvent},
+				},
+			},
+			{
+				Type: resource,
+				Namespaces: config.RegexConstraints{
+					Include: []string{"prod"},
+				},
+				Event: config.KubernetesEvent{
+					Types: []config.EventType{config.UpdateEvent, config.ConditionChangedEvent},
+				},
+				Conditions: []config.ResourceCondition{
+					{Type: "Issuing", HealthyStatus: "False"},
+				},
+			},
+		},
+	}
+
+	// when
+	router := NewRouter(nil, nil, loggerx.NewNoop()).BuildTable(&cfg)
+
+	// then
+	routes := router.getSourceRoutes(resource, config.ConditionChangedEvent)
+	assert.Len(t, routes, 2)
+	assert.Equal(t, []string{"Ready", "Issuing"}, conditionTypesForRoutes(routes))
+
+	issuingRoutes, cond := routesForCondition(routes, "Issuing")
+	assert.Len(t, issuingRoutes, 1)
+	assert.Equal(t, config.Success, levelForCondition(cond, "False"))
+	assert.Equal(t, config.Error, levelForCondition(cond, "True"))
+
+	readyRoutes, cond := routesForCondition(routes, "Ready")
+	assert.Len(t, readyRoutes, 1)
+	assert.Equal(t, config.Error, levelForCondition(cond, "False"))
+	assert.Equal(t, config.Info, levelForCondition(cond, "Unknown"))
+
+	for _, rt := range router.getSourceRoutes(resource, config.UpdateEvent) {
+		assert.Empty(t, rt.conditions)
+	}
+}
+
+func TestRouter_RegisterInformers_KeepsFullObjectsForTemplates(t *testing.T) {
+	// given
+	const (
+		templated = "apps/v1/deployments"
+		plain     = "v1/configmaps"
+	)
+	cfg := config.Config{
+		Event: &config.KubernetesEvent{},
+		Resources: []config.Resource{
+			{
+				Type:         templated,
+				Event:        config.KubernetesEvent{Types: []config.EventType{config.CreateEvent, config.DeleteEvent}},
+				MetadataOnly: true,
+			},
+			{
+				Type:         plain,
+				Event:        config.KubernetesEvent{Types: []config.EventType{config.CreateEvent, config.DeleteEvent}},
+				MetadataOnly: true,
+			},
+		},
+		Templates: []config.MessageTemplate{
+			{Trigger: config.MessageTemplateTrigger{Resources: []string{templated}}},
+		},
+	}
+	router := NewRouter(nil, nil, loggerx.NewNoop()).BuildTable(&cfg)
+
+	// when
+	opts := map[string]informerOptions{}
+	err := router.RegisterInformers([]config.EventType{config.CreateEvent, config.DeleteEvent}, func(resource string, o informerOptions) (informerGroup, error) {
+		opts[resource] = o
+		return nil, nil
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, opts[templated].MetadataOnly, "templates can read the object spec and status")
+	assert.True(t, opts[plain].MetadataOnly)
+}
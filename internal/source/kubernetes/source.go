@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -11,8 +13,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic/dynamicinformer"
-	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/command"
 	"github.com/kubeshop/botkube/internal/loggerx"
@@ -20,7 +20,10 @@ import (
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/msgtemplate"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/replay"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	pkgConfig "github.com/kubeshop/botkube/pkg/config"
@@ -33,8 +36,16 @@ const (
 	description = "Consume Kubernetes events and get notifications with additional warnings and recommendations."
 
 	componentLogFieldKey = "component"
+
+	// replayIdlePeriod is the time without new replayed events after which the core Events listed by informers on startup
+	// are considered processed, as handlers are notified asynchronously after the informer cache is synced.
+	replayIdlePeriod = time.Second
+	// replayMaxWait limits how long the replayed events are buffered, even if the informer caches aren't synced.
+	replayMaxWait = time.Minute
 )
 
+var invalidConfigMapKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
 type RecommendationFactory interface {
 	New(cfg config.Config) (recommendation.AggregatedRunner, config.Recommendations)
 }
@@ -52,7 +63,9 @@ type Source struct {
 	clusterName              string
 	kubeConfig               []byte
 	messageBuilder           *MessageBuilder
+	messageRenderer          *msgtemplate.Renderer
 	isInteractivitySupported bool
+	replayer                 *replay.Replayer
 }
 
 // NewSource returns a new instance of Source.
@@ -68,6 +81,16 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
+	renderer, err := msgtemplate.NewRenderer(cfg.Templates)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while loading message templates: %w", err)
+	}
+	if err := commander.ValidateResourceCommands(cfg.Resources); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating resource commands: %w", err)
+	}
+	if cfg.Replay != nil {
+		cfg.Replay.Checkpoint.Key = checkpointKey(cfg.Replay.Checkpoint.Key, input)
+	}
 	s := Source{
 		startTime: time.Now(),
 		eventCh:   make(chan source.Event),
@@ -78,6 +101,7 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 		clusterName:              input.Context.ClusterName,
 		kubeConfig:               input.Context.KubeConfig,
 		isInteractivitySupported: input.Context.IsInteractivitySupported,
+		messageRenderer:          renderer,
 	}
 
 	go consumeEvents(ctx, s)
@@ -99,48 +123,59 @@ func consumeEvents(ctx context.Context, s Source) {
 	client, err := NewClient(s.kubeConfig)
 	exitOnError(err, s.logger)
 
-	dynamicKubeInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(client.dynamicCli, s.config.InformerResyncPeriod)
+	informers := newInformerFactory(s.logger.WithField(componentLogFieldKey, "Informer Factory"), client.dynamicCli, client.metadataCli, client.mapper, s.config.InformerResyncPeriod)
 	router := NewRouter(client.mapper, client.dynamicCli, s.logger)
 	router.BuildTable(&s.config)
 	s.recommFactory = recommendation.NewFactory(s.logger.WithField("component", "Recommendations"), client.dynamicCli)
 	s.commandGuard = command.NewCommandGuard(s.logger.WithField(componentLogFieldKey, "Command Guard"), client.discoveryCli)
-	cmdr := commander.NewCommander(s.logger.WithField(componentLogFieldKey, "Commander"), s.commandGuard, s.config.Commands)
-	s.messageBuilder = NewMessageBuilder(s.isInteractivitySupported, s.logger.WithField(componentLogFieldKey, "Message Builder"), cmdr)
+	cmdr, err := commander.NewCommander(s.logger.WithField(componentLogFieldKey, "Commander"), s.commandGuard, s.config.Commands, s.config.Resources)
+	exitOnError(err, s.logger)
+	s.messageBuilder = NewMessageBuilder(s.isInteractivitySupported, s.logger.WithField(componentLogFieldKey, "Message Builder"), cmdr, s.messageRenderer)
 	s.filterEngine = filterengine.WithAllFilters(s.logger, client.dynamicCli, client.mapper, s.config.Filters)
 
-	err = router.RegisterInformers([]config.EventType{
+	if s.config.Replay != nil && s.config.Replay.Enabled {
+		checkpoint := s.config.Replay.Checkpoint
+		store := replay.NewConfigMapStore(client.k8sCli, checkpoint.Namespace, checkpoint.Name, checkpoint.Key)
+		replayer, err := replay.New(ctx, s.logger.WithField(componentLogFieldKey, "Replayer"), store, s.startTime, s.config.Replay.Window)
+		if err != nil {
+			s.logger.Errorf("while creating event replayer, only new events are delivered: %s", err.Error())
+		} else {
+			s.replayer = replayer
+			go s.replayer.Run(ctx, checkpoint.Interval)
+		}
+	}
+
+	informerEvents := append([]config.EventType{
 		config.CreateEvent,
 		config.UpdateEvent,
 		config.DeleteEvent,
-	}, func(resource string) (cache.SharedIndexInformer, error) {
+	}, config.RolloutEventTypes...)
+	informerEvents = append(informerEvents, config.ConditionChangedEvent)
+	err = router.RegisterInformers(informerEvents, func(resource string, opts informerOptions) (informerGroup, error) {
 		gvr, err := parseResourceArg(resource, client.mapper)
 		if err != nil {
 			s.logger.Infof("Unable to parse resource: %s to register with informer\n", resource)
 			return nil, err
 		}
-		return dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
+		return informers.ForResource(gvr, opts)
 	})
 	if err != nil {
 		exitOnError(err, s.logger.WithFields(logrus.Fields{
-			"events": []config.EventType{
-				config.CreateEvent,
-				config.UpdateEvent,
-				config.DeleteEvent,
-			},
-			"error": err.Error(),
+			"events": informerEvents,
+			"error":  err.Error(),
 		}))
 	}
 
 	err = router.MapWithEventsInformer(
 		config.ErrorEvent,
 		config.WarningEvent,
-		func(resource string) (cache.SharedIndexInformer, error) {
+		func(resource string, opts informerOptions) (informerGroup, error) {
 			gvr, err := parseResourceArg(resource, client.mapper)
 			if err != nil {
 				s.logger.Infof("Unable to parse resource: %s to register with informer\n", resource)
 				return nil, err
 			}
-			return dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
+			return informers.ForResource(gvr, opts)
 		})
 	if err != nil {
 		exitOnError(err, s.logger.WithFields(logrus.Fields{
@@ -164,6 +199,9 @@ func consumeEvents(ctx context.Context, s Source) {
 		)
 	}
 
+	router.RegisterRolloutEventHandler(ctx, s, handleEvent)
+	router.RegisterConditionEventHandler(ctx, s, handleEvent)
+
 	router.HandleMappedEvent(
 		ctx,
 		s,
@@ -172,17 +210,46 @@ func consumeEvents(ctx context.Context, s Source) {
 	)
 
 	stopCh := ctx.Done()
-	dynamicKubeInformerFactory.Start(stopCh)
+	informers.Start(stopCh)
+
+	if s.replayer != nil {
+		flushReplayedEvents(ctx, s, informers)
+	}
+}
+
+// flushReplayedEvents delivers the buffered events once the core Event informers are synced and their listed events are processed.
+// Only core Events are replayed, so other informers aren't waited for. The events are flushed after replayMaxWait at the latest.
+func flushReplayedEvents(ctx context.Context, s Source, informers *informerFactory) {
+	waitCtx, cancel := context.WithTimeout(ctx, replayMaxWait)
+	defer cancel()
+
+	eventsGVR, _ := strToGVR(eventsResource)
+	if informers.WaitForResourceSync(waitCtx.Done(), eventsGVR) {
+		s.replayer.WaitForIdle(waitCtx, replayIdlePeriod)
+	} else if ctx.Err() == nil {
+		s.logger.Warnf("Event informers not synced within %s. Flushing replayed events...", replayMaxWait)
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+	s.replayer.Flush(ctx, s.eventCh)
 }
 
 func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []string) {
 	s.logger.Debugf("Processing %s to %s/%v in %s namespace", e.Type, e.Resource, e.Name, e.Namespace)
 	enrichEventWithAdditionalMetadata(s, &e)
 
+	// Only core/v1 Events can be replayed, as other events are derived from the current object state
+	replayable := s.replayer != nil && isCoreEvent(e)
+	replayItem := replay.Item{ID: coreEventID(e), TimeStamp: e.TimeStamp}
+
 	// Skip older events
 	if !e.TimeStamp.IsZero() && e.TimeStamp.Before(s.startTime) {
-		s.logger.Debug("Skipping older events")
-		return
+		if !replayable || !s.replayer.ShouldReplay(replayItem.ID, replayItem.TimeStamp) {
+			s.logger.Debug("Skipping older events")
+			return
+		}
 	}
 
 	// Check for significant Update Events in objects
@@ -214,6 +281,11 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 		return
 	}
 
+	// Report event against the top-level workload
+	if s.config.OwnerRollup != nil && s.config.OwnerRollup.Enabled {
+		e.RollUpToTopLevelOwner()
+	}
+
 	msg, err := s.messageBuilder.FromEvent(e)
 	if err != nil {
 		s.logger.Errorf("while rendering message from event: %w", err)
@@ -225,6 +297,11 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 		RawObject:       e,
 		AnalyticsLabels: event.AnonymizedEventDetailsFrom(e),
 	}
+	if replayable {
+		replayItem.Event = message
+		s.replayer.Deliver(ctx, replayItem, s.eventCh)
+		return
+	}
 	s.eventCh <- message
 }
 
@@ -232,6 +309,45 @@ func enrichEventWithAdditionalMetadata(s Source, event *event.Event) {
 	event.Cluster = s.clusterName
 }
 
+// isCoreEvent returns true if the event was created for the core/v1 Event object.
+func isCoreEvent(e event.Event) bool {
+	return k8sutil.GetObjectTypeMetaData(e.Object).Kind == "Event"
+}
+
+// coreEventID identifies the occurrence of the core/v1 Event, as the same Event object is updated when it recurs.
+func coreEventID(e event.Event) string {
+	id := string(e.ObjectMeta.UID)
+	if id == "" {
+		id = fmt.Sprintf("%s/%s", e.ObjectMeta.Namespace, e.ObjectMeta.Name)
+	}
+	return fmt.Sprintf("%s/%d", id, e.Count)
+}
+
+// checkpointKey returns the ConfigMap data key of the checkpoint for a given stream.
+// The key is based on the configured one or, if empty, on the source name, so it doesn't change when the source configuration is edited.
+// The same source is streamed separately for interactive and non-interactive platforms, so the key is suffixed with the interactivity flag.
+// If the source name is unknown, e.g. the plugin is run by an older Botkube version, the key is derived from the source configuration.
+func checkpointKey(key string, input source.StreamInput) string {
+	if key == "" {
+		key = invalidConfigMapKeyChars.ReplaceAllString(input.Context.SourceName, "_")
+	}
+	if key == "" {
+		h := fnv.New32a()
+		for _, cfg := range input.Configs {
+			if cfg == nil {
+				continue
+			}
+			_, _ = h.Write(cfg.RawYAML)
+		}
+		key = fmt.Sprintf("source-%08x", h.Sum32())
+	}
+
+	if input.Context.IsInteractivitySupported {
+		return key + ".interactive"
+	}
+	return key + ".non-interactive"
+}
+
 func parseResourceArg(arg string, mapper meta.RESTMapper) (schema.GroupVersionResource, error) {
 	gvr, err := strToGVR(arg)
 	if err != nil {
@@ -542,6 +658,30 @@ func jsonSchema() api.JSONSchema {
 					  "type": "boolean",
 					  "description": "If true, notifies about Pod resources created without labels.",
 					  "default": true
+					},
+					"resourcesSet": {
+					  "title": "Resources set",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod containers without CPU and memory requests or limits.",
+					  "default": false
+					},
+					"probesSet": {
+					  "title": "Probes set",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod containers without liveness or readiness probes.",
+					  "default": false
+					},
+					"noPrivilegedContainers": {
+					  "title": "No privileged containers",
+					  "type": "boolean",
+					  "description": "If true, notifies about privileged Pod containers and containers running as root.",
+					  "default": false
+					},
+					"noHostPathVolumes": {
+					  "title": "No hostPath volumes",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pods which mount hostPath volumes.",
+					  "default": false
 					}
 				  }
 				},
@@ -564,6 +704,34 @@ func jsonSchema() api.JSONSchema {
 					  "default": true
 					}
 				  }
+				},
+				"deployment": {
+				  "title": "Deployment Recommendations",
+				  "description": "Recommendations for Deployment Kubernetes resource.",
+				  "type": "object",
+				  "additionalProperties": false,
+				  "properties": {
+					"podDisruptionBudgetSet": {
+					  "title": "PodDisruptionBudget set",
+					  "type": "boolean",
+					  "description": "If true, notifies about Deployments with more than one replica without matching PodDisruptionBudget.",
+					  "default": false
+					}
+				  }
+				},
+				"service": {
+				  "title": "Service Recommendations",
+				  "description": "Recommendations for Service Kubernetes resource.",
+				  "type": "object",
+				  "additionalProperties": false,
+				  "properties": {
+					"selectorMatchesPods": {
+					  "title": "Selector matches Pods",
+					  "type": "boolean",
+					  "description": "If true, notifies about Services which selector doesn't match any Pod.",
+					  "default": false
+					}
+				  }
 				}
 			  },
 			  "additionalProperties": false
@@ -655,6 +823,37 @@ func jsonSchema() api.JSONSchema {
 					"title": "Type",
 					"description": "Kubernetes resource type in the format \"{group}/{version}/{kind (plural)}\" format, such as \"apps/v1/deployments\", or \"v1/pods\"."
 				  },
+				  "commands": {
+					"title": "Commands",
+					"description": "Suggested commands displayed in a dropdown under the event message. Commands are Go templates rendered with the event data, such as '{{ .Name }}', '{{ .Namespace }}' or '{{ .Labels }}'.",
+					"type": "array",
+					"items": {
+					  "type": "object",
+					  "required": [
+						"displayName",
+						"command"
+					  ],
+					  "properties": {
+						"displayName": {
+						  "type": "string",
+						  "title": "Display name"
+						},
+						"command": {
+						  "type": "string",
+						  "title": "Command",
+						  "description": "Command template, such as 'kubectl rollout restart deploy/{{ .Name }} -n {{ .Namespace }}'. Commands for kubectl are displayed only if they are allowed by the executor RBAC."
+						},
+						"events": {
+						  "type": "array",
+						  "title": "Events",
+						  "description": "Event types for which the command is displayed. If not specified, the command is displayed for all event types except delete.",
+						  "items": {
+							"type": "string"
+						  }
+						}
+					  }
+					}
+				  },
 				  "namespaces": {
 					"description": "Overrides Namespaces defined in global scope for all resources. Describes namespaces for every Kubernetes resources you want to watch or exclude.",
 					"title": "Namespaces",
@@ -748,6 +947,26 @@ func jsonSchema() api.JSONSchema {
 							{
 							  "const": "warning",
 							  "title": "Warning"
+							},
+							{
+							  "const": "rolloutStarted",
+							  "title": "Rollout started"
+							},
+							{
+							  "const": "rolloutSucceeded",
+							  "title": "Rollout succeeded"
+							},
+							{
+							  "const": "rolloutStuck",
+							  "title": "Rollout stuck"
+							},
+							{
+							  "const": "rolloutRolledBack",
+							  "title": "Rollout rolled back"
+							},
+							{
+							  "const": "conditionChanged",
+							  "title": "Condition changed"
 							}
 						  ]
 						},
@@ -828,6 +1047,95 @@ func jsonSchema() api.JSONSchema {
 					},
 					"title": "Update settings",
 					"description": "Additional settings for \"Update\" event type."
+				  },
+				  "conditions": {
+					"title": "Conditions",
+					"description": "Status conditions watched for the \"Condition changed\" event type. If empty, the Ready condition is watched.",
+					"type": "array",
+					"items": {
+					  "type": "object",
+					  "additionalProperties": false,
+					  "required": [
+						"type"
+					  ],
+					  "properties": {
+						"type": {
+						  "title": "Type",
+						  "description": "Condition type, such as Ready.",
+						  "type": "string"
+						},
+						"healthyStatus": {
+						  "title": "Healthy status",
+						  "description": "Condition status which means that the resource is healthy. Use False for abnormal-true conditions, such as Degraded or Stalled.",
+						  "type": "string",
+						  "default": "True"
+						}
+					  }
+					}
+				  },
+				  "metadataOnly": {
+					"title": "Metadata only",
+					"description": "If true, only object metadata is cached when the resource is watched only for create and delete events. Events sent to sinks and actions don't contain the object spec and status then.",
+					"type": "boolean",
+					"default": false
+				  },
+				  "owner": {
+					"title": "Owner",
+					"description": "Optional constraints for the top-level owner of the resource, such as Deployment for a given Pod. Objects without owners are matched against empty kind and name.",
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+					  "kind": {
+						"title": "Kind",
+						"type": "object",
+						"additionalProperties": false,
+						"properties": {
+						  "include": {
+							"title": "Include",
+							"type": "array",
+							"items": {
+							  "type": "string",
+							  "title": "Kind"
+							},
+							"description": "List of allowed owner kinds. It can also contain a regex expressions."
+						  },
+						  "exclude": {
+							"title": "Exclude",
+							"type": "array",
+							"items": {
+							  "type": "string",
+							  "title": "Kind"
+							},
+							"description": "List of excluded owner kinds. It can also contain a regex expressions."
+						  }
+						}
+					  },
+					  "name": {
+						"title": "Name",
+						"type": "object",
+						"additionalProperties": false,
+						"properties": {
+						  "include": {
+							"title": "Include",
+							"type": "array",
+							"items": {
+							  "type": "string",
+							  "title": "Name"
+							},
+							"description": "List of allowed owner names. It can also contain a regex expressions."
+						  },
+						  "exclude": {
+							"title": "Exclude",
+							"type": "array",
+							"items": {
+							  "type": "string",
+							  "title": "Name"
+							},
+							"description": "List of excluded owner names. It can also contain a regex expressions."
+						  }
+						}
+					  }
+					}
 				  }
 				}
 			  },
@@ -903,6 +1211,157 @@ func jsonSchema() api.JSONSchema {
 				}
 			  }
 			},
+			"ownerRollup": {
+			  "additionalProperties": false,
+			  "title": "Owner rollup",
+			  "type": "object",
+			  "description": "Configure reporting events against the top-level owner of a given object.",
+			  "properties": {
+				"enabled": {
+				  "type": "boolean",
+				  "title": "Enabled",
+				  "description": "If true, resolves owner references (e.g. Pod -> ReplicaSet -> Deployment, Job -> CronJob) and reports events against the top-level workload, listing the affected objects.",
+				  "default": false
+				}
+			  }
+			},
+			"replay": {
+			  "additionalProperties": false,
+			  "title": "Replay",
+			  "type": "object",
+			  "description": "Configure replaying Kubernetes events emitted while Botkube wasn't running, e.g. during restart or upgrade.",
+			  "properties": {
+				"enabled": {
+				  "type": "boolean",
+				  "title": "Enabled",
+				  "description": "If true, replays Kubernetes events newer than the last delivered one, which is persisted in a ConfigMap checkpoint. It requires permissions to get, create and update the ConfigMap.",
+				  "default": false
+				},
+				"window": {
+				  "type": "string",
+				  "title": "Window",
+				  "description": "Maximum age of replayed events.",
+				  "default": "1h"
+				},
+				"checkpoint": {
+				  "additionalProperties": false,
+				  "title": "Checkpoint",
+				  "type": "object",
+				  "description": "ConfigMap in which the last delivered event is persisted.",
+				  "properties": {
+					"namespace": {
+					  "type": "string",
+					  "title": "Namespace",
+					  "default": "botkube"
+					},
+					"name": {
+					  "type": "string",
+					  "title": "Name",
+					  "default": "botkube-kubernetes-checkpoints"
+					},
+					"key": {
+					  "type": "string",
+					  "title": "Key",
+					  "description": "ConfigMap data key under which the checkpoint is stored. It must be unique per source. If empty, the source name is used. The key is suffixed with the platform interactivity, as the source is streamed separately for interactive and non-interactive platforms."
+					},
+					"interval": {
+					  "type": "string",
+					  "title": "Interval",
+					  "description": "How often the checkpoint is persisted. It's also persisted when the source is stopped.",
+					  "default": "10s"
+					}
+				  }
+				}
+			  }
+			},
+			"templates": {
+			  "title": "Message templates",
+			  "description": "Custom notification layouts rendered with Go templates. The first template matching a given event is used, otherwise the built-in layout is used. Templates have access to the event (.Event), the involved object (.Object) and helper functions, such as sprig functions, age and owner.",
+			  "type": "array",
+			  "default": [],
+			  "items": {
+				"type": "object",
+				"title": "Message template",
+				"additionalProperties": false,
+				"properties": {
+				  "trigger": {
+					"title": "Trigger",
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+					  "resources": {
+						"title": "Resources",
+						"description": "Resource types, e.g. apps/v1/deployments. If empty, all resources are matched.",
+						"type": "array",
+						"items": {
+						  "type": "string"
+						}
+					  },
+					  "events": {
+						"title": "Event types",
+						"description": "Event types, e.g. create or error. If empty, all event types are matched.",
+						"type": "array",
+						"items": {
+						  "type": "string"
+						}
+					  }
+					}
+				  },
+				  "header": {
+					"title": "Header",
+					"description": "Template for the message header. If empty, the built-in header is used.",
+					"type": "string"
+				  },
+				  "textFields": {
+					"title": "Text fields",
+					"type": "array",
+					"items": {
+					  "type": "object",
+					  "additionalProperties": false,
+					  "properties": {
+						"key": {
+						  "title": "Key",
+						  "type": "string"
+						},
+						"value": {
+						  "title": "Value",
+						  "description": "Template for the field value. Fields with empty value are skipped.",
+						  "type": "string"
+						}
+					  },
+					  "required": ["key"]
+					}
+				  },
+				  "bulletLists": {
+					"title": "Bullet lists",
+					"type": "array",
+					"items": {
+					  "type": "object",
+					  "additionalProperties": false,
+					  "properties": {
+						"title": {
+						  "title": "Title",
+						  "type": "string"
+						},
+						"items": {
+						  "title": "Items",
+						  "description": "Template for the list items. Every non-empty line is a separate item.",
+						  "type": "string"
+						}
+					  }
+					}
+				  },
+				  "context": {
+					"title": "Context",
+					"description": "Templates for context lines displayed at the bottom of the message.",
+					"type": "array",
+					"items": {
+					  "type": "string"
+					}
+				  }
+				}
+			  }
+			},
 			"informerResyncPeriod": {
 			  "description": "Resync period of Kubernetes informer in a form of a duration string. A duration string is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".",
 			  "type": "string",
@@ -1041,6 +1500,26 @@ func jsonSchema() api.JSONSchema {
 					  {
 						"const": "warning",
 						"title": "Warning"
+					  },
+					  {
+						"const": "rolloutStarted",
+						"title": "Rollout started"
+					  },
+					  {
+						"const": "rolloutSucceeded",
+						"title": "Rollout succeeded"
+					  },
+					  {
+						"const": "rolloutStuck",
+						"title": "Rollout stuck"
+					  },
+					  {
+						"const": "rolloutRolledBack",
+						"title": "Rollout rolled back"
+					  },
+					  {
+						"const": "conditionChanged",
+						"title": "Condition changed"
 					  }
 					]
 				  },
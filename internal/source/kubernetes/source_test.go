@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
 )
 
 // TODO: Refactor these tests as a part of https://github.com/kubeshop/botkube/issues/589
@@ -69,3 +71,52 @@ func TestController_strToGVR(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckpointKey(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Key      string
+		Input    source.StreamInput
+		Expected string
+	}{
+		{
+			Name: "Source name",
+			Input: source.StreamInput{
+				Context: source.StreamInputContext{SourceName: "k8s-err-events", IsInteractivitySupported: true},
+			},
+			Expected: "k8s-err-events.interactive",
+		},
+		{
+			Name: "Source name with invalid characters",
+			Input: source.StreamInput{
+				Context: source.StreamInputContext{SourceName: "k8s err/events"},
+			},
+			Expected: "k8s_err_events.non-interactive",
+		},
+		{
+			Name: "Configured key",
+			Key:  "custom",
+			Input: source.StreamInput{
+				Context: source.StreamInputContext{SourceName: "k8s-err-events"},
+			},
+			Expected: "custom.non-interactive",
+		},
+		{
+			Name: "Unknown source name",
+			Input: source.StreamInput{
+				Configs: []*source.Config{{RawYAML: []byte("replay: {enabled: true}")}},
+			},
+			Expected: "source-2ddc2bf7.non-interactive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// when
+			actual := checkpointKey(test.Key, test.Input)
+
+			// then
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package logwatcher
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds container log watcher source configuration.
+type Config struct {
+	Log        config.Logger              `yaml:"log"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// LabelSelector selects Pods which logs are followed, e.g. `app in (api, worker)`. All Pods are selected if empty.
+	LabelSelector string `yaml:"labelSelector"`
+	// Patterns are matched against every log line.
+	Patterns []Pattern `yaml:"patterns"`
+	// Cooldown is the default time during which a given pattern is not reported again for the same container.
+	Cooldown time.Duration `yaml:"cooldown"`
+	// ContextLines is the number of lines preceding the matching line which are sent together with it.
+	ContextLines int `yaml:"contextLines"`
+	// MaxStreams limits the number of log streams opened concurrently.
+	MaxStreams int `yaml:"maxStreams"`
+	// InformerResyncPeriod defines how often Pods are checked for containers without an opened log stream,
+	// for example when the MaxStreams limit was reached before.
+	InformerResyncPeriod time.Duration `yaml:"informerResyncPeriod"`
+}
+
+// Pattern defines a regex matched against log lines.
+type Pattern struct {
+	Name  string `yaml:"name"`
+	Regex string `yaml:"regex"`
+	// Cooldown overrides the default cooldown for a given pattern.
+	Cooldown time.Duration `yaml:"cooldown"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		Cooldown:             5 * time.Minute,
+		ContextLines:         5,
+		MaxStreams:           20,
+		InformerResyncPeriod: time.Minute,
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if _, err := labels.Parse(c.LabelSelector); err != nil {
+		return fmt.Errorf("while parsing label selector %q: %w", c.LabelSelector, err)
+	}
+	if len(c.Patterns) == 0 {
+		return errors.New("at least one pattern is required")
+	}
+
+	names := map[string]struct{}{}
+	for _, p := range c.Patterns {
+		if p.Name == "" {
+			return fmt.Errorf("the name of the %q pattern is required", p.Regex)
+		}
+		if _, found := names[p.Name]; found {
+			return fmt.Errorf("the %q pattern is defined more than once", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if _, err := regexp.Compile(p.Regex); err != nil {
+			return fmt.Errorf("while compiling regex of the %q pattern: %w", p.Name, err)
+		}
+		if p.Cooldown < 0 {
+			return fmt.Errorf("the cooldown of the %q pattern must not be negative, got %s", p.Name, p.Cooldown)
+		}
+	}
+
+	if c.Cooldown < 0 {
+		return fmt.Errorf("the cooldown must not be negative, got %s", c.Cooldown)
+	}
+	if c.ContextLines < 0 {
+		return fmt.Errorf("the number of context lines must not be negative, got %d", c.ContextLines)
+	}
+	if c.MaxStreams <= 0 {
+		return fmt.Errorf("the max streams must be positive, got %d", c.MaxStreams)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package logwatcher
+
+import (
+	"regexp"
+	"sync"
+	"time"
+)
+
+type compiledPattern struct {
+	name     string
+	regex    *regexp.Regexp
+	cooldown time.Duration
+}
+
+func compilePatterns(cfg Config) ([]compiledPattern, error) {
+	out := make([]compiledPattern, 0, len(cfg.Patterns))
+	for _, p := range cfg.Patterns {
+		regex, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return nil, err
+		}
+		cooldown := p.Cooldown
+		if cooldown == 0 {
+			cooldown = cfg.Cooldown
+		}
+		out = append(out, compiledPattern{name: p.Name, regex: regex, cooldown: cooldown})
+	}
+	return out, nil
+}
+
+// cooldowns tracks until when a given pattern is muted for a given container. It's shared by all log streams.
+type cooldowns struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	expireAt map[string]time.Time
+}
+
+func newCooldowns() *cooldowns {
+	return &cooldowns{
+		now:      time.Now,
+		expireAt: map[string]time.Time{},
+	}
+}
+
+// Allow returns true if a given key wasn't reported within the cooldown period. If so, the key is marked as reported.
+func (c *cooldowns) Allow(key string, cooldown time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if expireAt, found := c.expireAt[key]; found && now.Before(expireAt) {
+		return false
+	}
+
+	// Matches are rare, so it's fine to clean up expired entries here.
+	for k, expireAt := range c.expireAt {
+		if !now.Before(expireAt) {
+			delete(c.expireAt, k)
+		}
+	}
+	c.expireAt[key] = now.Add(cooldown)
+	return true
+}
+
+// matcher matches lines of a single log stream against configured patterns.
+// It keeps the most recent lines to send them as a context of the match.
+type matcher struct {
+	ref          containerRef
+	patterns     []compiledPattern
+	cooldowns    *cooldowns
+	contextLines int
+	history      []string
+}
+
+// Match returns matches for a given line, skipping patterns which are in the cooldown period for the container.
+func (m *matcher) Match(line string) []LogMatch {
+	defer m.remember(line)
+
+	var out []LogMatch
+	for _, p := range m.patterns {
+		if !p.regex.MatchString(line) {
+			continue
+		}
+		if !m.cooldowns.Allow(m.cooldownKey(p), p.cooldown) {
+			continue
+		}
+		out = append(out, LogMatch{
+			Pattern:   p.name,
+			Namespace: m.ref.Namespace,
+			Pod:       m.ref.Pod,
+			Container: m.ref.Container,
+			Line:      line,
+			Context:   append([]string(nil), m.history...),
+		})
+	}
+	return out
+}
+
+func (m *matcher) remember(line string) {
+	if m.contextLines == 0 {
+		return
+	}
+	if len(m.history) == m.contextLines {
+		m.history = append(m.history[:0], m.history[1:]...)
+	}
+	m.history = append(m.history, line)
+}
+
+func (m *matcher) cooldownKey(p compiledPattern) string {
+	return p.name + "/" + m.ref.String()
+}
@@ -0,0 +1,52 @@
+package logwatcher
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// LogMatch is a raw object sent together with the log pattern message.
+type LogMatch struct {
+	Pattern   string   `json:"pattern"`
+	Namespace string   `json:"namespace"`
+	Pod       string   `json:"pod"`
+	Container string   `json:"container"`
+	Line      string   `json:"line"`
+	Context   []string `json:"context,omitempty"`
+	Cluster   string   `json:"cluster,omitempty"`
+}
+
+func messageForMatch(m LogMatch, now time.Time) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("🔎 Pattern %q matched in %s/%s logs", m.Pattern, m.Namespace, m.Pod),
+			Body: api.Body{
+				CodeBlock: strings.Join(append(append([]string(nil), m.Context...), m.Line), "\n"),
+			},
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Pattern", m.Pattern)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", m.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Pod", m.Pod)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Container", m.Container)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", m.Cluster)
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
@@ -0,0 +1,285 @@
+package logwatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the container log watcher Botkube plugin.
+	PluginName = "log-watcher"
+
+	description = "Get notifications when container logs match configured patterns, such as panics, out of memory errors or connection failures."
+)
+
+// Source container log watcher source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams container log pattern matches
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+	patterns, err := compilePatterns(cfg)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while compiling patterns: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	cd := newCooldowns()
+	newMatcher := func(ref containerRef) *matcher {
+		return &matcher{ref: ref, patterns: patterns, cooldowns: cd, contextLines: cfg.ContextLines}
+	}
+	handle := func(ctx context.Context, match LogMatch) {
+		match.Cluster = input.Context.ClusterName
+		select {
+		case out.Event <- source.Event{
+			Message:   messageForMatch(match, time.Now()),
+			RawObject: match,
+		}:
+		case <-ctx.Done():
+		}
+	}
+
+	w := &watcher{
+		log:     log,
+		cfg:     cfg,
+		streams: newStreamManager(log, newLogOpener(k8sCli), newMatcher, cfg.MaxStreams, handle),
+	}
+	go w.watch(ctx, k8sCli)
+
+	return out, nil
+}
+
+// Metadata returns metadata of container log watcher configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+type watcher struct {
+	log     logrus.FieldLogger
+	cfg     Config
+	streams *streamManager
+}
+
+func (w *watcher) watch(ctx context.Context, k8sCli kubernetes.Interface) {
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sCli, w.cfg.InformerResyncPeriod, informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+		opts.LabelSelector = w.cfg.LabelSelector
+	}))
+	informer := factory.Core().V1().Pods().Informer()
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.sync(ctx, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			w.sync(ctx, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				return
+			}
+			w.streams.Forget(pod)
+		},
+	})
+
+	w.log.Info("Starting Pod informer...")
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+	w.streams.Wait()
+}
+
+func (w *watcher) sync(ctx context.Context, obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+
+	allowed, err := w.cfg.Namespaces.IsAllowed(pod.Namespace)
+	if err != nil {
+		w.log.Errorf("while matching namespace: %s", err.Error())
+		return
+	}
+	if !allowed {
+		return
+	}
+	w.streams.Sync(ctx, pod)
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Container log watcher",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces of Pods which logs are followed.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"labelSelector": {
+			  "title": "Label selector",
+			  "description": "Selects Pods which logs are followed, e.g. app in (api, worker). All Pods are selected if empty.",
+			  "type": "string"
+			},
+			"patterns": {
+			  "title": "Patterns",
+			  "description": "Regex patterns matched against every log line.",
+			  "type": "array",
+			  "items": {
+				"type": "object",
+				"required": ["name", "regex"],
+				"properties": {
+				  "name": {
+					"title": "Name",
+					"type": "string"
+				  },
+				  "regex": {
+					"title": "Regex",
+					"type": "string"
+				  },
+				  "cooldown": {
+					"title": "Cooldown",
+					"description": "Overrides the default cooldown for this pattern.",
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"cooldown": {
+			  "title": "Cooldown",
+			  "description": "Time during which a given pattern is not reported again for the same container.",
+			  "type": "string",
+			  "default": "5m"
+			},
+			"contextLines": {
+			  "title": "Context lines",
+			  "description": "Number of lines preceding the matching line which are sent together with it.",
+			  "type": "integer",
+			  "default": 5
+			},
+			"maxStreams": {
+			  "title": "Max streams",
+			  "description": "Maximum number of log streams opened concurrently.",
+			  "type": "integer",
+			  "default": 20
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
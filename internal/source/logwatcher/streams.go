@@ -0,0 +1,266 @@
+package logwatcher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const maxLineSize = 1 << 20
+
+// containerRef identifies a container in a given Pod.
+type containerRef struct {
+	Namespace string
+	Pod       string
+	Container string
+}
+
+func (r containerRef) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.Namespace, r.Pod, r.Container)
+}
+
+// streamOptions defines which part of the container logs is read. Lines written before Since are skipped.
+type streamOptions struct {
+	Since    time.Time
+	Follow   bool
+	Previous bool
+}
+
+// logOpener opens a log stream of a given container.
+type logOpener func(ctx context.Context, ref containerRef, opts streamOptions) (io.ReadCloser, error)
+
+func newLogOpener(k8sCli kubernetes.Interface) logOpener {
+	return func(ctx context.Context, ref containerRef, opts streamOptions) (io.ReadCloser, error) {
+		since := metav1.NewTime(opts.Since)
+		return k8sCli.CoreV1().Pods(ref.Namespace).GetLogs(ref.Pod, &corev1.PodLogOptions{
+			Container: ref.Container,
+			Follow:    opts.Follow,
+			Previous:  opts.Previous,
+			SinceTime: &since,
+			// SinceTime has a precision of seconds, so timestamps are used to skip already read lines.
+			Timestamps: true,
+		}).Stream(ctx)
+	}
+}
+
+type streamKey struct {
+	podUID    types.UID
+	container string
+}
+
+// resumePoint holds the position from which a given container instance should be read again.
+type resumePoint struct {
+	containerID string
+	since       time.Time
+}
+
+// streamManager opens a log stream for every container instance of watched Pods.
+//
+// Each container instance, identified by the container ID, is read only once. Running containers are followed,
+// so the stream ends when the container terminates. If a container restarts, the new instance is read from its start.
+// If a container terminated before it was seen running, e.g. it's in the CrashLoopBackOff state, logs of the terminated instance are read.
+// If a followed stream ends or fails, e.g. the API server closed the connection, the container instance is read again on the next Pod sync,
+// starting after the last read line.
+// Rescheduled Pods get a new UID, so they are handled as new Pods.
+type streamManager struct {
+	log        logrus.FieldLogger
+	open       logOpener
+	newMatcher func(ref containerRef) *matcher
+	handle     func(ctx context.Context, match LogMatch)
+	maxStreams int
+	startedAt  time.Time
+
+	mu sync.Mutex
+	// active holds cancel functions of opened streams.
+	active map[streamKey]context.CancelFunc
+	// streamed holds the ID of the last container instance which was read for a given container.
+	streamed map[streamKey]string
+	// resume holds the position of the last interrupted stream for a given container.
+	resume map[streamKey]resumePoint
+	wg     sync.WaitGroup
+}
+
+func newStreamManager(log logrus.FieldLogger, open logOpener, newMatcher func(ref containerRef) *matcher, maxStreams int, handle func(ctx context.Context, match LogMatch)) *streamManager {
+	return &streamManager{
+		log:        log,
+		open:       open,
+		newMatcher: newMatcher,
+		handle:     handle,
+		maxStreams: maxStreams,
+		startedAt:  time.Now(),
+		active:     map[streamKey]context.CancelFunc{},
+		streamed:   map[streamKey]string{},
+		resume:     map[streamKey]resumePoint{},
+	}
+}
+
+// Sync opens log streams for container instances of a given Pod which weren't read yet.
+func (m *streamManager) Sync(ctx context.Context, pod *corev1.Pod) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, status := range pod.Status.ContainerStatuses {
+		key := streamKey{podUID: pod.UID, container: status.Name}
+		containerID, opts, ok := m.streamFor(status)
+		if !ok || m.streamed[key] == containerID {
+			continue
+		}
+		if resume, found := m.resume[key]; found && resume.containerID == containerID {
+			opts.Since = resume.since
+		}
+
+		ref := containerRef{Namespace: pod.Namespace, Pod: pod.Name, Container: status.Name}
+		if cancel, found := m.active[key]; found {
+			// the previous instance is gone, so its stream is about to end anyway
+			cancel()
+			delete(m.active, key)
+		}
+		if len(m.active) >= m.maxStreams {
+			m.log.WithField("container", ref.String()).Debugf("Limit of %d log streams reached, skipping...", m.maxStreams)
+			continue
+		}
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		m.active[key] = cancel
+		m.streamed[key] = containerID
+		delete(m.resume, key)
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			m.read(streamCtx, key, containerID, ref, opts)
+		}()
+	}
+}
+
+// streamFor returns the container instance that should be read, together with stream options.
+func (m *streamManager) streamFor(status corev1.ContainerStatus) (string, streamOptions, bool) {
+	if running := status.State.Running; running != nil {
+		return status.ContainerID, streamOptions{Since: m.sinceTime(running.StartedAt.Time), Follow: true}, true
+	}
+
+	terminated, previous := status.State.Terminated, false
+	if terminated == nil {
+		terminated, previous = status.LastTerminationState.Terminated, true
+	}
+	if terminated == nil || terminated.ContainerID == "" || terminated.FinishedAt.Time.Before(m.startedAt) {
+		return "", streamOptions{}, false
+	}
+	return terminated.ContainerID, streamOptions{Since: m.sinceTime(terminated.StartedAt.Time), Previous: previous}, true
+}
+
+// sinceTime ensures that logs written before the plugin started are not read.
+func (m *streamManager) sinceTime(containerStartedAt time.Time) time.Time {
+	if containerStartedAt.Before(m.startedAt) {
+		return m.startedAt
+	}
+	return containerStartedAt
+}
+
+// Forget closes all log streams of a given Pod.
+func (m *streamManager) Forget(pod *corev1.Pod) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.streamed {
+		if key.podUID != pod.UID {
+			continue
+		}
+		if cancel, found := m.active[key]; found {
+			cancel()
+			delete(m.active, key)
+		}
+		delete(m.streamed, key)
+		delete(m.resume, key)
+	}
+}
+
+// Wait blocks until all log streams are closed.
+func (m *streamManager) Wait() {
+	m.wg.Wait()
+}
+
+func (m *streamManager) read(ctx context.Context, key streamKey, containerID string, ref containerRef, opts streamOptions) {
+	log := m.log.WithField("container", ref.String())
+	log.Debug("Opening log stream...")
+
+	lastSeen, err := m.readLines(ctx, ref, opts)
+	stopped := ctx.Err() != nil
+	if err != nil && !stopped {
+		log.Warnf("while reading logs: %s", err.Error())
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.streamed[key] != containerID {
+		// a newer container instance is already read
+		return
+	}
+	if cancel, found := m.active[key]; found {
+		cancel()
+		delete(m.active, key)
+	}
+	if stopped {
+		return
+	}
+	// Logs of a terminated container are complete. A followed stream could end while the container is still running,
+	// so it's resumed on the next Pod sync. If the container terminated in the meantime, only the remaining lines are read.
+	if err == nil && !opts.Follow {
+		return
+	}
+	since := opts.Since
+	if lastSeen.After(since) {
+		since = lastSeen.Add(time.Nanosecond)
+	}
+	delete(m.streamed, key)
+	m.resume[key] = resumePoint{containerID: containerID, since: since}
+}
+
+// readLines matches all lines of a given log stream. It returns the timestamp of the last read line.
+func (m *streamManager) readLines(ctx context.Context, ref containerRef, opts streamOptions) (time.Time, error) {
+	var lastSeen time.Time
+	stream, err := m.open(ctx, ref, opts)
+	if err != nil {
+		return lastSeen, fmt.Errorf("while opening log stream: %w", err)
+	}
+	defer stream.Close()
+
+	matcher := m.newMatcher(ref)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		timestamp, line, found := splitTimestamp(scanner.Text())
+		if found {
+			if timestamp.Before(opts.Since) {
+				continue
+			}
+			lastSeen = timestamp
+		}
+		for _, match := range matcher.Match(line) {
+			m.handle(ctx, match)
+		}
+	}
+	return lastSeen, scanner.Err()
+}
+
+// splitTimestamp splits a log line into the timestamp added by the kubelet and the line written by the container.
+func splitTimestamp(in string) (time.Time, string, bool) {
+	prefix, line, found := strings.Cut(in, " ")
+	if !found {
+		return time.Time{}, in, false
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Time{}, in, false
+	}
+	return timestamp, line, true
+}
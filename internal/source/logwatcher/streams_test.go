@@ -0,0 +1,295 @@
+package logwatcher
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	cd := newCooldowns()
+	cd.now = func() time.Time { return now }
+	ref := containerRef{Namespace: "default", Pod: "api-1", Container: "app"}
+	patterns := []compiledPattern{
+		{name: "panic", regex: regexp.MustCompile(`^panic:`), cooldown: time.Minute},
+		{name: "conn", regex: regexp.MustCompile(`connection refused`), cooldown: time.Minute},
+	}
+	m := &matcher{ref: ref, patterns: patterns, cooldowns: cd, contextLines: 2}
+
+	// when
+	var matches []LogMatch
+	for _, line := range []string{"starting", "listening on :8080", "dial tcp: connection refused", "panic: nil map"} {
+		matches = append(matches, m.Match(line)...)
+	}
+
+	// then
+	assert.Equal(t, []LogMatch{
+		{Pattern: "conn", Namespace: "default", Pod: "api-1", Container: "app", Line: "dial tcp: connection refused", Context: []string{"starting", "listening on :8080"}},
+		{Pattern: "panic", Namespace: "default", Pod: "api-1", Container: "app", Line: "panic: nil map", Context: []string{"listening on :8080", "dial tcp: connection refused"}},
+	}, matches)
+
+	// when
+	matches = m.Match("panic: again")
+	otherContainer := (&matcher{ref: containerRef{Namespace: "default", Pod: "api-2", Container: "app"}, patterns: patterns, cooldowns: cd}).Match("panic: other")
+
+	// then
+	assert.Empty(t, matches, "pattern should be in cooldown for the same container")
+	assert.Len(t, otherContainer, 1)
+
+	// when
+	now = now.Add(time.Minute)
+	matches = m.Match("panic: after cooldown")
+
+	// then
+	assert.Len(t, matches, 1)
+}
+
+func TestStreamManager(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startedAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	opener := &fakeOpener{logs: []string{"panic: first", "panic: second", "panic: crash loop"}}
+	var (
+		mu      sync.Mutex
+		matches []string
+	)
+	handle := func(_ context.Context, match LogMatch) {
+		mu.Lock()
+		defer mu.Unlock()
+		matches = append(matches, match.Line)
+	}
+	got := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), matches...)
+	}
+	m := newStreamManager(loggerx.NewNoop(), opener.Open, fixMatcherFactory(), 10, handle)
+	m.startedAt = startedAt
+
+	pod := fixPod("api-1", runningContainer("containerd://1", startedAt.Add(-time.Hour)))
+
+	// when
+	m.Sync(ctx, pod)
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"panic: first"}, got())
+	}, time.Second, 10*time.Millisecond)
+
+	// when the container restarts
+	restarted := fixPod("api-1", runningContainer("containerd://2", startedAt.Add(time.Minute)))
+	m.Sync(ctx, restarted)
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"panic: first", "panic: second"}, got())
+	}, time.Second, 10*time.Millisecond)
+
+	// when the container crashed before it was seen running
+	crashLoop := fixPod("api-1", corev1.ContainerStatus{
+		Name:  "app",
+		State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			ContainerID: "containerd://3",
+			StartedAt:   metav1.NewTime(startedAt.Add(2 * time.Minute)),
+			FinishedAt:  metav1.NewTime(startedAt.Add(3 * time.Minute)),
+		}},
+	})
+	m.Sync(ctx, crashLoop)
+	m.Sync(ctx, crashLoop)
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"panic: first", "panic: second", "panic: crash loop"}, got())
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	m.Wait()
+	assert.Equal(t, []openCall{
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt, Follow: true}},
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt.Add(time.Minute), Follow: true}},
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt.Add(2 * time.Minute), Previous: true}},
+	}, opener.Calls())
+}
+
+func TestStreamManager_ResumeEndedStream(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startedAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	opener := &fakeOpener{logs: []string{
+		"2023-03-01T12:00:01.5Z panic: first\n2023-03-01T12:00:02.5Z panic: second",
+		"2023-03-01T12:00:02.5Z panic: second\n2023-03-01T12:00:03.5Z panic: third",
+		"2023-03-01T12:00:03.5Z panic: third",
+	}}
+	var (
+		mu      sync.Mutex
+		matches []string
+	)
+	handle := func(_ context.Context, match LogMatch) {
+		mu.Lock()
+		defer mu.Unlock()
+		matches = append(matches, match.Line)
+	}
+	got := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), matches...)
+	}
+	m := newStreamManager(loggerx.NewNoop(), opener.Open, fixMatcherFactory(), 10, handle)
+	m.startedAt = startedAt
+	streamsEnded := func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return len(m.active) == 0
+	}
+
+	// when
+	m.Sync(ctx, fixPod("api-1", runningContainer("containerd://1", startedAt)))
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"panic: first", "panic: second"}, got()) && streamsEnded()
+	}, time.Second, 10*time.Millisecond)
+
+	// when the stream ended while the container is still running
+	m.Sync(ctx, fixPod("api-1", runningContainer("containerd://1", startedAt)))
+
+	// then
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"panic: first", "panic: second", "panic: third"}, got()) && streamsEnded()
+	}, time.Second, 10*time.Millisecond)
+
+	// when the container terminated
+	terminated := fixPod("api-1", corev1.ContainerStatus{
+		Name: "app",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			ContainerID: "containerd://1",
+			StartedAt:   metav1.NewTime(startedAt),
+			FinishedAt:  metav1.NewTime(startedAt.Add(time.Minute)),
+		}},
+	})
+	m.Sync(ctx, terminated)
+	assert.Eventually(t, streamsEnded, time.Second, 10*time.Millisecond)
+	m.Sync(ctx, terminated)
+
+	// then
+	cancel()
+	m.Wait()
+	assert.Equal(t, []string{"panic: first", "panic: second", "panic: third"}, got())
+	assert.Equal(t, []openCall{
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt, Follow: true}},
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt.Add(2500*time.Millisecond + time.Nanosecond), Follow: true}},
+		{ref: "default/api-1/app", opts: streamOptions{Since: startedAt.Add(3500*time.Millisecond + time.Nanosecond)}},
+	}, opener.Calls())
+}
+
+func TestStreamManager_MaxStreams(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opener := &fakeOpener{block: true}
+	m := newStreamManager(loggerx.NewNoop(), opener.Open, fixMatcherFactory(), 1, func(context.Context, LogMatch) {})
+	first := fixPod("api-1", runningContainer("containerd://1", m.startedAt))
+	second := fixPod("api-2", runningContainer("containerd://2", m.startedAt))
+
+	// when
+	m.Sync(ctx, first)
+	m.Sync(ctx, second)
+
+	// then
+	assert.Eventually(t, func() bool { return len(opener.Calls()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// when
+	m.Forget(first)
+	m.Sync(ctx, second)
+
+	// then
+	assert.Eventually(t, func() bool { return len(opener.Calls()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "default/api-2/app", opener.Calls()[1].ref)
+
+	cancel()
+	m.Wait()
+}
+
+type openCall struct {
+	ref  string
+	opts streamOptions
+}
+
+// fakeOpener returns the configured logs in the order of calls. If block is set, streams are open until the context is canceled.
+type fakeOpener struct {
+	mu    sync.Mutex
+	logs  []string
+	block bool
+	calls []openCall
+}
+
+func (f *fakeOpener) Open(ctx context.Context, ref containerRef, opts streamOptions) (io.ReadCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, openCall{ref: ref.String(), opts: opts})
+	if f.block {
+		reader, writer := io.Pipe()
+		go func() {
+			<-ctx.Done()
+			writer.Close()
+		}()
+		return reader, nil
+	}
+
+	var logs string
+	if len(f.logs) > 0 {
+		logs, f.logs = f.logs[0], f.logs[1:]
+	}
+	return io.NopCloser(strings.NewReader(logs)), nil
+}
+
+func (f *fakeOpener) Calls() []openCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]openCall(nil), f.calls...)
+}
+
+func fixPod(name string, status corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name + "-uid")},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{status},
+		},
+	}
+}
+
+func runningContainer(id string, startedAt time.Time) corev1.ContainerStatus {
+	return corev1.ContainerStatus{
+		Name:        "app",
+		ContainerID: id,
+		State:       corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)}},
+	}
+}
+
+func fixMatcherFactory() func(ref containerRef) *matcher {
+	cd := newCooldowns()
+	patterns := []compiledPattern{{name: "panic", regex: regexp.MustCompile(`^panic:`)}}
+	return func(ref containerRef) *matcher {
+		return &matcher{ref: ref, patterns: patterns, cooldowns: cd}
+	}
+}
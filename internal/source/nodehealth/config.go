@@ -0,0 +1,69 @@
+package nodehealth
+
+import (
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds Node health source configuration.
+type Config struct {
+	Log                  config.Logger              `yaml:"log"`
+	InformerResyncPeriod time.Duration              `yaml:"informerResyncPeriod"`
+	Nodes                k8sconfig.RegexConstraints `yaml:"nodes"`
+	Conditions           []string                   `yaml:"conditions"`
+	Cordon               CordonConfig               `yaml:"cordon"`
+	Taints               TaintsConfig               `yaml:"taints"`
+}
+
+// CordonConfig contains configuration for cordon and drain notifications.
+type CordonConfig struct {
+	// Enabled notifies when a Node is cordoned or uncordoned.
+	Enabled bool `yaml:"enabled"`
+}
+
+// TaintsConfig contains configuration for taint change notifications.
+type TaintsConfig struct {
+	// Enabled notifies when a Node taint is added or removed.
+	Enabled bool `yaml:"enabled"`
+	// Ignore contains taint keys that are expected to change, e.g. set automatically together with Node conditions.
+	// It can also contain a regex expressions.
+	Ignore []string `yaml:"ignore"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		InformerResyncPeriod: 30 * time.Minute,
+		Nodes: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		Conditions: []string{readyCondition, "MemoryPressure", "DiskPressure", "PIDPressure"},
+		Cordon: CordonConfig{
+			Enabled: true,
+		},
+		Taints: TaintsConfig{
+			Enabled: true,
+			Ignore: []string{
+				"node.kubernetes.io/.*",
+				"node.cloudprovider.kubernetes.io/.*",
+				"ToBeDeletedByClusterAutoscaler",
+				"DeletionCandidateOfClusterAutoscaler",
+			},
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
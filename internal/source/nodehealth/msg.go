@@ -0,0 +1,96 @@
+package nodehealth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// NodeEvent is a raw object sent together with the Node health message.
+type NodeEvent struct {
+	Node             string        `json:"node"`
+	Cluster          string        `json:"cluster,omitempty"`
+	Type             string        `json:"type"`
+	Condition        string        `json:"condition,omitempty"`
+	Reason           string        `json:"reason,omitempty"`
+	Message          string        `json:"message,omitempty"`
+	Taint            string        `json:"taint,omitempty"`
+	Duration         time.Duration `json:"duration,omitempty"`
+	EvictedWorkloads []string      `json:"evictedWorkloads,omitempty"`
+}
+
+func newNodeEvent(nodeName, cluster string, tr Transition, evicted []string) NodeEvent {
+	out := NodeEvent{
+		Node:             nodeName,
+		Cluster:          cluster,
+		Type:             string(tr.Type),
+		Condition:        tr.Condition,
+		Reason:           tr.Reason,
+		Message:          tr.Message,
+		Duration:         tr.Duration,
+		EvictedWorkloads: evicted,
+	}
+	if tr.Taint != nil {
+		out.Taint = tr.Taint.ToString()
+	}
+	return out
+}
+
+func messageForTransition(nodeName, cluster string, tr Transition, evicted []string, now time.Time) api.Message {
+	emoji := "🟢"
+	if tr.IsProblem() {
+		emoji = "❗"
+	}
+
+	title := tr.Title(nodeName)
+	if tr.Duration > 0 {
+		title = fmt.Sprintf("%s after %s", title, tr.Duration)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("%s %s", emoji, title),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Node", nodeName)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Condition", tr.Condition)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Reason", tr.Reason)
+	if tr.Duration > 0 {
+		durationKey := "Unhealthy for"
+		if tr.Type == Uncordoned {
+			durationKey = "Cordoned for"
+		}
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, durationKey, tr.Duration.String())
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", cluster)
+
+	if tr.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Messages",
+			Items: []string{tr.Message},
+		})
+	}
+	if len(evicted) > 0 {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Evicted workloads",
+			Items: evicted,
+		})
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
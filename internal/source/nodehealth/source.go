@@ -0,0 +1,340 @@
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the Node health Botkube plugin.
+	PluginName = "node-health"
+
+	description = "Get notifications about Node condition transitions, cordons and taint changes together with evicted workloads."
+)
+
+// Source Node health source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams Node health events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	w := &watcher{
+		log:         loggerx.New(cfg.Log),
+		cfg:         cfg,
+		clusterName: input.Context.ClusterName,
+		detector:    newDetector(cfg),
+		workloads:   newWorkloadTracker(k8sCli),
+		eventCh:     out.Event,
+	}
+	go w.watch(ctx, k8sCli)
+
+	return out, nil
+}
+
+// Metadata returns metadata of Node health configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+type watcher struct {
+	log         logrus.FieldLogger
+	cfg         Config
+	clusterName string
+	detector    *detector
+	workloads   *workloadTracker
+	eventCh     chan<- source.Event
+}
+
+func (w *watcher) watch(ctx context.Context, k8sCli kubernetes.Interface) {
+	factory := informers.NewSharedInformerFactory(k8sCli, w.cfg.InformerResyncPeriod)
+	informer := factory.Core().V1().Nodes().Informer()
+
+	// Only transitions are reported, so the initial state of Nodes listed on startup is ignored.
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			w.handleUpdate(ctx, oldNode, newNode)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			node, ok := obj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			w.detector.Forget(node.Name)
+			w.workloads.Forget(node.Name)
+		},
+	})
+
+	w.log.Info("Starting Node informer...")
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+}
+
+func (w *watcher) handleUpdate(ctx context.Context, oldNode, newNode *corev1.Node) {
+	log := w.log.WithField("node", newNode.Name)
+
+	allowed, err := w.cfg.Nodes.IsAllowed(newNode.Name)
+	if err != nil {
+		log.Errorf("while matching Node name: %s", err.Error())
+		return
+	}
+	if !allowed {
+		return
+	}
+
+	transitions, err := w.detector.Detect(oldNode, newNode)
+	if err != nil {
+		log.Errorf("while detecting Node transitions: %s", err.Error())
+		return
+	}
+	if len(transitions) == 0 {
+		return
+	}
+
+	wasUnhealthy := hasActiveProblems(w.cfg, oldNode)
+	isUnhealthy := hasActiveProblems(w.cfg, newNode)
+
+	if !wasUnhealthy && isUnhealthy {
+		if err := w.workloads.Snapshot(ctx, newNode.Name); err != nil {
+			log.Errorf("while taking snapshot of Node workloads: %s", err.Error())
+		}
+	}
+
+	var evicted []string
+	if wasUnhealthy && !isUnhealthy {
+		evicted, err = w.workloads.Evicted(ctx, newNode.Name)
+		if err != nil {
+			log.Errorf("while getting evicted workloads: %s", err.Error())
+		}
+	}
+
+	for _, tr := range transitions {
+		// Evicted workloads are reported only once, together with the first resolved problem.
+		var trEvicted []string
+		if !tr.IsProblem() && tr.Taint == nil {
+			trEvicted, evicted = evicted, nil
+		}
+
+		log.WithField("transition", tr.Type).Debug("Sending Node health event...")
+		w.eventCh <- source.Event{
+			Message:   messageForTransition(newNode.Name, w.clusterName, tr, trEvicted, time.Now()),
+			RawObject: newNodeEvent(newNode.Name, w.clusterName, tr, trEvicted),
+		}
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Node health",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"nodes": {
+			  "title": "Nodes",
+			  "description": "Node names to watch. You can use regex expressions.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"conditions": {
+			  "title": "Conditions",
+			  "description": "Node conditions to watch. Notification is sent when a Node becomes NotReady or gets a pressure condition, and when it is healthy again.",
+			  "type": "array",
+			  "default": [
+				"Ready",
+				"MemoryPressure",
+				"DiskPressure",
+				"PIDPressure"
+			  ],
+			  "items": {
+				"type": "string",
+				"title": "Condition",
+				"oneOf": [
+				  {
+					"const": "Ready",
+					"title": "Ready"
+				  },
+				  {
+					"const": "MemoryPressure",
+					"title": "Memory pressure"
+				  },
+				  {
+					"const": "DiskPressure",
+					"title": "Disk pressure"
+				  },
+				  {
+					"const": "PIDPressure",
+					"title": "PID pressure"
+				  },
+				  {
+					"const": "NetworkUnavailable",
+					"title": "Network unavailable"
+				  }
+				]
+			  },
+			  "uniqueItems": true
+			},
+			"cordon": {
+			  "title": "Cordon",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If enabled, notifies when a Node is cordoned or uncordoned.",
+				  "type": "boolean",
+				  "default": true
+				}
+			  }
+			},
+			"taints": {
+			  "title": "Taints",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If enabled, notifies when a Node taint is added or removed.",
+				  "type": "boolean",
+				  "default": true
+				},
+				"ignore": {
+				  "title": "Ignored taints",
+				  "description": "Taint keys that are expected to change. You can use regex expressions.",
+				  "type": "array",
+				  "default": [
+					"node.kubernetes.io/.*",
+					"node.cloudprovider.kubernetes.io/.*",
+					"ToBeDeletedByClusterAutoscaler",
+					"DeletionCandidateOfClusterAutoscaler"
+				  ],
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"informerResyncPeriod": {
+			  "title": "Informer resync period",
+			  "description": "Resync period of the Node informer, e.g. 30m.",
+			  "type": "string",
+			  "default": "30m"
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -0,0 +1,261 @@
+package nodehealth
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const readyCondition = string(corev1.NodeReady)
+
+// TransitionType defines the type of Node state transition.
+type TransitionType string
+
+const (
+	// ConditionStarted when a Node becomes NotReady or gets a pressure condition.
+	ConditionStarted TransitionType = "conditionStarted"
+	// ConditionResolved when a Node becomes Ready again or a pressure condition is gone.
+	ConditionResolved TransitionType = "conditionResolved"
+	// Cordoned when a Node is marked as unschedulable.
+	Cordoned TransitionType = "cordoned"
+	// Uncordoned when a Node is marked as schedulable again.
+	Uncordoned TransitionType = "uncordoned"
+	// TaintAdded when a Node gets a new taint.
+	TaintAdded TransitionType = "taintAdded"
+	// TaintRemoved when a taint is removed from a Node.
+	TaintRemoved TransitionType = "taintRemoved"
+)
+
+// Transition describes a single Node state transition.
+type Transition struct {
+	Type      TransitionType
+	Condition string
+	Reason    string
+	Message   string
+	// Duration describes how long the previous state lasted. It is set only for resolved transitions.
+	Duration time.Duration
+	Taint    *corev1.Taint
+}
+
+// IsProblem returns true if a given transition describes a Node problem.
+func (t Transition) IsProblem() bool {
+	switch t.Type {
+	case ConditionStarted, Cordoned, TaintAdded:
+		return true
+	}
+	return false
+}
+
+// Title returns a human-readable title for the transition.
+func (t Transition) Title(nodeName string) string {
+	switch t.Type {
+	case ConditionStarted:
+		if t.Condition == readyCondition {
+			return fmt.Sprintf("Node %s is NotReady", nodeName)
+		}
+		return fmt.Sprintf("Node %s has %s", nodeName, t.Condition)
+	case ConditionResolved:
+		if t.Condition == readyCondition {
+			return fmt.Sprintf("Node %s is Ready again", nodeName)
+		}
+		return fmt.Sprintf("Node %s has no %s anymore", nodeName, t.Condition)
+	case Cordoned:
+		return fmt.Sprintf("Node %s cordoned", nodeName)
+	case Uncordoned:
+		return fmt.Sprintf("Node %s uncordoned", nodeName)
+	case TaintAdded:
+		return fmt.Sprintf("Node %s tainted with %s", nodeName, t.Taint.ToString())
+	case TaintRemoved:
+		return fmt.Sprintf("Node %s taint %s removed", nodeName, t.Taint.ToString())
+	}
+	return fmt.Sprintf("Node %s changed", nodeName)
+}
+
+// detector detects Node state transitions between two Node versions.
+type detector struct {
+	cfg Config
+	now func() time.Time
+	// cordonedAt stores the time when a given Node was cordoned, as Node doesn't persist it.
+	cordonedAt map[string]time.Time
+}
+
+func newDetector(cfg Config) *detector {
+	return &detector{
+		cfg:        cfg,
+		now:        time.Now,
+		cordonedAt: make(map[string]time.Time),
+	}
+}
+
+// Detect returns all transitions between the old and the new Node version.
+func (d *detector) Detect(oldNode, newNode *corev1.Node) ([]Transition, error) {
+	var out []Transition
+	for _, condType := range d.cfg.Conditions {
+		if tr, ok := d.detectCondition(condType, oldNode, newNode); ok {
+			out = append(out, tr)
+		}
+	}
+
+	if d.cfg.Cordon.Enabled {
+		if tr, ok := d.detectCordon(oldNode, newNode); ok {
+			out = append(out, tr)
+		}
+	}
+
+	if d.cfg.Taints.Enabled {
+		taints, err := d.detectTaints(oldNode, newNode)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, taints...)
+	}
+
+	return out, nil
+}
+
+// Forget removes all data stored for a given Node.
+func (d *detector) Forget(nodeName string) {
+	delete(d.cordonedAt, nodeName)
+}
+
+func (d *detector) detectCondition(condType string, oldNode, newNode *corev1.Node) (Transition, bool) {
+	oldCond, oldFound := findCondition(oldNode, condType)
+	newCond, newFound := findCondition(newNode, condType)
+	if !newFound {
+		return Transition{}, false
+	}
+
+	wasUnhealthy := oldFound && isUnhealthy(oldCond)
+	isNowUnhealthy := isUnhealthy(newCond)
+
+	switch {
+	case !wasUnhealthy && isNowUnhealthy:
+		return Transition{
+			Type:      ConditionStarted,
+			Condition: condType,
+			Reason:    newCond.Reason,
+			Message:   newCond.Message,
+		}, true
+	case wasUnhealthy && !isNowUnhealthy:
+		return Transition{
+			Type:      ConditionResolved,
+			Condition: condType,
+			Reason:    newCond.Reason,
+			Message:   newCond.Message,
+			Duration:  newCond.LastTransitionTime.Sub(oldCond.LastTransitionTime.Time).Round(time.Second),
+		}, true
+	}
+
+	return Transition{}, false
+}
+
+func (d *detector) detectCordon(oldNode, newNode *corev1.Node) (Transition, bool) {
+	switch {
+	case !oldNode.Spec.Unschedulable && newNode.Spec.Unschedulable:
+		d.cordonedAt[newNode.Name] = d.now()
+		return Transition{Type: Cordoned}, true
+	case oldNode.Spec.Unschedulable && !newNode.Spec.Unschedulable:
+		tr := Transition{Type: Uncordoned}
+		if since, ok := d.cordonedAt[newNode.Name]; ok {
+			tr.Duration = d.now().Sub(since).Round(time.Second)
+			delete(d.cordonedAt, newNode.Name)
+		}
+		return tr, true
+	}
+
+	return Transition{}, false
+}
+
+func (d *detector) detectTaints(oldNode, newNode *corev1.Node) ([]Transition, error) {
+	var out []Transition
+
+	for i := range newNode.Spec.Taints {
+		taint := newNode.Spec.Taints[i]
+		if hasTaint(oldNode.Spec.Taints, taint) {
+			continue
+		}
+		ignored, err := d.isTaintIgnored(taint)
+		if err != nil {
+			return nil, err
+		}
+		if ignored {
+			continue
+		}
+		out = append(out, Transition{Type: TaintAdded, Taint: &taint})
+	}
+
+	for i := range oldNode.Spec.Taints {
+		taint := oldNode.Spec.Taints[i]
+		if hasTaint(newNode.Spec.Taints, taint) {
+			continue
+		}
+		ignored, err := d.isTaintIgnored(taint)
+		if err != nil {
+			return nil, err
+		}
+		if ignored {
+			continue
+		}
+		out = append(out, Transition{Type: TaintRemoved, Taint: &taint})
+	}
+
+	return out, nil
+}
+
+func (d *detector) isTaintIgnored(taint corev1.Taint) (bool, error) {
+	for _, pattern := range d.cfg.Taints.Ignore {
+		if pattern == taint.Key {
+			return true, nil
+		}
+		matched, err := regexp.MatchString(fmt.Sprintf("^%s$", pattern), taint.Key)
+		if err != nil {
+			return false, fmt.Errorf("while matching taint key %q with regex %q: %w", taint.Key, pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func hasTaint(taints []corev1.Taint, taint corev1.Taint) bool {
+	for i := range taints {
+		if taints[i].MatchTaint(&taint) && taints[i].Value == taint.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func findCondition(node *corev1.Node, condType string) (corev1.NodeCondition, bool) {
+	for _, cond := range node.Status.Conditions {
+		if string(cond.Type) == condType {
+			return cond, true
+		}
+	}
+	return corev1.NodeCondition{}, false
+}
+
+// isUnhealthy returns true if Node is not ready or has a given pressure condition.
+func isUnhealthy(cond corev1.NodeCondition) bool {
+	if string(cond.Type) == readyCondition {
+		return cond.Status != corev1.ConditionTrue
+	}
+	return cond.Status == corev1.ConditionTrue
+}
+
+// hasActiveProblems returns true if Node has any of the watched conditions or is cordoned.
+func hasActiveProblems(cfg Config, node *corev1.Node) bool {
+	if cfg.Cordon.Enabled && node.Spec.Unschedulable {
+		return true
+	}
+	for _, condType := range cfg.Conditions {
+		cond, found := findCondition(node, condType)
+		if found && isUnhealthy(cond) {
+			return true
+		}
+	}
+	return false
+}
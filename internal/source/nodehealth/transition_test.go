@@ -0,0 +1,232 @@
+package nodehealth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/pkg/ptr"
+)
+
+func TestDetector_Detect(t *testing.T) {
+	since := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		oldNode  *corev1.Node
+		newNode  *corev1.Node
+		expected []Transition
+	}{
+		{
+			name:     "No changes",
+			oldNode:  fixNode(readyCond(corev1.ConditionTrue, since)),
+			newNode:  fixNode(readyCond(corev1.ConditionTrue, since)),
+			expected: nil,
+		},
+		{
+			name:    "Node becomes NotReady",
+			oldNode: fixNode(readyCond(corev1.ConditionTrue, since)),
+			newNode: fixNode(readyCond(corev1.ConditionUnknown, since.Add(time.Hour))),
+			expected: []Transition{
+				{Type: ConditionStarted, Condition: "Ready", Reason: "NodeStatusUnknown", Message: "Kubelet stopped posting node status."},
+			},
+		},
+		{
+			name:    "Node is Ready again",
+			oldNode: fixNode(readyCond(corev1.ConditionFalse, since)),
+			newNode: fixNode(readyCond(corev1.ConditionTrue, since.Add(5*time.Minute+12*time.Second))),
+			expected: []Transition{
+				{Type: ConditionResolved, Condition: "Ready", Reason: "KubeletReady", Message: "kubelet is posting ready status", Duration: 5*time.Minute + 12*time.Second},
+			},
+		},
+		{
+			name:    "Node gets memory pressure",
+			oldNode: fixNode(readyCond(corev1.ConditionTrue, since), pressureCond("MemoryPressure", corev1.ConditionFalse, since)),
+			newNode: fixNode(readyCond(corev1.ConditionTrue, since), pressureCond("MemoryPressure", corev1.ConditionTrue, since.Add(time.Minute))),
+			expected: []Transition{
+				{Type: ConditionStarted, Condition: "MemoryPressure", Reason: "KubeletHasInsufficientMemory"},
+			},
+		},
+		{
+			name:    "Ignored taint is skipped",
+			oldNode: fixNode(readyCond(corev1.ConditionTrue, since)),
+			newNode: fixNode(readyCond(corev1.ConditionTrue, since), withTaints(corev1.Taint{
+				Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoSchedule,
+			})),
+			expected: nil,
+		},
+		{
+			name:    "Unexpected taint added",
+			oldNode: fixNode(readyCond(corev1.ConditionTrue, since)),
+			newNode: fixNode(readyCond(corev1.ConditionTrue, since), withTaints(corev1.Taint{
+				Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute,
+			})),
+			expected: []Transition{
+				{Type: TaintAdded, Taint: &corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}},
+			},
+		},
+		{
+			name: "Taint removed",
+			oldNode: fixNode(readyCond(corev1.ConditionTrue, since), withTaints(corev1.Taint{
+				Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute,
+			})),
+			newNode: fixNode(readyCond(corev1.ConditionTrue, since)),
+			expected: []Transition{
+				{Type: TaintRemoved, Taint: &corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg, err := MergeConfigs(nil)
+			require.NoError(t, err)
+			d := newDetector(cfg)
+
+			// when
+			actual, err := d.Detect(tc.oldNode, tc.newNode)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestDetector_Detect_Cordon(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	d := newDetector(cfg)
+	d.now = func() time.Time { return now }
+
+	schedulable := fixNode()
+	cordoned := fixNode(func(n *corev1.Node) { n.Spec.Unschedulable = true })
+
+	// when
+	actual, err := d.Detect(schedulable, cordoned)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Transition{{Type: Cordoned}}, actual)
+	assert.Equal(t, "Node node-1 cordoned", actual[0].Title("node-1"))
+
+	// given
+	now = now.Add(42 * time.Minute)
+
+	// when
+	actual, err = d.Detect(cordoned, schedulable)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Transition{{Type: Uncordoned, Duration: 42 * time.Minute}}, actual)
+	assert.False(t, actual[0].IsProblem())
+}
+
+func TestWorkloadTracker_Evicted(t *testing.T) {
+	// given
+	ctx := context.Background()
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7c9f",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{controllerRef("Deployment", "web")},
+		},
+	}
+	webPod := fixPod("web-7c9f-abc", "node-1", controllerRef("ReplicaSet", "web-7c9f"))
+	dbPod := fixPod("db-0", "node-1", controllerRef("StatefulSet", "db"))
+	barePod := fixPod("debug", "node-1")
+	otherNodePod := fixPod("other", "node-2")
+
+	cli := fake.NewSimpleClientset(rs, webPod, dbPod, barePod, otherNodePod)
+	tracker := newWorkloadTracker(cli)
+
+	// when
+	err := tracker.Snapshot(ctx, "node-1")
+	require.NoError(t, err)
+
+	require.NoError(t, cli.CoreV1().Pods("default").Delete(ctx, webPod.Name, metav1.DeleteOptions{}))
+	dbPod.Status.Reason = evictedPodReason
+	_, err = cli.CoreV1().Pods("default").UpdateStatus(ctx, dbPod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	evicted, err := tracker.Evicted(ctx, "node-1")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Deployment default/web", "StatefulSet default/db"}, evicted)
+}
+
+func fixNode(mutators ...func(*corev1.Node)) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+	}
+	for _, mutate := range mutators {
+		mutate(node)
+	}
+	return node
+}
+
+func readyCond(status corev1.ConditionStatus, since time.Time) func(*corev1.Node) {
+	cond := corev1.NodeCondition{
+		Type:               corev1.NodeReady,
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(since),
+	}
+	switch status {
+	case corev1.ConditionTrue:
+		cond.Reason, cond.Message = "KubeletReady", "kubelet is posting ready status"
+	case corev1.ConditionUnknown:
+		cond.Reason, cond.Message = "NodeStatusUnknown", "Kubelet stopped posting node status."
+	default:
+		cond.Reason, cond.Message = "KubeletNotReady", "container runtime is down"
+	}
+	return func(n *corev1.Node) {
+		n.Status.Conditions = append(n.Status.Conditions, cond)
+	}
+}
+
+func pressureCond(condType corev1.NodeConditionType, status corev1.ConditionStatus, since time.Time) func(*corev1.Node) {
+	cond := corev1.NodeCondition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: metav1.NewTime(since),
+	}
+	if status == corev1.ConditionTrue {
+		cond.Reason = "KubeletHasInsufficientMemory"
+	}
+	return func(n *corev1.Node) {
+		n.Status.Conditions = append(n.Status.Conditions, cond)
+	}
+}
+
+func withTaints(taints ...corev1.Taint) func(*corev1.Node) {
+	return func(n *corev1.Node) {
+		n.Spec.Taints = append(n.Spec.Taints, taints...)
+	}
+}
+
+func fixPod(name, nodeName string, owners ...metav1.OwnerReference) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			UID:             types.UID("uid-" + name),
+			OwnerReferences: owners,
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func controllerRef(kind, name string) metav1.OwnerReference {
+	return metav1.OwnerReference{Kind: kind, Name: name, Controller: ptr.Bool(true)}
+}
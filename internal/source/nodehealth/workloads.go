@@ -0,0 +1,153 @@
+package nodehealth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+const evictedPodReason = "Evicted"
+
+// workloadTracker tracks Pods scheduled on unhealthy Nodes to report evicted workloads once a given Node is healthy again.
+type workloadTracker struct {
+	cli kubernetes.Interface
+
+	mu sync.Mutex
+	// snapshots contains Pods running on a given Node when the first problem started, indexed by Pod UID.
+	snapshots map[string]map[string]corev1.Pod
+}
+
+func newWorkloadTracker(cli kubernetes.Interface) *workloadTracker {
+	return &workloadTracker{
+		cli:       cli,
+		snapshots: make(map[string]map[string]corev1.Pod),
+	}
+}
+
+// Snapshot stores Pods running on a given Node. It's a no-op if a snapshot for a given Node already exists.
+func (w *workloadTracker) Snapshot(ctx context.Context, nodeName string) error {
+	w.mu.Lock()
+	_, exists := w.snapshots[nodeName]
+	w.mu.Unlock()
+	if exists {
+		return nil
+	}
+
+	pods, err := w.listPods(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	snapshot := make(map[string]corev1.Pod, len(pods))
+	for _, pod := range pods {
+		snapshot[string(pod.UID)] = pod
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.snapshots[nodeName] = snapshot
+	return nil
+}
+
+// Evicted returns workloads which Pods were evicted from a given Node since the snapshot was taken.
+// The snapshot is removed afterwards.
+func (w *workloadTracker) Evicted(ctx context.Context, nodeName string) ([]string, error) {
+	w.mu.Lock()
+	snapshot, exists := w.snapshots[nodeName]
+	delete(w.snapshots, nodeName)
+	w.mu.Unlock()
+
+	pods, err := w.listPods(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var evicted []corev1.Pod
+	current := make(map[string]struct{}, len(pods))
+	for _, pod := range pods {
+		current[string(pod.UID)] = struct{}{}
+		if pod.Status.Reason == evictedPodReason {
+			evicted = append(evicted, pod)
+		}
+	}
+	if exists {
+		for uid, pod := range snapshot {
+			if _, found := current[uid]; found {
+				continue
+			}
+			evicted = append(evicted, pod)
+		}
+	}
+
+	return w.workloadsForPods(ctx, evicted)
+}
+
+// Forget removes the snapshot for a given Node.
+func (w *workloadTracker) Forget(nodeName string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.snapshots, nodeName)
+}
+
+func (w *workloadTracker) listPods(ctx context.Context, nodeName string) ([]corev1.Pod, error) {
+	list, err := w.cli.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while listing Pods for Node %q: %w", nodeName, err)
+	}
+	return list.Items, nil
+}
+
+// workloadsForPods returns sorted and deduplicated workloads in the "Kind namespace/name" form.
+func (w *workloadTracker) workloadsForPods(ctx context.Context, pods []corev1.Pod) ([]string, error) {
+	set := map[string]struct{}{}
+	for i := range pods {
+		workload, err := w.workloadForPod(ctx, &pods[i])
+		if err != nil {
+			return nil, err
+		}
+		set[workload] = struct{}{}
+	}
+
+	var out []string
+	for workload := range set {
+		out = append(out, workload)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func (w *workloadTracker) workloadForPod(ctx context.Context, pod *corev1.Pod) (string, error) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return workloadName("Pod", pod.Namespace, pod.Name), nil
+	}
+	if ref.Kind != "ReplicaSet" {
+		return workloadName(ref.Kind, pod.Namespace, ref.Name), nil
+	}
+
+	rs, err := w.cli.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return workloadName(ref.Kind, pod.Namespace, ref.Name), nil
+	case err != nil:
+		return "", fmt.Errorf("while getting ReplicaSet %q: %w", ref.Name, err)
+	}
+
+	if owner := metav1.GetControllerOf(rs); owner != nil {
+		return workloadName(owner.Kind, pod.Namespace, owner.Name), nil
+	}
+	return workloadName(ref.Kind, pod.Namespace, ref.Name), nil
+}
+
+func workloadName(kind, namespace, name string) string {
+	return fmt.Sprintf("%s %s/%s", kind, namespace, name)
+}
@@ -8,6 +8,7 @@ import (
 
 	promClient "github.com/prometheus/client_golang/api"
 	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	promConfig "github.com/prometheus/common/config"
 )
 
 // Client prometheus client
@@ -42,9 +43,37 @@ func (a *alert) IsValid(request GetAlertsRequest) bool {
 }
 
 // NewClient initializes Prometheus client
-func NewClient(url string) (*Client, error) {
+func NewClient(url string, auth Auth, tls TLS) (*Client, error) {
+	httpCfg := promConfig.HTTPClientConfig{
+		TLSConfig: promConfig.TLSConfig{
+			CAFile:             tls.CAFile,
+			CertFile:           tls.CertFile,
+			KeyFile:            tls.KeyFile,
+			InsecureSkipVerify: tls.InsecureSkipVerify,
+		},
+		FollowRedirects: true,
+	}
+	if auth.Username != "" {
+		httpCfg.BasicAuth = &promConfig.BasicAuth{
+			Username: auth.Username,
+			Password: promConfig.Secret(auth.Password),
+		}
+	}
+	if auth.BearerToken != "" {
+		httpCfg.BearerToken = promConfig.Secret(auth.BearerToken)
+	}
+	if err := httpCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("while validating HTTP client configuration: %w", err)
+	}
+
+	rt, err := promConfig.NewRoundTripperFromConfig(httpCfg, PluginName)
+	if err != nil {
+		return nil, fmt.Errorf("while creating HTTP round tripper: %w", err)
+	}
+
 	c, err := promClient.NewClient(promClient.Config{
-		Address: url,
+		Address:      url,
+		RoundTripper: rt,
 	})
 
 	if err != nil {
@@ -1,7 +1,9 @@
 package prometheus
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
 
@@ -11,19 +13,112 @@ import (
 	"github.com/kubeshop/botkube/pkg/ptr"
 )
 
+// Mode defines how the alerts are received.
+type Mode string
+
+const (
+	// PollMode polls alerts from the Prometheus API.
+	PollMode Mode = "poll"
+	// ReceiverMode exposes an HTTP endpoint which accepts the Alertmanager webhook payload.
+	ReceiverMode Mode = "receiver"
+)
+
+// Level defines the notification level of an alert.
+type Level string
+
+const (
+	// LevelInfo is the info level.
+	LevelInfo Level = "info"
+	// LevelWarning is the warning level.
+	LevelWarning Level = "warning"
+	// LevelError is the error level.
+	LevelError Level = "error"
+	// LevelSuccess is the level of resolved alerts.
+	LevelSuccess Level = "success"
+)
+
 // Config prometheus configuration
 type Config struct {
+	Mode            Mode                 `yaml:"mode,omitempty"`
 	URL             string               `yaml:"url,omitempty"`
+	PollInterval    time.Duration        `yaml:"pollInterval,omitempty"`
 	AlertStates     []promApi.AlertState `yaml:"alertStates,omitempty"`
 	IgnoreOldAlerts *bool                `yaml:"ignoreOldAlerts,omitempty"`
+	Auth            Auth                 `yaml:"auth,omitempty"`
+	TLS             TLS                  `yaml:"tls,omitempty"`
+	Filters         Filters              `yaml:"filters,omitempty"`
+	Severity        Severity             `yaml:"severity,omitempty"`
+	Templates       Templates            `yaml:"templates,omitempty"`
+	Receiver        Receiver             `yaml:"receiver,omitempty"`
 	Log             config.Logger        `yaml:"log"`
 }
 
+// Auth contains credentials used for the Prometheus API calls.
+type Auth struct {
+	Username    string `yaml:"username,omitempty"`
+	Password    string `yaml:"password,omitempty"`
+	BearerToken string `yaml:"bearerToken,omitempty"`
+}
+
+// TLS contains TLS configuration of the Prometheus client.
+type TLS struct {
+	CAFile             string `yaml:"caFile,omitempty"`
+	CertFile           string `yaml:"certFile,omitempty"`
+	KeyFile            string `yaml:"keyFile,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+// Filters contains label matchers which select alerts sent as notifications.
+// Matchers use the Alertmanager format, such as `severity=~"critical|warning"` or `namespace!="kube-system"`.
+type Filters struct {
+	// Include matchers must all match for an alert to be sent. If empty, all alerts are included.
+	Include []string `yaml:"include,omitempty"`
+	// Exclude matchers drop an alert if any of them matches.
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// Severity maps values of the severity label to notification levels.
+type Severity struct {
+	Label  string           `yaml:"label,omitempty"`
+	Levels map[string]Level `yaml:"levels,omitempty"`
+}
+
+// Templates contains Go templates of the notification title and body.
+// If not specified, the built-in layout is used.
+type Templates struct {
+	Title string `yaml:"title,omitempty"`
+	Body  string `yaml:"body,omitempty"`
+}
+
+// Receiver contains configuration of the Alertmanager webhook receiver.
+// Requests must be authenticated with either the bearer token or the basic auth credentials.
+type Receiver struct {
+	Port        int    `yaml:"port,omitempty"`
+	Path        string `yaml:"path,omitempty"`
+	BearerToken string `yaml:"bearerToken,omitempty"`
+	Username    string `yaml:"username,omitempty"`
+	Password    string `yaml:"password,omitempty"`
+}
+
 // MergeConfigs merges all input configuration.
 func MergeConfigs(configs []*source.Config) (Config, error) {
 	defaults := Config{
+		Mode:            PollMode,
+		PollInterval:    5 * time.Second,
 		AlertStates:     []promApi.AlertState{promApi.AlertStateFiring, promApi.AlertStatePending, promApi.AlertStateInactive},
 		IgnoreOldAlerts: ptr.Bool(true),
+		Severity: Severity{
+			Label: "severity",
+			Levels: map[string]Level{
+				"critical": LevelError,
+				"warning":  LevelWarning,
+				"info":     LevelInfo,
+			},
+		},
+		Receiver: Receiver{
+			Port: 2115,
+			Path: "/alertmanager",
+		},
 	}
 
 	var out Config
@@ -33,3 +128,54 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 
 	return out, nil
 }
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	switch c.Mode {
+	case PollMode:
+		if c.URL == "" {
+			return fmt.Errorf("the url property is required in %q mode", c.Mode)
+		}
+		if c.PollInterval <= 0 {
+			return fmt.Errorf("the poll interval must be positive, got %s", c.PollInterval)
+		}
+	case ReceiverMode:
+		if c.Receiver.Port <= 0 {
+			return fmt.Errorf("invalid receiver port %d", c.Receiver.Port)
+		}
+		if err := c.Receiver.validateAuth(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, allowed values: %q, %q", c.Mode, PollMode, ReceiverMode)
+	}
+
+	if c.Auth.Username != "" && c.Auth.BearerToken != "" {
+		return errors.New("the basic auth and bearer token are mutually exclusive")
+	}
+	if _, err := NewAlertFilter(c.Filters); err != nil {
+		return err
+	}
+	for severity, level := range c.Severity.Levels {
+		if _, found := emojiForLevel[level]; !found {
+			return fmt.Errorf("unknown level %q for severity %q", level, severity)
+		}
+	}
+	if _, err := parseTemplates(c.Templates); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r Receiver) validateAuth() error {
+	hasBasicAuth := r.Username != "" || r.Password != ""
+	switch {
+	case r.BearerToken != "" && hasBasicAuth:
+		return errors.New("the receiver bearer token and basic auth are mutually exclusive")
+	case r.BearerToken == "" && !hasBasicAuth:
+		return errors.New("the receiver requires either the bearer token or the basic auth credentials")
+	case hasBasicAuth && (r.Username == "" || r.Password == ""):
+		return errors.New("the receiver basic auth requires both username and password")
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package prometheus
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kubeshop/botkube/internal/executor/alertmanager"
+)
+
+// labelMatcher matches a single alert label. Regular expressions are compiled once.
+type labelMatcher struct {
+	alertmanager.Matcher
+
+	re *regexp.Regexp
+}
+
+// newLabelMatcher parses matcher in the Alertmanager format, such as `namespace="default"` or `severity=~"warning|critical"`.
+func newLabelMatcher(in string) (labelMatcher, error) {
+	m, err := alertmanager.ParseMatcher(in)
+	if err != nil {
+		return labelMatcher{}, err
+	}
+
+	out := labelMatcher{Matcher: m}
+	if m.IsRegex {
+		// regular expressions are fully anchored, as in Alertmanager
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", m.Value))
+		if err != nil {
+			return labelMatcher{}, fmt.Errorf("invalid matcher %q: %w", in, err)
+		}
+		out.re = re
+	}
+	return out, nil
+}
+
+// Matches returns true if a given label set matches. A missing label has an empty value.
+func (m labelMatcher) Matches(labels map[string]string) bool {
+	val := labels[m.Name]
+
+	var matches bool
+	if m.IsRegex {
+		matches = m.re.MatchString(val)
+	} else {
+		matches = val == m.Value
+	}
+	return matches == m.IsEqual
+}
+
+// AlertFilter selects alerts based on their labels.
+type AlertFilter struct {
+	include []labelMatcher
+	exclude []labelMatcher
+}
+
+// NewAlertFilter returns a new AlertFilter instance. It returns an error if any of the matchers is invalid.
+func NewAlertFilter(cfg Filters) (*AlertFilter, error) {
+	include, err := parseLabelMatchers(cfg.Include)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing include filters: %w", err)
+	}
+	exclude, err := parseLabelMatchers(cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing exclude filters: %w", err)
+	}
+
+	return &AlertFilter{
+		include: include,
+		exclude: exclude,
+	}, nil
+}
+
+// IsAllowed returns true if all include matchers and none of the exclude matchers match given labels.
+func (f *AlertFilter) IsAllowed(labels map[string]string) bool {
+	for _, m := range f.include {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	for _, m := range f.exclude {
+		if m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseLabelMatchers(in []string) ([]labelMatcher, error) {
+	var out []labelMatcher
+	for _, item := range in {
+		m, err := newLabelMatcher(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
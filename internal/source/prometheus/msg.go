@@ -0,0 +1,249 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	sprig "github.com/go-task/slim-sprig"
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const (
+	alertmanagerPluginName = "alertmanager"
+	firingEmoji            = "🔥"
+)
+
+var emojiForLevel = map[Level]string{
+	LevelInfo:    "💡",
+	LevelWarning: "⚠️",
+	LevelError:   "❗",
+	LevelSuccess: "✅",
+}
+
+// TemplateData holds data available in the title and body templates.
+type TemplateData struct {
+	// Name is the alert name.
+	Name string
+	// State is the alert state, such as firing, pending, inactive or resolved.
+	State       string
+	Labels      map[string]string
+	Annotations map[string]string
+	StartsAt    time.Time
+	// Value is the alert value. It's available only in the poll mode.
+	Value        string
+	GeneratorURL string
+	// Alerts holds all alerts of the alert group. It's available only in the receiver mode, where Labels and Annotations are common for all alerts in the group.
+	Alerts []TemplateData
+}
+
+type parsedTemplates struct {
+	title *template.Template
+	body  *template.Template
+}
+
+func parseTemplates(cfg Templates) (parsedTemplates, error) {
+	var (
+		out parsedTemplates
+		err error
+	)
+	if cfg.Title != "" {
+		out.title, err = template.New("title").Funcs(sprig.TxtFuncMap()).Parse(cfg.Title)
+		if err != nil {
+			return parsedTemplates{}, fmt.Errorf("while parsing title template: %w", err)
+		}
+	}
+	if cfg.Body != "" {
+		out.body, err = template.New("body").Funcs(sprig.TxtFuncMap()).Parse(cfg.Body)
+		if err != nil {
+			return parsedTemplates{}, fmt.Errorf("while parsing body template: %w", err)
+		}
+	}
+	return out, nil
+}
+
+// MessageBuilder builds notifications for Prometheus alerts, based on the configured filters, severity levels and templates.
+type MessageBuilder struct {
+	log                      logrus.FieldLogger
+	filter                   *AlertFilter
+	severity                 Severity
+	templates                parsedTemplates
+	isInteractivitySupported bool
+}
+
+// NewMessageBuilder returns a new MessageBuilder instance.
+func NewMessageBuilder(log logrus.FieldLogger, cfg Config, isInteractivitySupported bool) (*MessageBuilder, error) {
+	filter, err := NewAlertFilter(cfg.Filters)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := parseTemplates(cfg.Templates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageBuilder{
+		log:                      log,
+		filter:                   filter,
+		severity:                 cfg.Severity,
+		templates:                templates,
+		isInteractivitySupported: isInteractivitySupported,
+	}, nil
+}
+
+// ForAlert returns a notification for an alert polled from Prometheus. It returns false if the alert is filtered out.
+func (b *MessageBuilder) ForAlert(alert alert) (api.Message, bool) {
+	labels := alertLabels(alert.Labels)
+	if !b.filter.IsAllowed(labels) {
+		return api.Message{}, false
+	}
+
+	name := labels[model.AlertNameLabel]
+	header := fmt.Sprintf("%s %s", firingEmoji, name)
+	if alert.State == promApi.AlertStateInactive {
+		header = fmt.Sprintf("%s %s", emojiForLevel[LevelSuccess], name)
+	} else if level, found := b.levelFor(labels); found {
+		header = fmt.Sprintf("%s %s", emojiForLevel[level], name)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: header,
+		},
+		TextFields: []api.TextField{
+			{Key: "Source", Value: PluginName},
+			{Key: "Alert Name", Value: name},
+			{Key: "State", Value: string(alert.State)},
+		},
+		BulletLists: []api.BulletList{
+			{
+				Title: "Description",
+				Items: []string{
+					string(alert.Annotations["description"]),
+				},
+			},
+		},
+	}
+	b.applyTemplates(&section, TemplateData{
+		Name:        name,
+		State:       string(alert.State),
+		Labels:      labels,
+		Annotations: alertLabels(alert.Annotations),
+		StartsAt:    alert.ActiveAt,
+		Value:       alert.Value,
+	})
+
+	msg := api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}
+	if alert.State == promApi.AlertStateInactive {
+		return msg, true
+	}
+	return withSilenceSection(msg, labels, b.isInteractivitySupported), true
+}
+
+// applyTemplates replaces the header and the description of a given section with the rendered templates.
+// The built-in layout is kept if a template fails to render.
+func (b *MessageBuilder) applyTemplates(section *api.Section, data TemplateData) {
+	if b.templates.title != nil {
+		title, err := renderTemplate(b.templates.title, data)
+		if err != nil {
+			b.log.Errorf("while rendering title template: %s", err.Error())
+		} else {
+			section.Header = title
+		}
+	}
+	if b.templates.body != nil {
+		body, err := renderTemplate(b.templates.body, data)
+		if err != nil {
+			b.log.Errorf("while rendering body template: %s", err.Error())
+			return
+		}
+		section.Description = body
+		section.BulletLists = removeBulletList(section.BulletLists, "Description")
+	}
+}
+
+// levelFor returns the level mapped to the severity label of a given alert.
+func (b *MessageBuilder) levelFor(labels map[string]string) (Level, bool) {
+	level, found := b.severity.Levels[labels[b.severity.Label]]
+	return level, found
+}
+
+func renderTemplate(tpl *template.Template, data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func removeBulletList(in []api.BulletList, title string) []api.BulletList {
+	var out []api.BulletList
+	for _, list := range in {
+		if list.Title == title {
+			continue
+		}
+		out = append(out, list)
+	}
+	return out
+}
+
+// withSilenceSection adds buttons which silence alerts with given labels in Alertmanager, if the interactivity is supported.
+func withSilenceSection(msg api.Message, labels map[string]string, isInteractivitySupported bool) api.Message {
+	if !isInteractivitySupported || len(labels) == 0 {
+		msg.Type = api.NonInteractiveSingleSection
+		return msg
+	}
+
+	msg.Type = api.DefaultMessage
+	msg.Sections = append(msg.Sections, silenceSection(labels))
+	return msg
+}
+
+func silenceSection(labels map[string]string) api.Section {
+	cmd := fmt.Sprintf("%s silence create %s", alertmanagerPluginName, silenceMatchers(labels))
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	return api.Section{
+		Buttons: api.Buttons{
+			btnBuilder.ForCommandWithoutDesc("Silence 1h", fmt.Sprintf("%s --duration 1h", cmd)),
+			btnBuilder.ForCommandWithoutDesc("Silence 4h", fmt.Sprintf("%s --duration 4h", cmd)),
+		},
+		PlaintextInputs: api.LabelInputs{
+			{
+				Command:          fmt.Sprintf("%s %s --duration ", api.MessageBotNamePlaceholder, cmd),
+				Text:             "Silence for a custom duration",
+				Placeholder:      "Duration, such as 30m, 12h or 2d",
+				DispatchedAction: api.DispatchInputActionOnEnter,
+			},
+		},
+	}
+}
+
+// silenceMatchers returns sorted equality matchers for all given labels, such as `alertname="KubePodCrashLooping" namespace="default"`.
+func silenceMatchers(labels map[string]string) string {
+	out := make([]string, 0, len(labels))
+	for key, val := range labels {
+		out = append(out, fmt.Sprintf("%s=%q", key, val))
+	}
+	sort.Strings(out)
+	return strings.Join(out, " ")
+}
+
+func alertLabels(labels model.LabelSet) map[string]string {
+	out := make(map[string]string, len(labels))
+	for key, val := range labels {
+		out[string(key)] = string(val)
+	}
+	return out
+}
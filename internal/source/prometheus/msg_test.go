@@ -0,0 +1,275 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestMessageBuilder_ForAlert(t *testing.T) {
+	tests := []struct {
+		name                string
+		cfg                 Config
+		alert               alert
+		expectedFiltered    bool
+		expectedHeader      string
+		expectedDescription string
+		expectedLists       int
+	}{
+		{
+			name:           "Default layout",
+			cfg:            Config{},
+			alert:          fixAlert(promApi.AlertStateFiring, "critical", "default"),
+			expectedHeader: "🔥 KubePodCrashLooping",
+			expectedLists:  1,
+		},
+		{
+			name:           "Severity mapped to level",
+			cfg:            Config{Severity: Severity{Label: "severity", Levels: map[string]Level{"critical": LevelError}}},
+			alert:          fixAlert(promApi.AlertStateFiring, "critical", "default"),
+			expectedHeader: "❗ KubePodCrashLooping",
+			expectedLists:  1,
+		},
+		{
+			name:           "Inactive alert",
+			cfg:            Config{Severity: Severity{Label: "severity", Levels: map[string]Level{"critical": LevelError}}},
+			alert:          fixAlert(promApi.AlertStateInactive, "critical", "default"),
+			expectedHeader: "✅ KubePodCrashLooping",
+			expectedLists:  1,
+		},
+		{
+			name:             "Excluded namespace",
+			cfg:              Config{Filters: Filters{Exclude: []string{`namespace="kube-system"`}}},
+			alert:            fixAlert(promApi.AlertStateFiring, "critical", "kube-system"),
+			expectedFiltered: true,
+		},
+		{
+			name:             "Not included severity",
+			cfg:              Config{Filters: Filters{Include: []string{`severity=~"critical|warning"`}}},
+			alert:            fixAlert(promApi.AlertStateFiring, "info", "default"),
+			expectedFiltered: true,
+		},
+		{
+			name:           "Included severity",
+			cfg:            Config{Filters: Filters{Include: []string{`severity=~"critical|warning"`}, Exclude: []string{`namespace="kube-system"`}}},
+			alert:          fixAlert(promApi.AlertStateFiring, "warning", "default"),
+			expectedHeader: "🔥 KubePodCrashLooping",
+			expectedLists:  1,
+		},
+		{
+			name: "Custom templates",
+			cfg: Config{Templates: Templates{
+				Title: `{{ .Name }} in {{ .Labels.namespace }}`,
+				Body:  `{{ .Annotations.description | upper }} ({{ .State }}, value {{ .Value }})`,
+			}},
+			alert:               fixAlert(promApi.AlertStateFiring, "critical", "default"),
+			expectedHeader:      "KubePodCrashLooping in default",
+			expectedDescription: "POD IS CRASH LOOPING. (firing, value 1e+00)",
+		},
+		{
+			name:           "Failed template keeps the built-in layout",
+			cfg:            Config{Templates: Templates{Title: `{{ .Labels.namespace | fail }}`}},
+			alert:          fixAlert(promApi.AlertStateFiring, "critical", "default"),
+			expectedHeader: "🔥 KubePodCrashLooping",
+			expectedLists:  1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			builder := fixMessageBuilder(t, tc.cfg, false)
+
+			// when
+			msg, ok := builder.ForAlert(tc.alert)
+
+			// then
+			if tc.expectedFiltered {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Len(t, msg.Sections, 1)
+			section := msg.Sections[0]
+			assert.Equal(t, tc.expectedHeader, section.Header)
+			assert.Equal(t, tc.expectedDescription, section.Description)
+			assert.Len(t, section.BulletLists, tc.expectedLists)
+		})
+	}
+}
+
+func TestMessageBuilder_ForAlertGroup(t *testing.T) {
+	// given
+	var payload WebhookMessage
+	require.NoError(t, json.Unmarshal([]byte(firingPayload), &payload))
+
+	builder := fixMessageBuilder(t, Config{
+		Filters: Filters{Exclude: []string{`pod="nginx-2"`}},
+		Severity: Severity{
+			Label:  "severity",
+			Levels: map[string]Level{"warning": LevelWarning},
+		},
+		Templates: Templates{
+			Body: `{{ range .Alerts }}{{ .Labels.pod }} {{ end }}`,
+		},
+	}, false)
+
+	// when
+	filtered, ok := builder.FilterAlertGroup(payload)
+	msg := builder.ForAlertGroup(filtered)
+
+	// then
+	require.True(t, ok)
+	require.Len(t, filtered.Alerts, 1)
+	require.Len(t, msg.Sections, 1)
+	section := msg.Sections[0]
+	assert.Equal(t, "⚠️ [FIRING:1] KubePodCrashLooping", section.Header)
+	assert.Equal(t, "nginx-1", section.Description)
+	require.Len(t, section.BulletLists, 1)
+	assert.Equal(t, "Alerts", section.BulletLists[0].Title)
+	assert.Len(t, section.BulletLists[0].Items, 1)
+
+	// when
+	builder = fixMessageBuilder(t, Config{Filters: Filters{Exclude: []string{`pod=~"nginx-.*"`}}}, false)
+	_, ok = builder.FilterAlertGroup(payload)
+
+	// then
+	assert.False(t, ok)
+}
+
+func TestMessageBuilder_FilterAlertGroup(t *testing.T) {
+	// given
+	payload := WebhookMessage{
+		Status:            alertStatusFiring,
+		CommonLabels:      map[string]string{"alertname": "HighMemory"},
+		CommonAnnotations: map[string]string{},
+		Alerts: []WebhookAlert{
+			{
+				Status:      alertStatusFiring,
+				Labels:      map[string]string{"alertname": "HighMemory", "node": "node-1", "env": "dev"},
+				Annotations: map[string]string{"summary": "Memory usage is high on node-1."},
+			},
+			{
+				Status:      alertStatusResolved,
+				Labels:      map[string]string{"alertname": "HighMemory", "node": "node-2", "env": "prod"},
+				Annotations: map[string]string{"summary": "Memory usage is high on node-2.", "runbook": "https://runbooks/memory"},
+			},
+			{
+				Status:      alertStatusResolved,
+				Labels:      map[string]string{"alertname": "HighMemory", "node": "node-3", "env": "prod"},
+				Annotations: map[string]string{"summary": "Memory usage is high on node-3.", "runbook": "https://runbooks/memory"},
+			},
+		},
+	}
+	builder := fixMessageBuilder(t, Config{Filters: Filters{Include: []string{`env="prod"`}}}, true)
+
+	// when
+	filtered, ok := builder.FilterAlertGroup(payload)
+
+	// then
+	require.True(t, ok)
+	assert.Len(t, filtered.Alerts, 2)
+	assert.Equal(t, alertStatusResolved, filtered.Status)
+	assert.Equal(t, map[string]string{"alertname": "HighMemory", "env": "prod"}, filtered.CommonLabels)
+	assert.Equal(t, map[string]string{"runbook": "https://runbooks/memory"}, filtered.CommonAnnotations)
+
+	msg := builder.ForAlertGroup(filtered)
+	require.Len(t, msg.Sections, 1, "resolved group shouldn't have the silence section")
+	assert.Equal(t, "✅ [RESOLVED] HighMemory", msg.Sections[0].Header)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectedErr string
+	}{
+		{
+			name:        "Invalid matcher",
+			cfg:         Config{Filters: Filters{Include: []string{"severity"}}},
+			expectedErr: `while parsing include filters: invalid matcher "severity"`,
+		},
+		{
+			name:        "Invalid regex matcher",
+			cfg:         Config{Filters: Filters{Exclude: []string{`pod=~"nginx-("`}}},
+			expectedErr: "while parsing exclude filters",
+		},
+		{
+			name:        "Unknown level",
+			cfg:         Config{Severity: Severity{Levels: map[string]Level{"critical": "panic"}}},
+			expectedErr: `unknown level "panic" for severity "critical"`,
+		},
+		{
+			name:        "Invalid template",
+			cfg:         Config{Templates: Templates{Title: "{{ .Name "}},
+			expectedErr: "while parsing title template",
+		},
+		{
+			name:        "Basic auth and bearer token",
+			cfg:         Config{Auth: Auth{Username: "admin", BearerToken: "token"}},
+			expectedErr: "the basic auth and bearer token are mutually exclusive",
+		},
+		{
+			name:        "Receiver without credentials",
+			cfg:         Config{Mode: ReceiverMode, Receiver: Receiver{Port: 2115, Path: "/alertmanager"}},
+			expectedErr: "the receiver requires either the bearer token or the basic auth credentials",
+		},
+		{
+			name:        "Receiver basic auth without password",
+			cfg:         Config{Mode: ReceiverMode, Receiver: Receiver{Port: 2115, Path: "/alertmanager", Username: "alertmanager"}},
+			expectedErr: "the receiver basic auth requires both username and password",
+		},
+		{
+			name:        "Non-positive poll interval",
+			cfg:         Config{Mode: PollMode, URL: "http://prometheus:9090"},
+			expectedErr: "the poll interval must be positive, got 0s",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg := tc.cfg
+			if cfg.Mode == "" {
+				cfg.Mode = ReceiverMode
+				cfg.Receiver = fixReceiverConfig()
+			}
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func fixMessageBuilder(t *testing.T, cfg Config, isInteractivitySupported bool) *MessageBuilder {
+	t.Helper()
+
+	builder, err := NewMessageBuilder(loggerx.NewNoop(), cfg, isInteractivitySupported)
+	require.NoError(t, err)
+	return builder
+}
+
+func fixAlert(state promApi.AlertState, severity, namespace string) alert {
+	return alert{
+		ActiveAt: time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+		Labels: model.LabelSet{
+			"alertname": "KubePodCrashLooping",
+			"severity":  model.LabelValue(severity),
+			"namespace": model.LabelValue(namespace),
+		},
+		Annotations: model.LabelSet{
+			"description": "Pod is crash looping.",
+		},
+		State: state,
+		Value: "1e+00",
+	}
+}
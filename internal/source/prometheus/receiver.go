@@ -0,0 +1,387 @@
+package prometheus
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	alertStatusFiring   = "firing"
+	alertStatusResolved = "resolved"
+
+	maxWebhookPayloadSize = 10 << 20
+)
+
+// WebhookMessage is the payload sent by the Alertmanager webhook receiver.
+// See https://prometheus.io/docs/alerting/latest/configuration/#webhook_config.
+type WebhookMessage struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []WebhookAlert    `json:"alerts"`
+}
+
+// WebhookAlert describes a single alert in the Alertmanager webhook payload.
+type WebhookAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// receiverRouter dispatches the Alertmanager webhook requests to all streams subscribed to a given path.
+// The same path can be streamed multiple times, e.g. for interactive and non-interactive platforms.
+// Each stream renders the alert group with its own message builder, as filters and templates may differ.
+type receiverRouter struct {
+	log logrus.FieldLogger
+
+	mu     sync.RWMutex
+	routes map[string]*receiverRoute
+}
+
+type receiverRoute struct {
+	cfg         Receiver
+	subscribers map[chan<- source.Event]receiverSubscriber
+}
+
+type receiverSubscriber struct {
+	ctx        context.Context
+	msgBuilder *MessageBuilder
+}
+
+func newReceiverRouter(log logrus.FieldLogger) *receiverRouter {
+	return &receiverRouter{
+		log:    log,
+		routes: map[string]*receiverRoute{},
+	}
+}
+
+// Subscribe registers a given receiver path and sends its events to a given channel until the context is canceled.
+// It returns an error if the path is already registered with different credentials.
+func (r *receiverRouter) Subscribe(ctx context.Context, cfg Receiver, msgBuilder *MessageBuilder, ch chan<- source.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, found := r.routes[cfg.Path]
+	if found && rt.cfg != cfg {
+		return fmt.Errorf("the path %q is already used by a receiver with different credentials", cfg.Path)
+	}
+	if !found {
+		rt = &receiverRoute{
+			cfg:         cfg,
+			subscribers: map[chan<- source.Event]receiverSubscriber{},
+		}
+		r.routes[cfg.Path] = rt
+	}
+	rt.subscribers[ch] = receiverSubscriber{ctx: ctx, msgBuilder: msgBuilder}
+
+	go func() {
+		<-ctx.Done()
+		r.unsubscribe(cfg.Path, ch)
+	}()
+	return nil
+}
+
+func (r *receiverRouter) unsubscribe(path string, ch chan<- source.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, found := r.routes[path]
+	if !found {
+		return
+	}
+	delete(rt.subscribers, ch)
+	if len(rt.subscribers) == 0 {
+		delete(r.routes, path)
+	}
+}
+
+// ServeHTTP handles the Alertmanager webhook requests.
+func (r *receiverRouter) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.mu.RLock()
+	rt, found := r.routes[request.URL.Path]
+	var subscribers map[chan<- source.Event]receiverSubscriber
+	if found {
+		subscribers = make(map[chan<- source.Event]receiverSubscriber, len(rt.subscribers))
+		for ch, sub := range rt.subscribers {
+			subscribers[ch] = sub
+		}
+	}
+	r.mu.RUnlock()
+	if !found {
+		http.NotFound(writer, request)
+		return
+	}
+
+	if err := authenticate(rt.cfg, request); err != nil {
+		r.log.Debugf("Rejecting request: %s", err.Error())
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload WebhookMessage
+	body := http.MaxBytesReader(writer, request.Body, maxWebhookPayloadSize)
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		r.log.Errorf("while decoding Alertmanager webhook payload: %s", err.Error())
+		http.Error(writer, fmt.Sprintf("invalid payload: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	r.log.WithFields(logrus.Fields{
+		"groupKey": payload.GroupKey,
+		"status":   payload.Status,
+		"alerts":   len(payload.Alerts),
+	}).Debug("Received Alertmanager webhook")
+
+	for ch, sub := range subscribers {
+		filtered, ok := sub.msgBuilder.FilterAlertGroup(payload)
+		if !ok {
+			r.log.WithField("groupKey", payload.GroupKey).Debug("All alerts filtered out, skipping...")
+			continue
+		}
+
+		select {
+		case ch <- source.Event{Message: sub.msgBuilder.ForAlertGroup(filtered), RawObject: filtered}:
+		case <-sub.ctx.Done():
+		case <-request.Context().Done():
+			http.Error(writer, fmt.Sprintf("while sending event: %s", request.Context().Err()), http.StatusServiceUnavailable)
+			return
+		}
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+// authenticate verifies the bearer token or basic auth credentials.
+func authenticate(cfg Receiver, request *http.Request) error {
+	if cfg.BearerToken != "" {
+		token := strings.TrimPrefix(request.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(cfg.BearerToken)) != 1 {
+			return errors.New("invalid bearer token")
+		}
+		return nil
+	}
+
+	username, password, ok := request.BasicAuth()
+	if !ok {
+		return errors.New("missing basic auth credentials")
+	}
+	validUsername := subtle.ConstantTimeCompare([]byte(username), []byte(cfg.Username)) == 1
+	validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(cfg.Password)) == 1
+	if !validUsername || !validPassword {
+		return errors.New("invalid basic auth credentials")
+	}
+	return nil
+}
+
+// FilterAlertGroup returns the alert group with alerts allowed by the configured filters. It returns false if all alerts are filtered out.
+// The group status, common labels and common annotations are recomputed from the remaining alerts.
+func (b *MessageBuilder) FilterAlertGroup(payload WebhookMessage) (WebhookMessage, bool) {
+	var alerts []WebhookAlert
+	for _, alert := range payload.Alerts {
+		if !b.filter.IsAllowed(alert.Labels) {
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	if len(alerts) == 0 {
+		return WebhookMessage{}, false
+	}
+	if len(alerts) == len(payload.Alerts) {
+		return payload, true
+	}
+
+	payload.Alerts = alerts
+	payload.Status = alertStatusResolved
+	if countFiring(alerts) > 0 {
+		payload.Status = alertStatusFiring
+	}
+	payload.CommonLabels = commonValues(alerts, func(alert WebhookAlert) map[string]string { return alert.Labels })
+	payload.CommonAnnotations = commonValues(alerts, func(alert WebhookAlert) map[string]string { return alert.Annotations })
+	return payload, true
+}
+
+// ForAlertGroup renders alert group as a single message with a list of alerts.
+// Firing alert groups can be silenced by labels common for all alerts in the group.
+func (b *MessageBuilder) ForAlertGroup(payload WebhookMessage) api.Message {
+	emoji := firingEmoji
+	if level, found := b.levelFor(payload.CommonLabels); found {
+		emoji = emojiForLevel[level]
+	}
+	header := fmt.Sprintf("%s [FIRING:%d] %s", emoji, countFiring(payload.Alerts), alertGroupName(payload))
+	if payload.Status == alertStatusResolved {
+		header = fmt.Sprintf("%s [RESOLVED] %s", emojiForLevel[LevelSuccess], alertGroupName(payload))
+	}
+
+	fields := api.TextFields{
+		{Key: "Source", Value: PluginName},
+		{Key: "Status", Value: payload.Status},
+		{Key: "Receiver", Value: payload.Receiver},
+		{Key: "Group labels", Value: formatLabels(payload.GroupLabels, nil)},
+		{Key: "Alertmanager", Value: payload.ExternalURL},
+	}
+	if payload.TruncatedAlerts > 0 {
+		fields = append(fields, api.TextField{Key: "Truncated alerts", Value: fmt.Sprintf("%d", payload.TruncatedAlerts)})
+	}
+
+	var items []string
+	for _, alert := range payload.Alerts {
+		items = append(items, alertItem(alert, payload.CommonLabels))
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: header,
+		},
+		TextFields: fields,
+		BulletLists: []api.BulletList{
+			{
+				Title: "Alerts",
+				Items: items,
+			},
+		},
+	}
+	if summary := commonSummary(payload.CommonAnnotations); summary != "" {
+		section.BulletLists = append([]api.BulletList{{Title: "Description", Items: []string{summary}}}, section.BulletLists...)
+	}
+	b.applyTemplates(&section, alertGroupTemplateData(payload))
+
+	msg := api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}
+	if payload.Status == alertStatusResolved {
+		return msg
+	}
+	return withSilenceSection(msg, payload.CommonLabels, b.isInteractivitySupported)
+}
+
+func alertGroupTemplateData(payload WebhookMessage) TemplateData {
+	out := TemplateData{
+		Name:        alertGroupName(payload),
+		State:       payload.Status,
+		Labels:      payload.CommonLabels,
+		Annotations: payload.CommonAnnotations,
+	}
+	for _, alert := range payload.Alerts {
+		if out.StartsAt.IsZero() || alert.StartsAt.Before(out.StartsAt) {
+			out.StartsAt = alert.StartsAt
+		}
+		out.Alerts = append(out.Alerts, TemplateData{
+			Name:         alert.Labels["alertname"],
+			State:        alert.Status,
+			Labels:       alert.Labels,
+			Annotations:  alert.Annotations,
+			StartsAt:     alert.StartsAt,
+			GeneratorURL: alert.GeneratorURL,
+		})
+	}
+	return out
+}
+
+func alertGroupName(payload WebhookMessage) string {
+	if name := payload.GroupLabels["alertname"]; name != "" {
+		return name
+	}
+	if name := payload.CommonLabels["alertname"]; name != "" {
+		return name
+	}
+	if labels := formatLabels(payload.GroupLabels, nil); labels != "" {
+		return labels
+	}
+	return "Alert group"
+}
+
+// alertItem returns a description of a given alert, such as "[firing] KubePodCrashLooping: Pod is crash looping (pod=nginx) <url>".
+// Labels common for all alerts in the group are omitted.
+func alertItem(alert WebhookAlert, commonLabels map[string]string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s] %s", alert.Status, alert.Labels["alertname"])
+	if summary := commonSummary(alert.Annotations); summary != "" {
+		fmt.Fprintf(&sb, ": %s", summary)
+	}
+	if labels := formatLabels(alert.Labels, commonLabels); labels != "" {
+		fmt.Fprintf(&sb, " (%s)", labels)
+	}
+	if alert.GeneratorURL != "" {
+		fmt.Fprintf(&sb, " %s", alert.GeneratorURL)
+	}
+	return sb.String()
+}
+
+func commonSummary(annotations map[string]string) string {
+	if description := annotations["description"]; description != "" {
+		return description
+	}
+	return annotations["summary"]
+}
+
+// formatLabels returns sorted labels in the "key=value" format, skipping the alert name and labels present in a given skip map.
+func formatLabels(labels, skip map[string]string) string {
+	var out []string
+	for key, val := range labels {
+		if key == "alertname" {
+			continue
+		}
+		if skipVal, found := skip[key]; found && skipVal == val {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s=%s", key, val))
+	}
+	sort.Strings(out)
+	return strings.Join(out, ", ")
+}
+
+// commonValues returns key-value pairs present in all alerts.
+func commonValues(alerts []WebhookAlert, valuesFn func(alert WebhookAlert) map[string]string) map[string]string {
+	out := map[string]string{}
+	for key, val := range valuesFn(alerts[0]) {
+		out[key] = val
+	}
+	for _, alert := range alerts[1:] {
+		values := valuesFn(alert)
+		for key, val := range out {
+			if got, found := values[key]; !found || got != val {
+				delete(out, key)
+			}
+		}
+	}
+	return out
+}
+
+func countFiring(alerts []WebhookAlert) int {
+	var count int
+	for _, alert := range alerts {
+		if alert.Status == alertStatusFiring {
+			count++
+		}
+	}
+	return count
+}
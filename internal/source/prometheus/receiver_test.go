@@ -0,0 +1,311 @@
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name            string
+		payload         string
+		expectedHeader  string
+		expectedFields  api.TextFields
+		expectedAlerts  []string
+		expectedSummary []string
+	}{
+		{
+			name:           "Firing alert group",
+			payload:        firingPayload,
+			expectedHeader: "🔥 [FIRING:2] KubePodCrashLooping",
+			expectedFields: api.TextFields{
+				{Key: "Source", Value: "prometheus"},
+				{Key: "Status", Value: "firing"},
+				{Key: "Receiver", Value: "botkube"},
+				{Key: "Group labels", Value: "namespace=default"},
+				{Key: "Alertmanager", Value: "http://alertmanager:9093"},
+			},
+			expectedSummary: []string{"Pod is crash looping."},
+			expectedAlerts: []string{
+				"[firing] KubePodCrashLooping (pod=nginx-1) http://prometheus:9090/graph?g0.expr=1",
+				"[firing] KubePodCrashLooping (pod=nginx-2) http://prometheus:9090/graph?g0.expr=1",
+			},
+		},
+		{
+			name:           "Resolved alert group",
+			payload:        resolvedPayload,
+			expectedHeader: "✅ [RESOLVED] HighMemory",
+			expectedFields: api.TextFields{
+				{Key: "Source", Value: "prometheus"},
+				{Key: "Status", Value: "resolved"},
+				{Key: "Receiver", Value: "botkube"},
+				{Key: "Group labels", Value: ""},
+				{Key: "Alertmanager", Value: ""},
+				{Key: "Truncated alerts", Value: "3"},
+			},
+			expectedAlerts: []string{
+				"[resolved] HighMemory: Memory usage is high on node-1.",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ch := make(chan source.Event, 1)
+			router := fixReceiverRouter(t, fixMessageBuilder(t, Config{}, false), ch)
+			req := fixReceiverRequest(tc.payload)
+			rec := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Len(t, ch, 1)
+			event := <-ch
+
+			require.Len(t, event.Message.Sections, 1)
+			section := event.Message.Sections[0]
+			assert.Equal(t, api.NonInteractiveSingleSection, event.Message.Type)
+			assert.Equal(t, tc.expectedHeader, section.Header)
+			assert.Equal(t, tc.expectedFields, section.TextFields)
+
+			lists := section.BulletLists
+			if tc.expectedSummary != nil {
+				require.Len(t, lists, 2)
+				assert.Equal(t, tc.expectedSummary, lists[0].Items)
+				lists = lists[1:]
+			}
+			require.Len(t, lists, 1)
+			assert.Equal(t, "Alerts", lists[0].Title)
+			assert.Equal(t, tc.expectedAlerts, lists[0].Items)
+		})
+	}
+}
+
+func TestWebhookHandler_InvalidPayload(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	router := fixReceiverRouter(t, fixMessageBuilder(t, Config{}, false), ch)
+	req := fixReceiverRequest("{")
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, ch)
+}
+
+func TestWebhookHandler_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+	}{
+		{
+			name: "Missing credentials",
+		},
+		{
+			name:          "Invalid bearer token",
+			authorization: "Bearer invalid",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ch := make(chan source.Event, 1)
+			router := fixReceiverRouter(t, fixMessageBuilder(t, Config{}, false), ch)
+			req := httptest.NewRequest(http.MethodPost, "/alertmanager", strings.NewReader(firingPayload))
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Empty(t, ch)
+		})
+	}
+}
+
+func TestReceiverRouter_MultipleStreams(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := newReceiverRouter(loggerx.NewNoop())
+	interactiveCh := make(chan source.Event, 1)
+	nonInteractiveCh := make(chan source.Event, 1)
+	require.NoError(t, router.Subscribe(ctx, fixReceiverConfig(), fixMessageBuilder(t, Config{}, true), interactiveCh))
+	require.NoError(t, router.Subscribe(ctx, fixReceiverConfig(), fixMessageBuilder(t, Config{}, false), nonInteractiveCh))
+	rec := httptest.NewRecorder()
+
+	// when
+	router.ServeHTTP(rec, fixReceiverRequest(firingPayload))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, interactiveCh, 1)
+	assert.Equal(t, api.DefaultMessage, (<-interactiveCh).Message.Type)
+	require.Len(t, nonInteractiveCh, 1)
+	assert.Equal(t, api.NonInteractiveSingleSection, (<-nonInteractiveCh).Message.Type)
+}
+
+func TestReceiverRouter_SubscribeWithDifferentCredentials(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := newReceiverRouter(loggerx.NewNoop())
+	require.NoError(t, router.Subscribe(ctx, fixReceiverConfig(), fixMessageBuilder(t, Config{}, false), make(chan source.Event)))
+
+	otherCfg := fixReceiverConfig()
+	otherCfg.BearerToken = "other"
+
+	// when
+	err := router.Subscribe(ctx, otherCfg, fixMessageBuilder(t, Config{}, false), make(chan source.Event))
+
+	// then
+	assert.EqualError(t, err, `the path "/alertmanager" is already used by a receiver with different credentials`)
+}
+
+func fixReceiverConfig() Receiver {
+	return Receiver{
+		Port:        2115,
+		Path:        "/alertmanager",
+		BearerToken: "secret",
+	}
+}
+
+func fixReceiverRouter(t *testing.T, msgBuilder *MessageBuilder, ch chan<- source.Event) *receiverRouter {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	router := newReceiverRouter(loggerx.NewNoop())
+	require.NoError(t, router.Subscribe(ctx, fixReceiverConfig(), msgBuilder, ch))
+	return router
+}
+
+func fixReceiverRequest(payload string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/alertmanager", strings.NewReader(payload))
+	req.Header.Set("Authorization", "Bearer secret")
+	return req
+}
+
+const firingPayload = `{
+  "version": "4",
+  "groupKey": "{}:{namespace=\"default\"}",
+  "status": "firing",
+  "receiver": "botkube",
+  "groupLabels": {"namespace": "default"},
+  "commonLabels": {"alertname": "KubePodCrashLooping", "namespace": "default", "severity": "warning"},
+  "commonAnnotations": {"description": "Pod is crash looping."},
+  "externalURL": "http://alertmanager:9093",
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {"alertname": "KubePodCrashLooping", "namespace": "default", "severity": "warning", "pod": "nginx-1"},
+      "annotations": {},
+      "startsAt": "2023-03-01T12:00:00Z",
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=1",
+      "fingerprint": "a"
+    },
+    {
+      "status": "firing",
+      "labels": {"alertname": "KubePodCrashLooping", "namespace": "default", "severity": "warning", "pod": "nginx-2"},
+      "annotations": {},
+      "startsAt": "2023-03-01T12:00:00Z",
+      "generatorURL": "http://prometheus:9090/graph?g0.expr=1",
+      "fingerprint": "b"
+    }
+  ]
+}`
+
+const resolvedPayload = `{
+  "version": "4",
+  "status": "resolved",
+  "receiver": "botkube",
+  "truncatedAlerts": 3,
+  "groupLabels": {},
+  "commonLabels": {"alertname": "HighMemory", "node": "node-1"},
+  "alerts": [
+    {
+      "status": "resolved",
+      "labels": {"alertname": "HighMemory", "node": "node-1"},
+      "annotations": {"summary": "Memory usage is high on node-1."},
+      "startsAt": "2023-03-01T12:00:00Z",
+      "endsAt": "2023-03-01T12:30:00Z"
+    }
+  ]
+}`
+
+func TestAlertGroupMessage_SilenceSection(t *testing.T) {
+	tests := []struct {
+		name                     string
+		payload                  string
+		isInteractivitySupported bool
+		expectedSilenceCmd       string
+	}{
+		{
+			name:                     "Firing alert group on interactive platform",
+			payload:                  firingPayload,
+			isInteractivitySupported: true,
+			expectedSilenceCmd:       `alertmanager silence create alertname="KubePodCrashLooping" namespace="default" severity="warning"`,
+		},
+		{
+			name:                     "Firing alert group on non-interactive platform",
+			payload:                  firingPayload,
+			isInteractivitySupported: false,
+		},
+		{
+			name:                     "Resolved alert group",
+			payload:                  resolvedPayload,
+			isInteractivitySupported: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var payload WebhookMessage
+			require.NoError(t, json.Unmarshal([]byte(tc.payload), &payload))
+
+			// when
+			msg := fixMessageBuilder(t, Config{}, tc.isInteractivitySupported).ForAlertGroup(payload)
+
+			// then
+			if tc.expectedSilenceCmd == "" {
+				assert.Equal(t, api.NonInteractiveSingleSection, msg.Type)
+				assert.Len(t, msg.Sections, 1)
+				return
+			}
+
+			assert.Equal(t, api.DefaultMessage, msg.Type)
+			require.Len(t, msg.Sections, 2)
+			section := msg.Sections[1]
+			require.Len(t, section.Buttons, 2)
+			assert.Equal(t, "Silence 1h", section.Buttons[0].Name)
+			assert.Equal(t, api.MessageBotNamePlaceholder+" "+tc.expectedSilenceCmd+" --duration 1h", section.Buttons[0].Command)
+			assert.Equal(t, "Silence 4h", section.Buttons[1].Name)
+			assert.Equal(t, api.MessageBotNamePlaceholder+" "+tc.expectedSilenceCmd+" --duration 4h", section.Buttons[1].Command)
+			require.Len(t, section.PlaintextInputs, 1)
+			assert.Equal(t, api.MessageBotNamePlaceholder+" "+tc.expectedSilenceCmd+" --duration ", section.PlaintextInputs[0].Command)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -11,21 +12,24 @@ import (
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/httpsrv"
 )
 
 const (
 	// PluginName is the name of the Prometheus Botkube plugin.
 	PluginName = "prometheus"
 
-	description = "Get notifications about alerts polled from configured Prometheus AlertManager."
-
-	pollPeriodInSeconds = 5
+	description = "Get notifications about alerts polled from configured Prometheus AlertManager or sent by the Alertmanager webhook receiver."
 )
 
 // Source prometheus source plugin data structure
 type Source struct {
 	pluginVersion string
 	startedAt     time.Time
+
+	// receivers holds the webhook receiver routers indexed by port. All streams share the HTTP servers, as they run in the same plugin process.
+	mu        sync.Mutex
+	receivers map[int]*receiverRouter
 }
 
 // NewSource returns a new instance of Source.
@@ -33,6 +37,7 @@ func NewSource(version string) *Source {
 	return &Source{
 		pluginVersion: version,
 		startedAt:     time.Now(),
+		receivers:     map[int]*receiverRouter{},
 	}
 }
 
@@ -43,7 +48,26 @@ func (p *Source) Stream(ctx context.Context, input source.StreamInput) (source.S
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
-	go p.consumeAlerts(ctx, config, out.Event)
+	if err := config.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	log := loggerx.New(config.Log)
+	msgBuilder, err := NewMessageBuilder(log, config, input.Context.IsInteractivitySupported)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating message builder: %w", err)
+	}
+
+	if config.Mode == ReceiverMode {
+		r := p.receiverForPort(log, config.Receiver.Port)
+		if err := r.Subscribe(ctx, config.Receiver, msgBuilder, out.Event); err != nil {
+			return source.StreamOutput{}, err
+		}
+		log.Infof("Listening for Alertmanager webhooks on %q", config.Receiver.Path)
+		return out, nil
+	}
+
+	go p.consumeAlerts(ctx, log, config, msgBuilder, out.Event)
 
 	return out, nil
 }
@@ -57,9 +81,8 @@ func (p *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
 	}, nil
 }
 
-func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source.Event) {
-	log := loggerx.New(cfg.Log)
-	prometheus, err := NewClient(cfg.URL)
+func (p *Source) consumeAlerts(ctx context.Context, log logrus.FieldLogger, cfg Config, msgBuilder *MessageBuilder, ch chan<- source.Event) {
+	prometheus, err := NewClient(cfg.URL, cfg.Auth, cfg.TLS)
 	exitOnError(err, log)
 
 	for {
@@ -72,26 +95,9 @@ func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source
 			log.Errorf("failed to get alerts. %v", err)
 		}
 		for _, alert := range alerts {
-			msg := api.Message{
-				Type:      api.NonInteractiveSingleSection,
-				Timestamp: time.Now(),
-				Sections: []api.Section{
-					{
-						TextFields: []api.TextField{
-							{Key: "Source", Value: PluginName},
-							{Key: "Alert Name", Value: string(alert.Labels["alertname"])},
-							{Key: "State", Value: string(alert.State)},
-						},
-						BulletLists: []api.BulletList{
-							{
-								Title: "Description",
-								Items: []string{
-									string(alert.Annotations["description"]),
-								},
-							},
-						},
-					},
-				},
+			msg, ok := msgBuilder.ForAlert(alert)
+			if !ok {
+				continue
 			}
 			ch <- source.Event{
 				Message:   msg,
@@ -99,8 +105,27 @@ func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source
 			}
 		}
 		// Fetch alerts periodically with given frequency
-		time.Sleep(time.Second * pollPeriodInSeconds)
+		time.Sleep(cfg.PollInterval)
+	}
+}
+
+// receiverForPort returns a receiver router for a given port. The HTTP server is started once per port and runs until the plugin process exits.
+func (p *Source) receiverForPort(log logrus.FieldLogger, port int) *receiverRouter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if r, found := p.receivers[port]; found {
+		return r
 	}
+
+	r := newReceiverRouter(log.WithField("component", "Alertmanager receiver"))
+	p.receivers[port] = r
+
+	srv := httpsrv.New(log.WithField("component", "Alertmanager receiver server"), fmt.Sprintf(":%d", port), r)
+	go func() {
+		exitOnError(srv.Serve(context.Background()), log)
+	}()
+	return r
 }
 
 func jsonSchema() api.JSONSchema {
@@ -111,12 +136,34 @@ func jsonSchema() api.JSONSchema {
 		  "description": "%s",
 		  "type": "object",
 		  "properties": {
+			"mode": {
+			  "title": "Mode",
+			  "description": "Defines how the alerts are received. In the receiver mode, the plugin exposes an HTTP endpoint for the Alertmanager webhook receiver.",
+			  "type": "string",
+			  "default": "poll",
+			  "oneOf": [
+				{
+				  "const": "poll",
+				  "title": "Poll Prometheus alerts"
+				},
+				{
+				  "const": "receiver",
+				  "title": "Alertmanager webhook receiver"
+				}
+			  ]
+			},
 			"url": {
 			  "title": "Endpoint",
 			  "description": "Prometheus endpoint without API version and resource.",
 			  "type": "string",
 			  "format": "uri"
 			},
+			"pollInterval": {
+			  "title": "Poll interval",
+			  "description": "Interval between subsequent Prometheus alerts fetches. Used only in the poll mode.",
+			  "type": "string",
+			  "default": "5s"
+			},
 			"ignoreOldAlerts": {
 			  "title": "Ignore old alerts",
 			  "description": "If set to true, Prometheus source plugin will not send alerts that is created before the plugin start time.",
@@ -153,6 +200,157 @@ func jsonSchema() api.JSONSchema {
 			  "uniqueItems": true,
 			  "minItems": 1
 			},
+			"auth": {
+			  "title": "Authentication",
+			  "description": "Credentials used for the Prometheus API calls. The basic auth and bearer token are mutually exclusive.",
+			  "type": "object",
+			  "properties": {
+				"username": {
+				  "title": "Username",
+				  "description": "Username for the basic auth.",
+				  "type": "string"
+				},
+				"password": {
+				  "title": "Password",
+				  "description": "Password for the basic auth.",
+				  "type": "string"
+				},
+				"bearerToken": {
+				  "title": "Bearer token",
+				  "description": "Token sent in the Authorization header.",
+				  "type": "string"
+				}
+			  }
+			},
+			"tls": {
+			  "title": "TLS",
+			  "description": "TLS configuration of the Prometheus client.",
+			  "type": "object",
+			  "properties": {
+				"caFile": {
+				  "title": "CA file",
+				  "description": "Path to the CA certificate used to verify the Prometheus server certificate.",
+				  "type": "string"
+				},
+				"certFile": {
+				  "title": "Certificate file",
+				  "description": "Path to the client certificate.",
+				  "type": "string"
+				},
+				"keyFile": {
+				  "title": "Key file",
+				  "description": "Path to the client certificate key.",
+				  "type": "string"
+				},
+				"insecureSkipVerify": {
+				  "title": "Skip TLS verification",
+				  "description": "If set to true, the Prometheus server certificate is not verified.",
+				  "type": "boolean",
+				  "default": false
+				}
+			  }
+			},
+			"filters": {
+			  "title": "Filters",
+			  "description": "Label matchers in the Alertmanager format, such as severity=~\"critical|warning\" or namespace!=\"kube-system\".",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "All of these matchers must match for an alert to be sent. If empty, all alerts are included.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "An alert is dropped if any of these matchers matches.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"severity": {
+			  "title": "Severity",
+			  "description": "Mapping from the severity label values to notification levels.",
+			  "type": "object",
+			  "properties": {
+				"label": {
+				  "title": "Label",
+				  "description": "Name of the severity label.",
+				  "type": "string",
+				  "default": "severity"
+				},
+				"levels": {
+				  "title": "Levels",
+				  "description": "Notification level per severity label value.",
+				  "type": "object",
+				  "default": {
+					"critical": "error",
+					"warning": "warning",
+					"info": "info"
+				  },
+				  "additionalProperties": {
+					"type": "string",
+					"enum": ["info", "warning", "error", "success"]
+				  }
+				}
+			  }
+			},
+			"templates": {
+			  "title": "Templates",
+			  "description": "Go templates of the notification title and body. Templates have access to the alert Name, State, Labels, Annotations, StartsAt, Value, GeneratorURL and, in the receiver mode, Alerts fields.",
+			  "type": "object",
+			  "properties": {
+				"title": {
+				  "title": "Title",
+				  "description": "Template of the notification title, such as {{ .Name }} in {{ .Labels.namespace }}.",
+				  "type": "string"
+				},
+				"body": {
+				  "title": "Body",
+				  "description": "Template of the notification body. It replaces the built-in description.",
+				  "type": "string"
+				}
+			  }
+			},
+			"receiver": {
+			  "title": "Receiver",
+			  "description": "Configuration of the Alertmanager webhook receiver. Used only in the receiver mode.",
+			  "type": "object",
+			  "properties": {
+				"port": {
+				  "title": "Port",
+				  "description": "Port on which the webhook endpoint is exposed.",
+				  "type": "integer",
+				  "default": 2115
+				},
+				"path": {
+				  "title": "Path",
+				  "description": "Path of the webhook endpoint.",
+				  "type": "string",
+				  "default": "/alertmanager"
+				},
+				"bearerToken": {
+				  "title": "Bearer token",
+				  "description": "Token which Alertmanager must send in the Authorization header. Either the bearer token or the basic auth credentials are required.",
+				  "type": "string"
+				},
+				"username": {
+				  "title": "Username",
+				  "description": "Username of the basic auth which Alertmanager must use.",
+				  "type": "string"
+				},
+				"password": {
+				  "title": "Password",
+				  "description": "Password of the basic auth which Alertmanager must use.",
+				  "type": "string"
+				}
+			  }
+			},
 			"log": {
 			  "title": "Logging",
 			  "description": "Logging configuration for the plugin.",
@@ -203,7 +401,17 @@ func jsonSchema() api.JSONSchema {
 			  }
 			}
 		  },
-		  "required": ["url"]
+		  "if": {
+			"properties": {
+			  "mode": {
+				"const": "receiver"
+			  }
+			},
+			"required": ["mode"]
+		  },
+		  "else": {
+			"required": ["url"]
+		  }
 		}`, description),
 	}
 }
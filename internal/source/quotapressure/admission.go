@@ -0,0 +1,164 @@
+package quotapressure
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+// failedCreateReason is the reason of events emitted by controllers which failed to create Pods.
+const failedCreateReason = "FailedCreate"
+
+var (
+	// resourceQuotaMessageRegex matches messages of the ResourceQuota admission, such as
+	// `exceeded quota: compute, requested: requests.cpu=500m, used: requests.cpu=2, limited: requests.cpu=2`
+	// or `failed quota: compute: must specify limits.cpu`.
+	resourceQuotaMessageRegex = regexp.MustCompile(`(exceeded|failed) quota`)
+	// limitRangeMessageRegex matches messages of the LimitRange admission, such as
+	// `maximum cpu usage per Container is 1, but limit is 2` or `cpu max limit to request ratio per Container is 2, but provided ratio is 4`.
+	limitRangeMessageRegex = regexp.MustCompile(`((maximum|minimum) \S+ usage|max limit to request ratio) per (Container|Pod|PersistentVolumeClaim)`)
+)
+
+// admissionWatcher reports Pods rejected by the ResourceQuota or LimitRange admission.
+// Such Pods are never created, so they are detected based on events of their controllers.
+type admissionWatcher struct {
+	log       logrus.FieldLogger
+	cfg       Config
+	cluster   string
+	startedAt time.Time
+	now       func() time.Time
+	eventCh   chan<- source.Event
+
+	mu sync.Mutex
+	// reportedAt holds the time of the last message for a given controller, indexed by namespace/kind/name.
+	reportedAt map[string]time.Time
+}
+
+func newAdmissionWatcher(log logrus.FieldLogger, cfg Config, cluster string, eventCh chan<- source.Event) *admissionWatcher {
+	return &admissionWatcher{
+		log:        log,
+		cfg:        cfg,
+		cluster:    cluster,
+		startedAt:  time.Now(),
+		now:        time.Now,
+		eventCh:    eventCh,
+		reportedAt: map[string]time.Time{},
+	}
+}
+
+// OnAdd handles a new event. Events emitted before the watcher started are ignored.
+func (w *admissionWatcher) OnAdd(ctx context.Context, obj interface{}) {
+	k8sEvent, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	w.process(ctx, k8sEvent)
+}
+
+// OnUpdate handles repeated failures, which are aggregated by Kubernetes into the same event with an increased count.
+// Resyncs without a new occurrence are ignored.
+func (w *admissionWatcher) OnUpdate(ctx context.Context, oldObj, newObj interface{}) {
+	oldEvent, ok := oldObj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	newEvent, ok := newObj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	if oldEvent.Count == newEvent.Count && eventTime(oldEvent).Equal(eventTime(newEvent)) {
+		return
+	}
+	w.process(ctx, newEvent)
+}
+
+// process reports the admission failure, unless the same controller was reported within the repeat interval.
+func (w *admissionWatcher) process(ctx context.Context, k8sEvent *corev1.Event) {
+	if k8sEvent.Reason != failedCreateReason || eventTime(k8sEvent).Before(w.startedAt) {
+		return
+	}
+
+	kind, ok := admissionFailureKind(k8sEvent.Message)
+	if !ok {
+		return
+	}
+
+	allowed, err := w.cfg.Namespaces.IsAllowed(k8sEvent.Namespace)
+	if err != nil {
+		w.log.Errorf("while matching namespace: %s", err.Error())
+		return
+	}
+	if !allowed {
+		return
+	}
+
+	event := AdmissionFailureEvent{
+		Kind:      kind,
+		Namespace: k8sEvent.Namespace,
+		Object:    fmt.Sprintf("%s/%s", k8sEvent.InvolvedObject.Kind, k8sEvent.InvolvedObject.Name),
+		Reason:    k8sEvent.Reason,
+		Message:   k8sEvent.Message,
+		Count:     k8sEvent.Count,
+		Cluster:   w.cluster,
+	}
+	if !w.shouldReport(fmt.Sprintf("%s/%s", event.Namespace, event.Object)) {
+		return
+	}
+
+	select {
+	case w.eventCh <- source.Event{
+		Message:   messageForAdmissionFailure(event, time.Now()),
+		RawObject: event,
+	}:
+	case <-ctx.Done():
+	}
+}
+
+// shouldReport returns true and remembers the time if a given controller wasn't reported within the repeat interval.
+func (w *admissionWatcher) shouldReport(key string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	if reportedAt, found := w.reportedAt[key]; found && now.Sub(reportedAt) < w.cfg.AdmissionFailures.RepeatInterval {
+		return false
+	}
+
+	// Forget controllers which can be reported again, so the state doesn't grow indefinitely.
+	for k, reportedAt := range w.reportedAt {
+		if now.Sub(reportedAt) >= w.cfg.AdmissionFailures.RepeatInterval {
+			delete(w.reportedAt, k)
+		}
+	}
+	w.reportedAt[key] = now
+	return true
+}
+
+// admissionFailureKind returns the admission plugin which rejected the Pod, based on the event message.
+func admissionFailureKind(msg string) (AdmissionFailureKind, bool) {
+	switch {
+	case resourceQuotaMessageRegex.MatchString(msg):
+		return ResourceQuotaFailureKind, true
+	case limitRangeMessageRegex.MatchString(msg):
+		return LimitRangeFailureKind, true
+	}
+	return "", false
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
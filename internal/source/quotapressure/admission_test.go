@@ -0,0 +1,177 @@
+package quotapressure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var fixStartedAt = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestAdmissionWatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    *corev1.Event
+		expected []AdmissionFailureEvent
+	}{
+		{
+			name:  "Exceeded quota",
+			event: fixFailedCreateEvent("default", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: exceeded quota: compute, requested: requests.cpu=500m, used: requests.cpu=2, limited: requests.cpu=2`, time.Minute),
+			expected: []AdmissionFailureEvent{
+				{
+					Kind:      ResourceQuotaFailureKind,
+					Namespace: "default",
+					Object:    "ReplicaSet/web-5d8f7c",
+					Reason:    "FailedCreate",
+					Message:   `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: exceeded quota: compute, requested: requests.cpu=500m, used: requests.cpu=2, limited: requests.cpu=2`,
+					Cluster:   "prod",
+				},
+			},
+		},
+		{
+			name:  "Missing limits required by quota",
+			event: fixFailedCreateEvent("default", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: failed quota: compute: must specify limits.cpu for: web`, time.Minute),
+			expected: []AdmissionFailureEvent{
+				{
+					Kind:      ResourceQuotaFailureKind,
+					Namespace: "default",
+					Object:    "ReplicaSet/web-5d8f7c",
+					Reason:    "FailedCreate",
+					Message:   `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: failed quota: compute: must specify limits.cpu for: web`,
+					Cluster:   "prod",
+				},
+			},
+		},
+		{
+			name:  "LimitRange violation",
+			event: fixFailedCreateEvent("default", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: maximum cpu usage per Container is 1, but limit is 2`, time.Minute),
+			expected: []AdmissionFailureEvent{
+				{
+					Kind:      LimitRangeFailureKind,
+					Namespace: "default",
+					Object:    "ReplicaSet/web-5d8f7c",
+					Reason:    "FailedCreate",
+					Message:   `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: maximum cpu usage per Container is 1, but limit is 2`,
+					Cluster:   "prod",
+				},
+			},
+		},
+		{
+			name:  "Unrelated failure",
+			event: fixFailedCreateEvent("default", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: error looking up service account default/web: serviceaccount "web" not found`, time.Minute),
+		},
+		{
+			name:  "Emitted before start",
+			event: fixFailedCreateEvent("default", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: exceeded quota: compute`, -time.Minute),
+		},
+		{
+			name:  "Excluded namespace",
+			event: fixFailedCreateEvent("kube-system", `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: exceeded quota: compute`, time.Minute),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg, err := MergeConfigs(nil)
+			require.NoError(t, err)
+			cfg.Namespaces.Exclude = []string{"kube-system"}
+
+			ch := make(chan source.Event, 10)
+			w := newAdmissionWatcher(loggerx.NewNoop(), cfg, "prod", ch)
+			w.startedAt = fixStartedAt
+
+			// when
+			w.OnAdd(context.Background(), tc.event)
+
+			// then
+			close(ch)
+			var actual []AdmissionFailureEvent
+			for event := range ch {
+				actual = append(actual, event.RawObject.(AdmissionFailureEvent))
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestAdmissionWatcher_RepeatedFailures(t *testing.T) {
+	// given
+	ctx := context.Background()
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+
+	ch := make(chan source.Event, 10)
+	w := newAdmissionWatcher(loggerx.NewNoop(), cfg, "prod", ch)
+	w.startedAt = fixStartedAt
+	now := fixStartedAt.Add(time.Minute)
+	w.now = func() time.Time { return now }
+
+	msg := `Error creating: pods "web-5d8f7c-x2v4q" is forbidden: exceeded quota: compute`
+	first := fixFailedCreateEvent("default", msg, time.Minute)
+	first.Count = 1
+	repeated := func(count int32, after time.Duration) *corev1.Event {
+		e := fixFailedCreateEvent("default", msg, after)
+		e.Count = count
+		return e
+	}
+	received := func() []int32 {
+		var out []int32
+		for {
+			select {
+			case event := <-ch:
+				out = append(out, event.RawObject.(AdmissionFailureEvent).Count)
+			default:
+				return out
+			}
+		}
+	}
+
+	// when
+	w.OnAdd(ctx, first)
+
+	// then
+	assert.Equal(t, []int32{1}, received())
+
+	// when
+	w.OnUpdate(ctx, first, first)
+
+	// then
+	assert.Empty(t, received(), "resync shouldn't be reported")
+
+	// when
+	now = now.Add(10 * time.Minute)
+	w.OnUpdate(ctx, first, repeated(2, 11*time.Minute))
+
+	// then
+	assert.Empty(t, received(), "repeated failure within the repeat interval shouldn't be reported")
+
+	// when
+	now = now.Add(time.Hour)
+	w.OnUpdate(ctx, repeated(2, 11*time.Minute), repeated(7, 71*time.Minute))
+
+	// then
+	assert.Equal(t, []int32{7}, received())
+}
+
+func fixFailedCreateEvent(namespace, msg string, emittedAfter time.Duration) *corev1.Event {
+	emittedAt := metav1.NewTime(fixStartedAt.Add(emittedAfter))
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web-5d8f7c.174a1b2c3d4e5f60",
+			Namespace:         namespace,
+			CreationTimestamp: emittedAt,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "ReplicaSet", Name: "web-5d8f7c", Namespace: namespace},
+		Reason:         "FailedCreate",
+		Message:        msg,
+		LastTimestamp:  emittedAt,
+	}
+}
@@ -0,0 +1,109 @@
+package quotapressure
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds quota pressure source configuration.
+type Config struct {
+	Log        config.Logger              `yaml:"log"`
+	Namespaces k8sconfig.RegexConstraints `yaml:"namespaces"`
+	// Interval defines how often ResourceQuotas are checked.
+	Interval time.Duration `yaml:"interval"`
+	// Thresholds defines usage percentages on which a warning is sent.
+	Thresholds []int `yaml:"thresholds"`
+	// ResourceThresholds overrides Thresholds for given resource names, such as `requests.cpu` or `pods`.
+	ResourceThresholds map[string][]int `yaml:"resourceThresholds"`
+	// Hysteresis is the number of percentage points by which the usage must drop below a reported threshold
+	// before it's cleared. It prevents flapping when the usage oscillates around the threshold.
+	Hysteresis int `yaml:"hysteresis"`
+	// NotifyOnRecovery sends a message when the usage drops below all thresholds.
+	NotifyOnRecovery  bool                    `yaml:"notifyOnRecovery"`
+	AdmissionFailures AdmissionFailuresConfig `yaml:"admissionFailures"`
+}
+
+// AdmissionFailuresConfig contains configuration for Pod admission failures.
+type AdmissionFailuresConfig struct {
+	// Enabled reports Pods rejected because of ResourceQuota or LimitRange, based on events of their controllers.
+	Enabled bool `yaml:"enabled"`
+	// RepeatInterval is the minimum time between messages about repeated failures of the same controller.
+	RepeatInterval       time.Duration `yaml:"repeatInterval"`
+	InformerResyncPeriod time.Duration `yaml:"informerResyncPeriod"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+		Namespaces: k8sconfig.RegexConstraints{
+			Include: []string{".*"},
+		},
+		Interval:         time.Minute,
+		Thresholds:       []int{80, 90, 100},
+		Hysteresis:       5,
+		NotifyOnRecovery: true,
+		AdmissionFailures: AdmissionFailuresConfig{
+			Enabled:              true,
+			RepeatInterval:       time.Hour,
+			InformerResyncPeriod: 30 * time.Minute,
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("the interval must be positive, got %s", c.Interval)
+	}
+	if len(c.Thresholds) == 0 {
+		return errors.New("at least one threshold is required")
+	}
+	if err := validateThresholds(c.Thresholds); err != nil {
+		return err
+	}
+	for name, thresholds := range c.ResourceThresholds {
+		if err := validateThresholds(thresholds); err != nil {
+			return fmt.Errorf("while validating thresholds for %q: %w", name, err)
+		}
+	}
+	if c.Hysteresis < 0 || c.Hysteresis >= 100 {
+		return fmt.Errorf("the hysteresis must be between 0 and 99 percentage points, got %d", c.Hysteresis)
+	}
+	if c.AdmissionFailures.RepeatInterval < 0 {
+		return fmt.Errorf("the admission failures repeat interval must not be negative, got %s", c.AdmissionFailures.RepeatInterval)
+	}
+	return nil
+}
+
+// ThresholdsFor returns usage thresholds for a given resource name.
+func (c Config) ThresholdsFor(resourceName string) []int {
+	if thresholds, ok := c.ResourceThresholds[resourceName]; ok {
+		return thresholds
+	}
+	return c.Thresholds
+}
+
+func validateThresholds(thresholds []int) error {
+	for _, threshold := range thresholds {
+		if threshold <= 0 {
+			return fmt.Errorf("the threshold must be a positive percentage, got %d", threshold)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package quotapressure
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// QuotaEventType describes the ResourceQuota usage change.
+type QuotaEventType string
+
+const (
+	// PressureEventType is used when the usage crossed a threshold.
+	PressureEventType QuotaEventType = "pressure"
+	// RecoveredEventType is used when the usage dropped below all thresholds.
+	RecoveredEventType QuotaEventType = "recovered"
+)
+
+// QuotaEvent is a raw object sent together with the ResourceQuota usage message.
+type QuotaEvent struct {
+	Type      QuotaEventType `json:"type"`
+	Quota     string         `json:"quota"`
+	Namespace string         `json:"namespace"`
+	Resource  string         `json:"resource"`
+	Used      string         `json:"used"`
+	Hard      string         `json:"hard"`
+	Percent   int            `json:"percent"`
+	Threshold int            `json:"threshold,omitempty"`
+	Cluster   string         `json:"cluster,omitempty"`
+}
+
+// AdmissionFailureKind describes the admission plugin which rejected the Pod.
+type AdmissionFailureKind string
+
+const (
+	// ResourceQuotaFailureKind is used when the Pod was rejected by the ResourceQuota admission.
+	ResourceQuotaFailureKind AdmissionFailureKind = "ResourceQuota"
+	// LimitRangeFailureKind is used when the Pod was rejected by the LimitRange admission.
+	LimitRangeFailureKind AdmissionFailureKind = "LimitRange"
+)
+
+// AdmissionFailureEvent is a raw object sent together with the Pod admission failure message.
+type AdmissionFailureEvent struct {
+	Kind      AdmissionFailureKind `json:"kind"`
+	Namespace string               `json:"namespace"`
+	// Object is the controller which failed to create the Pod, such as "ReplicaSet/web-5d8f7c".
+	Object  string `json:"object"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	// Count is the number of rejections aggregated by Kubernetes into the event.
+	Count   int32  `json:"count,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+}
+
+func messageForQuota(e QuotaEvent, now time.Time) api.Message {
+	var header string
+	switch {
+	case e.Type == RecoveredEventType:
+		header = fmt.Sprintf("✅ ResourceQuota %s/%s: %s usage dropped to %d%%", e.Namespace, e.Quota, e.Resource, e.Percent)
+	case e.Percent >= 100:
+		header = fmt.Sprintf("🚫 ResourceQuota %s/%s: %s is exhausted", e.Namespace, e.Quota, e.Resource)
+	default:
+		header = fmt.Sprintf("⚠️ ResourceQuota %s/%s: %s usage is at %d%%", e.Namespace, e.Quota, e.Resource, e.Percent)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header: header,
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "ResourceQuota", e.Quota)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Resource", e.Resource)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Used", fmt.Sprintf("%s of %s", e.Used, e.Hard))
+	if e.Threshold > 0 {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Threshold", strconv.Itoa(e.Threshold)+"%")
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func messageForAdmissionFailure(e AdmissionFailureEvent, now time.Time) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("🚫 %s in %s namespace rejected Pods of %s", e.Kind, e.Namespace, e.Object),
+		},
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Object", e.Object)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Namespace", e.Namespace)
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Reason", e.Reason)
+	if e.Count > 1 {
+		section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Occurrences", strconv.Itoa(int(e.Count)))
+	}
+	section.TextFields = appendTextFieldIfNotEmpty(section.TextFields, "Cluster", e.Cluster)
+
+	if e.Message != "" {
+		section.BulletLists = append(section.BulletLists, api.BulletList{
+			Title: "Message",
+			Items: []string{e.Message},
+		})
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: now,
+		Sections:  []api.Section{section},
+	}
+}
+
+func appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) api.TextFields {
+	if value == "" {
+		return fields
+	}
+	return append(fields, api.TextField{
+		Key:   title,
+		Value: value,
+	})
+}
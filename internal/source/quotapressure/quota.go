@@ -0,0 +1,136 @@
+package quotapressure
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// quotaChecker detects ResourceQuotas with usage close to the hard limits.
+type quotaChecker struct {
+	log     logrus.FieldLogger
+	cfg     Config
+	cluster string
+	k8sCli  kubernetes.Interface
+
+	// levels holds the highest crossed threshold for each quota resource, indexed by namespace/quota/resource.
+	levels map[string]int
+}
+
+func newQuotaChecker(log logrus.FieldLogger, cfg Config, cluster string, k8sCli kubernetes.Interface) *quotaChecker {
+	return &quotaChecker{
+		log:     log,
+		cfg:     cfg,
+		cluster: cluster,
+		k8sCli:  k8sCli,
+		levels:  map[string]int{},
+	}
+}
+
+// Check returns events for quota resources which crossed a threshold, or dropped below all thresholds, since the last check.
+func (c *quotaChecker) Check(ctx context.Context) ([]QuotaEvent, error) {
+	list, err := c.k8sCli.CoreV1().ResourceQuotas(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("while listing ResourceQuotas: %w", err)
+	}
+
+	seen := map[string]struct{}{}
+	var out []QuotaEvent
+	for i := range list.Items {
+		quota := &list.Items[i]
+		allowed, err := c.cfg.Namespaces.IsAllowed(quota.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("while matching namespace: %w", err)
+		}
+		if !allowed {
+			continue
+		}
+
+		for _, name := range sortedResourceNames(quota.Status.Hard) {
+			key := fmt.Sprintf("%s/%s/%s", quota.Namespace, quota.Name, name)
+			seen[key] = struct{}{}
+
+			event, found := c.check(quota, name, key)
+			if found {
+				out = append(out, event)
+			}
+		}
+	}
+
+	for key := range c.levels {
+		if _, ok := seen[key]; !ok {
+			delete(c.levels, key)
+		}
+	}
+	return out, nil
+}
+
+func (c *quotaChecker) check(quota *corev1.ResourceQuota, name corev1.ResourceName, key string) (QuotaEvent, bool) {
+	hard := quota.Status.Hard[name]
+	if hard.IsZero() {
+		return QuotaEvent{}, false
+	}
+	used := quota.Status.Used[name]
+	percent := used.AsApproximateFloat64() / hard.AsApproximateFloat64() * 100
+
+	current := c.levels[key]
+	level := pressureLevel(c.cfg.ThresholdsFor(string(name)), current, c.cfg.Hysteresis, percent)
+	if level == 0 {
+		delete(c.levels, key)
+	} else {
+		c.levels[key] = level
+	}
+
+	event := QuotaEvent{
+		Quota:     quota.Name,
+		Namespace: quota.Namespace,
+		Resource:  string(name),
+		Used:      used.String(),
+		Hard:      hard.String(),
+		Percent:   int(math.Floor(percent)),
+		Cluster:   c.cluster,
+	}
+	switch {
+	case level > current:
+		event.Type = PressureEventType
+		event.Threshold = level
+		return event, true
+	case level == 0 && current > 0 && c.cfg.NotifyOnRecovery:
+		event.Type = RecoveredEventType
+		return event, true
+	}
+	return QuotaEvent{}, false
+}
+
+// pressureLevel returns the highest threshold crossed by the usage percentage. Thresholds which are already reached
+// are kept until the usage drops below them by the hysteresis, so the usage oscillating around a threshold isn't reported repeatedly.
+func pressureLevel(thresholds []int, current, hysteresis int, percent float64) int {
+	out := 0
+	for _, threshold := range thresholds {
+		limit := float64(threshold)
+		if threshold <= current {
+			limit -= float64(hysteresis)
+		}
+		if percent >= limit && threshold > out {
+			out = threshold
+		}
+	}
+	return out
+}
+
+func sortedResourceNames(list corev1.ResourceList) []corev1.ResourceName {
+	out := make([]corev1.ResourceName, 0, len(list))
+	for name := range list {
+		out = append(out, name)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i] < out[j]
+	})
+	return out
+}
@@ -0,0 +1,177 @@
+package quotapressure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestQuotaChecker(t *testing.T) {
+	// given
+	ctx := context.Background()
+	cfg, err := MergeConfigs(nil)
+	require.NoError(t, err)
+	cfg.Namespaces.Exclude = []string{"kube-system"}
+	cfg.ResourceThresholds = map[string][]int{"pods": {100}}
+
+	k8sCli := fake.NewSimpleClientset(
+		fixQuota("kube-system", "10", "10"),
+	)
+	checker := newQuotaChecker(loggerx.NewNoop(), cfg, "prod", k8sCli)
+
+	steps := []struct {
+		name     string
+		usedCPU  string
+		usedPods string
+		expected []QuotaEvent
+	}{
+		{
+			name:     "Below thresholds",
+			usedCPU:  "1500m",
+			usedPods: "9",
+		},
+		{
+			name:     "Threshold crossed",
+			usedCPU:  "1700m",
+			usedPods: "9",
+			expected: []QuotaEvent{fixQuotaEvent(PressureEventType, "1700m", 85, 80)},
+		},
+		{
+			name:     "Usage still above the threshold",
+			usedCPU:  "1650m",
+			usedPods: "9",
+		},
+		{
+			name:     "Usage oscillates within hysteresis",
+			usedCPU:  "1560m",
+			usedPods: "9",
+		},
+		{
+			name:     "Usage back above the threshold",
+			usedCPU:  "1620m",
+			usedPods: "9",
+		},
+		{
+			name:     "Multiple thresholds crossed and resource threshold override",
+			usedCPU:  "2",
+			usedPods: "10",
+			expected: []QuotaEvent{
+				{
+					Type:      PressureEventType,
+					Quota:     "compute",
+					Namespace: "default",
+					Resource:  "pods",
+					Used:      "10",
+					Hard:      "10",
+					Percent:   100,
+					Threshold: 100,
+					Cluster:   "prod",
+				},
+				fixQuotaEvent(PressureEventType, "2", 100, 100),
+			},
+		},
+		{
+			name:     "Drop below the highest thresholds",
+			usedCPU:  "1600m",
+			usedPods: "10",
+		},
+		{
+			name:     "Crossing the lower threshold again",
+			usedCPU:  "1900m",
+			usedPods: "10",
+			expected: []QuotaEvent{fixQuotaEvent(PressureEventType, "1900m", 95, 90)},
+		},
+		{
+			name:     "Recovered",
+			usedCPU:  "1",
+			usedPods: "10",
+			expected: []QuotaEvent{fixQuotaEvent(RecoveredEventType, "1", 50, 0)},
+		},
+	}
+
+	for _, step := range steps {
+		_, err := k8sCli.CoreV1().ResourceQuotas("default").Get(ctx, "compute", metav1.GetOptions{})
+		quota := fixQuota("default", step.usedCPU, step.usedPods)
+		if err == nil {
+			_, err = k8sCli.CoreV1().ResourceQuotas("default").Update(ctx, quota, metav1.UpdateOptions{})
+		} else {
+			_, err = k8sCli.CoreV1().ResourceQuotas("default").Create(ctx, quota, metav1.CreateOptions{})
+		}
+		require.NoError(t, err)
+
+		// when
+		actual, err := checker.Check(ctx)
+
+		// then
+		require.NoError(t, err, step.name)
+		assert.Equal(t, step.expected, actual, step.name)
+	}
+}
+
+func TestPressureLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  int
+		percent  float64
+		expected int
+	}{
+		{name: "No pressure", current: 0, percent: 79.9, expected: 0},
+		{name: "Exactly at threshold", current: 0, percent: 80, expected: 80},
+		{name: "Highest crossed threshold", current: 0, percent: 120, expected: 100},
+		{name: "Kept within hysteresis", current: 90, percent: 85, expected: 90},
+		{name: "Cleared below hysteresis", current: 90, percent: 84.9, expected: 80},
+		{name: "All cleared", current: 100, percent: 74, expected: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := pressureLevel([]int{80, 90, 100}, tc.current, 5, tc.percent)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixQuota(namespace, usedCPU, usedPods string) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "compute",
+			Namespace: namespace,
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("2"),
+				corev1.ResourcePods:        resource.MustParse("10"),
+				corev1.ResourceServices:    resource.MustParse("0"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse(usedCPU),
+				corev1.ResourcePods:        resource.MustParse(usedPods),
+				corev1.ResourceServices:    resource.MustParse("0"),
+			},
+		},
+	}
+}
+
+func fixQuotaEvent(eventType QuotaEventType, used string, percent, threshold int) QuotaEvent {
+	return QuotaEvent{
+		Type:      eventType,
+		Quota:     "compute",
+		Namespace: "default",
+		Resource:  "requests.cpu",
+		Used:      used,
+		Hard:      "2",
+		Percent:   percent,
+		Threshold: threshold,
+		Cluster:   "prod",
+	}
+}
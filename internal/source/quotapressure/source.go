@@ -0,0 +1,276 @@
+package quotapressure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	// PluginName is the name of the quota pressure Botkube plugin.
+	PluginName = "quota-pressure"
+
+	description = "Get notifications when ResourceQuota usage crosses configured thresholds, and when Pods are rejected by ResourceQuota or LimitRange."
+)
+
+// Source quota pressure source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream streams ResourceQuota and LimitRange events
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	kubeConfig, err := clientcmd.RESTConfigFromKubeConfig(input.Context.KubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while reading kube config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+
+	checker := newQuotaChecker(log, cfg, input.Context.ClusterName, k8sCli)
+	go s.checkQuotasPeriodically(ctx, checker, out.Event)
+
+	if cfg.AdmissionFailures.Enabled {
+		w := newAdmissionWatcher(log, cfg, input.Context.ClusterName, out.Event)
+		go s.watchAdmissionFailures(ctx, k8sCli, w)
+	}
+
+	return out, nil
+}
+
+// Metadata returns metadata of quota pressure configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func (s *Source) checkQuotasPeriodically(ctx context.Context, checker *quotaChecker, ch chan<- source.Event) {
+	ticker := time.NewTicker(checker.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := checker.Check(ctx)
+		if err != nil {
+			checker.log.Errorf("while checking ResourceQuotas: %s", err.Error())
+		}
+		for _, event := range events {
+			select {
+			case ch <- source.Event{
+				Message:   messageForQuota(event, time.Now()),
+				RawObject: event,
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Source) watchAdmissionFailures(ctx context.Context, k8sCli kubernetes.Interface, w *admissionWatcher) {
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sCli, w.cfg.AdmissionFailures.InformerResyncPeriod,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("reason", failedCreateReason).String()
+		}),
+	)
+	informer := factory.Core().V1().Events().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.OnAdd(ctx, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.OnUpdate(ctx, oldObj, newObj)
+		},
+	})
+
+	w.log.Info("Starting event informer...")
+	factory.Start(ctx.Done())
+	<-ctx.Done()
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Quota pressure",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"namespaces": {
+			  "title": "Namespaces",
+			  "description": "Namespaces of watched ResourceQuotas and Pod admission failures.",
+			  "type": "object",
+			  "properties": {
+				"include": {
+				  "title": "Include",
+				  "description": "Allowed namespaces. It can also contain regex expressions.",
+				  "type": "array",
+				  "default": [".*"],
+				  "items": {
+					"type": "string"
+				  }
+				},
+				"exclude": {
+				  "title": "Exclude",
+				  "description": "Namespaces to be ignored even if allowed by include. It can also contain regex expressions.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  }
+				}
+			  }
+			},
+			"interval": {
+			  "title": "Interval",
+			  "description": "How often ResourceQuotas are checked.",
+			  "type": "string",
+			  "default": "1m"
+			},
+			"thresholds": {
+			  "title": "Thresholds",
+			  "description": "Usage percentages on which a warning is sent. Each threshold is reported once until the usage drops below it.",
+			  "type": "array",
+			  "default": [80, 90, 100],
+			  "items": {
+				"type": "integer",
+				"minimum": 1
+			  }
+			},
+			"resourceThresholds": {
+			  "title": "Resource thresholds",
+			  "description": "Usage percentages overridden for given resource names, such as 'requests.cpu' or 'pods'.",
+			  "type": "object",
+			  "additionalProperties": {
+				"type": "array",
+				"items": {
+				  "type": "integer",
+				  "minimum": 1
+				}
+			  }
+			},
+			"hysteresis": {
+			  "title": "Hysteresis",
+			  "description": "Percentage points by which the usage must drop below a reported threshold before it's cleared. It prevents flapping notifications.",
+			  "type": "integer",
+			  "default": 5,
+			  "minimum": 0,
+			  "maximum": 99
+			},
+			"notifyOnRecovery": {
+			  "title": "Notify on recovery",
+			  "description": "If true, sends a message when the usage drops below all thresholds.",
+			  "type": "boolean",
+			  "default": true
+			},
+			"admissionFailures": {
+			  "title": "Admission failures",
+			  "description": "Pods rejected by ResourceQuota or LimitRange.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "description": "If true, reports Pods rejected by ResourceQuota or LimitRange, based on events of their controllers.",
+				  "type": "boolean",
+				  "default": true
+				},
+				"repeatInterval": {
+				  "title": "Repeat interval",
+				  "description": "Minimum time between messages about repeated failures of the same controller.",
+				  "type": "string",
+				  "default": "1h"
+				}
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const defaultHMACHeader = "X-Signature-256"
+
+// Config holds incoming webhook source configuration.
+type Config struct {
+	// Port is the port on which all endpoints are exposed.
+	Port int `yaml:"port"`
+	// Endpoints holds webhook endpoints indexed by their names.
+	Endpoints map[string]Endpoint `yaml:"endpoints"`
+	Log       config.Logger       `yaml:"log"`
+}
+
+// Endpoint defines a single webhook endpoint.
+type Endpoint struct {
+	// Path is the HTTP path of the endpoint. Defaults to `/{name}`.
+	Path string `yaml:"path"`
+	// Token is a shared token that must be sent in the `Authorization: Bearer {token}` header.
+	Token string `yaml:"token"`
+	// HMAC defines the HMAC-SHA256 signature verification of the request body.
+	HMAC HMAC `yaml:"hmac"`
+	// Template defines how the JSON payload is rendered into a message.
+	Template Template `yaml:"template"`
+}
+
+// HMAC holds configuration of the request signature verification.
+type HMAC struct {
+	// Secret is the key used to sign the request body.
+	Secret string `yaml:"secret"`
+	// Header is the name of the header with the hex-encoded signature, optionally prefixed with `sha256=`.
+	Header string `yaml:"header"`
+}
+
+// Template holds Go templates of the message rendered for a given payload.
+// If not specified, the payload is rendered as a JSON code block.
+type Template struct {
+	Header string          `yaml:"header"`
+	Body   string          `yaml:"body"`
+	Fields []TemplateField `yaml:"fields"`
+}
+
+// TemplateField defines a message text field with a templated value.
+type TemplateField struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Port: 2116,
+		Log: config.Logger{
+			Level: "info",
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	for name, endpoint := range out.Endpoints {
+		if endpoint.Path == "" {
+			endpoint.Path = "/" + name
+		}
+		if endpoint.HMAC.Secret != "" && endpoint.HMAC.Header == "" {
+			endpoint.HMAC.Header = defaultHMACHeader
+		}
+		out.Endpoints[name] = endpoint
+	}
+
+	return out, nil
+}
+
+// Validate validates the configuration.
+func (c Config) Validate() error {
+	if c.Port <= 0 {
+		return fmt.Errorf("invalid port %d", c.Port)
+	}
+	if len(c.Endpoints) == 0 {
+		return errors.New("at least one endpoint is required")
+	}
+
+	paths := map[string]string{}
+	for _, name := range c.endpointNames() {
+		endpoint := c.Endpoints[name]
+		if !strings.HasPrefix(endpoint.Path, "/") {
+			return fmt.Errorf("the path %q of the %q endpoint must start with a slash", endpoint.Path, name)
+		}
+		if other, found := paths[endpoint.Path]; found {
+			return fmt.Errorf("the %q and %q endpoints use the same path %q", other, name, endpoint.Path)
+		}
+		paths[endpoint.Path] = name
+
+		if endpoint.Token == "" && endpoint.HMAC.Secret == "" {
+			return fmt.Errorf("the %q endpoint requires a token or an HMAC secret", name)
+		}
+		if _, err := newRenderer(endpoint.Template); err != nil {
+			return fmt.Errorf("while parsing template of the %q endpoint: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (c Config) endpointNames() []string {
+	var out []string
+	for name := range c.Endpoints {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
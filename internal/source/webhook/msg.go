@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	sprig "github.com/go-task/slim-sprig"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// TemplateData holds data available in the endpoint templates.
+type TemplateData struct {
+	// Endpoint is the name of the endpoint which received the payload.
+	Endpoint string
+	// Payload is the decoded JSON body.
+	Payload any
+	// Headers holds the request headers without credentials, such as the Authorization and signature headers.
+	Headers http.Header
+}
+
+type renderer struct {
+	header *template.Template
+	body   *template.Template
+	fields []parsedField
+}
+
+type parsedField struct {
+	key   string
+	value *template.Template
+}
+
+func newRenderer(cfg Template) (*renderer, error) {
+	var (
+		r   renderer
+		err error
+	)
+	if r.header, err = parseTemplate("header", cfg.Header); err != nil {
+		return nil, err
+	}
+	if r.body, err = parseTemplate("body", cfg.Body); err != nil {
+		return nil, err
+	}
+	for idx, field := range cfg.Fields {
+		if field.Value == "" {
+			return nil, fmt.Errorf("the value of the fields[%d] template is required", idx)
+		}
+		value, err := parseTemplate(fmt.Sprintf("fields[%d]", idx), field.Value)
+		if err != nil {
+			return nil, err
+		}
+		r.fields = append(r.fields, parsedField{key: field.Key, value: value})
+	}
+	return &r, nil
+}
+
+func parseTemplate(name, in string) (*template.Template, error) {
+	if in == "" {
+		return nil, nil
+	}
+	tpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(in)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing %s template: %w", name, err)
+	}
+	return tpl, nil
+}
+
+// Render renders a message for a given payload. If no template is configured, the payload is rendered as a JSON code block.
+func (r *renderer) Render(data TemplateData) (api.Message, error) {
+	section := api.Section{
+		Base: api.Base{
+			Header: fmt.Sprintf("Webhook %s", data.Endpoint),
+		},
+	}
+
+	if r.header == nil && r.body == nil && len(r.fields) == 0 {
+		raw, err := json.MarshalIndent(data.Payload, "", "  ")
+		if err != nil {
+			return api.Message{}, fmt.Errorf("while marshaling payload: %w", err)
+		}
+		section.Body.CodeBlock = string(raw)
+		return newMessage(section), nil
+	}
+
+	if r.header != nil {
+		header, err := execute(r.header, data)
+		if err != nil {
+			return api.Message{}, err
+		}
+		section.Header = header
+	}
+	if r.body != nil {
+		body, err := execute(r.body, data)
+		if err != nil {
+			return api.Message{}, err
+		}
+		section.Description = body
+	}
+	for _, field := range r.fields {
+		value, err := execute(field.value, data)
+		if err != nil {
+			return api.Message{}, err
+		}
+		if value == "" {
+			continue
+		}
+		section.TextFields = append(section.TextFields, api.TextField{Key: field.key, Value: value})
+	}
+	return newMessage(section), nil
+}
+
+func newMessage(section api.Section) api.Message {
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}
+}
+
+func execute(tpl *template.Template, data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("while rendering %s template: %w", tpl.Name(), err)
+	}
+	// missing payload keys are rendered as empty strings, the same as Helm does
+	out := strings.ReplaceAll(buf.String(), "<no value>", "")
+	return strings.TrimSpace(out), nil
+}
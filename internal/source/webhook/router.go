@@ -0,0 +1,203 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const maxPayloadSize = 10 << 20
+
+// router dispatches webhook requests to all streams subscribed to a given endpoint.
+// The same endpoint can be streamed multiple times, e.g. for interactive and non-interactive platforms.
+type router struct {
+	log logrus.FieldLogger
+
+	mu     sync.RWMutex
+	routes map[string]*route
+}
+
+type route struct {
+	name        string
+	cfg         Endpoint
+	renderer    *renderer
+	subscribers map[chan<- source.Event]context.Context
+}
+
+func newRouter(log logrus.FieldLogger) *router {
+	return &router{
+		log:    log,
+		routes: map[string]*route{},
+	}
+}
+
+// Subscribe registers a given endpoint and sends its events to a given channel until the context is canceled.
+// It returns an error if the endpoint path is already registered with a different configuration.
+func (r *router) Subscribe(ctx context.Context, name string, cfg Endpoint, ch chan<- source.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, found := r.routes[cfg.Path]
+	if found && (rt.name != name || !reflect.DeepEqual(rt.cfg, cfg)) {
+		return fmt.Errorf("the path %q is already used by a different %q endpoint", cfg.Path, rt.name)
+	}
+	if !found {
+		renderer, err := newRenderer(cfg.Template)
+		if err != nil {
+			return fmt.Errorf("while parsing template of the %q endpoint: %w", name, err)
+		}
+		rt = &route{
+			name:        name,
+			cfg:         cfg,
+			renderer:    renderer,
+			subscribers: map[chan<- source.Event]context.Context{},
+		}
+		r.routes[cfg.Path] = rt
+	}
+	rt.subscribers[ch] = ctx
+
+	go func() {
+		<-ctx.Done()
+		r.unsubscribe(cfg.Path, ch)
+	}()
+	return nil
+}
+
+func (r *router) unsubscribe(path string, ch chan<- source.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rt, found := r.routes[path]
+	if !found {
+		return
+	}
+	delete(rt.subscribers, ch)
+	if len(rt.subscribers) == 0 {
+		delete(r.routes, path)
+	}
+}
+
+// ServeHTTP handles webhook requests.
+func (r *router) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(writer, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.mu.RLock()
+	rt, found := r.routes[request.URL.Path]
+	r.mu.RUnlock()
+	if !found {
+		http.NotFound(writer, request)
+		return
+	}
+	log := r.log.WithField("endpoint", rt.name)
+
+	body, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(writer, fmt.Sprintf("while reading body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if err := authenticate(rt.cfg, request.Header, body); err != nil {
+		log.Debugf("Rejecting request: %s", err.Error())
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(writer, fmt.Sprintf("invalid JSON payload: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	msg, err := rt.renderer.Render(TemplateData{
+		Endpoint: rt.name,
+		Payload:  payload,
+		Headers:  templateHeaders(rt.cfg, request.Header),
+	})
+	if err != nil {
+		log.Errorf("while rendering message: %s", err.Error())
+		http.Error(writer, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if err := r.emit(request.Context(), rt, source.Event{
+		Message:   msg,
+		RawObject: payload,
+	}); err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (r *router) emit(ctx context.Context, rt *route, event source.Event) error {
+	r.mu.RLock()
+	subscribers := make(map[chan<- source.Event]context.Context, len(rt.subscribers))
+	for ch, subCtx := range rt.subscribers {
+		subscribers[ch] = subCtx
+	}
+	r.mu.RUnlock()
+
+	for ch, subCtx := range subscribers {
+		select {
+		case ch <- event:
+		case <-subCtx.Done():
+		case <-ctx.Done():
+			return fmt.Errorf("while sending event: %w", ctx.Err())
+		}
+	}
+	return nil
+}
+
+// templateHeaders returns the request headers without credentials, so they cannot leak to rendered messages.
+func templateHeaders(cfg Endpoint, headers http.Header) http.Header {
+	out := headers.Clone()
+	for _, name := range []string{"Authorization", "Proxy-Authorization", "Cookie"} {
+		out.Del(name)
+	}
+	if cfg.HMAC.Header != "" {
+		out.Del(cfg.HMAC.Header)
+	}
+	return out
+}
+
+// authenticate verifies the shared token and the HMAC signature, if configured.
+func authenticate(cfg Endpoint, headers http.Header, body []byte) error {
+	if cfg.Token != "" {
+		token := strings.TrimPrefix(headers.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(cfg.Token)) != 1 {
+			return errors.New("invalid token")
+		}
+	}
+
+	if cfg.HMAC.Secret != "" {
+		signature := strings.TrimPrefix(headers.Get(cfg.HMAC.Header), "sha256=")
+		got, err := hex.DecodeString(signature)
+		if err != nil {
+			return fmt.Errorf("invalid %s header: %w", cfg.HMAC.Header, err)
+		}
+
+		mac := hmac.New(sha256.New, []byte(cfg.HMAC.Secret))
+		mac.Write(body)
+		if !hmac.Equal(got, mac.Sum(nil)) {
+			return fmt.Errorf("invalid %s signature", cfg.HMAC.Header)
+		}
+	}
+	return nil
+}
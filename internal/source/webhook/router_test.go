@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const fixPayload = `{"pipeline":"release","status":"failed","url":"https://ci.example.com/builds/42"}`
+
+func TestRouter_Authentication(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpoint     Endpoint
+		headers      map[string]string
+		expectedCode int
+	}{
+		{
+			name:         "Valid token",
+			endpoint:     Endpoint{Path: "/ci", Token: "s3cr3t"},
+			headers:      map[string]string{"Authorization": "Bearer s3cr3t"},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Invalid token",
+			endpoint:     Endpoint{Path: "/ci", Token: "s3cr3t"},
+			headers:      map[string]string{"Authorization": "Bearer wrong"},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "Missing token",
+			endpoint:     Endpoint{Path: "/ci", Token: "s3cr3t"},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "Valid HMAC signature",
+			endpoint:     Endpoint{Path: "/ci", HMAC: HMAC{Secret: "key", Header: defaultHMACHeader}},
+			headers:      map[string]string{defaultHMACHeader: "sha256=" + sign("key", fixPayload)},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Invalid HMAC signature",
+			endpoint:     Endpoint{Path: "/ci", HMAC: HMAC{Secret: "key", Header: defaultHMACHeader}},
+			headers:      map[string]string{defaultHMACHeader: sign("other-key", fixPayload)},
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			r := newRouter(loggerx.NewNoop())
+			ch := make(chan source.Event, 1)
+			require.NoError(t, r.Subscribe(context.Background(), "ci", tc.endpoint, ch))
+
+			req := httptest.NewRequest(http.MethodPost, "/ci", strings.NewReader(fixPayload))
+			for key, val := range tc.headers {
+				req.Header.Set(key, val)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			r.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedCode != http.StatusOK {
+				assert.Empty(t, ch)
+				return
+			}
+			require.Len(t, ch, 1)
+			event := <-ch
+			assert.Equal(t, map[string]any{
+				"pipeline": "release",
+				"status":   "failed",
+				"url":      "https://ci.example.com/builds/42",
+			}, event.RawObject)
+		})
+	}
+}
+
+func TestRouter_Render(t *testing.T) {
+	tests := []struct {
+		name            string
+		template        Template
+		expectedSection api.Section
+	}{
+		{
+			name:     "Default layout",
+			template: Template{},
+			expectedSection: api.Section{
+				Base: api.Base{
+					Header: "Webhook ci",
+					Body: api.Body{
+						CodeBlock: "{\n  \"pipeline\": \"release\",\n  \"status\": \"failed\",\n  \"url\": \"https://ci.example.com/builds/42\"\n}",
+					},
+				},
+			},
+		},
+		{
+			name: "Custom template",
+			template: Template{
+				Header: `❗ Pipeline {{ .Payload.pipeline }} {{ .Payload.status }}`,
+				Body:   `See {{ .Payload.url }}`,
+				Fields: []TemplateField{
+					{Key: "Status", Value: `{{ .Payload.status | upper }}`},
+					{Key: "Triggered by", Value: `{{ .Payload.author }}`},
+				},
+			},
+			expectedSection: api.Section{
+				Base: api.Base{
+					Header:      "❗ Pipeline release failed",
+					Description: "See https://ci.example.com/builds/42",
+				},
+				TextFields: api.TextFields{
+					{Key: "Status", Value: "FAILED"},
+				},
+			},
+		},
+		{
+			name: "Headers without credentials",
+			template: Template{
+				Header: `Pipeline {{ .Payload.pipeline }}`,
+				Body:   `{{ .Headers.Get "Authorization" }}/{{ .Headers.Get "X-Signature-256" }}/{{ .Headers.Get "X-Request-Id" }}`,
+			},
+			expectedSection: api.Section{
+				Base: api.Base{
+					Header:      "Pipeline release",
+					Description: "//42",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			r := newRouter(loggerx.NewNoop())
+			ch := make(chan source.Event, 1)
+			endpoint := Endpoint{Path: "/ci", Token: "s3cr3t", HMAC: HMAC{Secret: "key", Header: defaultHMACHeader}, Template: tc.template}
+			require.NoError(t, r.Subscribe(context.Background(), "ci", endpoint, ch))
+
+			req := httptest.NewRequest(http.MethodPost, "/ci", strings.NewReader(fixPayload))
+			req.Header.Set("Authorization", "Bearer s3cr3t")
+			req.Header.Set(defaultHMACHeader, sign("key", fixPayload))
+			req.Header.Set("X-Request-Id", "42")
+			rec := httptest.NewRecorder()
+
+			// when
+			r.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Len(t, ch, 1)
+			event := <-ch
+			assert.Equal(t, api.NonInteractiveSingleSection, event.Message.Type)
+			require.Len(t, event.Message.Sections, 1)
+			assert.Equal(t, tc.expectedSection, event.Message.Sections[0])
+		})
+	}
+}
+
+func TestRouter_Subscribe(t *testing.T) {
+	// given
+	r := newRouter(loggerx.NewNoop())
+	endpoint := Endpoint{Path: "/ci", Token: "s3cr3t"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	interactiveCh := make(chan source.Event, 1)
+	nonInteractiveCh := make(chan source.Event, 1)
+
+	// when
+	require.NoError(t, r.Subscribe(ctx, "ci", endpoint, interactiveCh))
+	require.NoError(t, r.Subscribe(context.Background(), "ci", endpoint, nonInteractiveCh))
+	err := r.Subscribe(context.Background(), "cron", Endpoint{Path: "/ci", Token: "other"}, make(chan source.Event))
+
+	// then
+	require.EqualError(t, err, `the path "/ci" is already used by a different "ci" endpoint`)
+
+	// when
+	req := httptest.NewRequest(http.MethodPost, "/ci", strings.NewReader(fixPayload))
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, interactiveCh, 1)
+	assert.Len(t, nonInteractiveCh, 1)
+
+	// when
+	cancel()
+
+	// then
+	assert.Eventually(t, func() bool {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return len(r.routes["/ci"].subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRouter_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "Unknown path",
+			method:       http.MethodPost,
+			path:         "/unknown",
+			body:         fixPayload,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "Unsupported method",
+			method:       http.MethodGet,
+			path:         "/ci",
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:         "Invalid JSON",
+			method:       http.MethodPost,
+			path:         "/ci",
+			body:         "{",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			r := newRouter(loggerx.NewNoop())
+			ch := make(chan source.Event, 1)
+			require.NoError(t, r.Subscribe(context.Background(), "ci", Endpoint{Path: "/ci", Token: "s3cr3t"}, ch))
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Authorization", "Bearer s3cr3t")
+			rec := httptest.NewRecorder()
+
+			// when
+			r.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			assert.Empty(t, ch)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		expectedErr string
+	}{
+		{
+			name:        "No endpoints",
+			cfg:         Config{Port: 2116},
+			expectedErr: "at least one endpoint is required",
+		},
+		{
+			name:        "No authentication",
+			cfg:         Config{Port: 2116, Endpoints: map[string]Endpoint{"ci": {Path: "/ci"}}},
+			expectedErr: `the "ci" endpoint requires a token or an HMAC secret`,
+		},
+		{
+			name: "Duplicated path",
+			cfg: Config{Port: 2116, Endpoints: map[string]Endpoint{
+				"ci":   {Path: "/hooks", Token: "a"},
+				"cron": {Path: "/hooks", Token: "b"},
+			}},
+			expectedErr: `the "ci" and "cron" endpoints use the same path "/hooks"`,
+		},
+		{
+			name:        "Invalid template",
+			cfg:         Config{Port: 2116, Endpoints: map[string]Endpoint{"ci": {Path: "/ci", Token: "a", Template: Template{Header: "{{ .Payload"}}}},
+			expectedErr: `while parsing template of the "ci" endpoint`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := tc.cfg.Validate()
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/httpsrv"
+)
+
+const (
+	// PluginName is the name of the incoming webhook Botkube plugin.
+	PluginName = "webhook"
+
+	description = "Get notifications from CI systems, cron jobs and other tools which send HTTP webhooks with a JSON payload."
+)
+
+// Source incoming webhook source plugin data structure
+type Source struct {
+	pluginVersion string
+
+	// routers holds routers indexed by port. All streams share the HTTP servers, as they run in the same plugin process.
+	mu      sync.Mutex
+	routers map[int]*router
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+		routers:       map[int]*router{},
+	}
+}
+
+// Stream streams events received by the configured webhook endpoints.
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	r := s.routerForPort(log, cfg.Port)
+
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	for _, name := range cfg.endpointNames() {
+		if err := r.Subscribe(ctx, name, cfg.Endpoints[name], out.Event); err != nil {
+			return source.StreamOutput{}, err
+		}
+		log.Infof("Listening for webhooks on %q for the %q endpoint", cfg.Endpoints[name].Path, name)
+	}
+
+	return out, nil
+}
+
+// routerForPort returns a router for a given port. The HTTP server is started once per port and runs until the plugin process exits.
+func (s *Source) routerForPort(log logrus.FieldLogger, port int) *router {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, found := s.routers[port]; found {
+		return r
+	}
+
+	r := newRouter(log.WithField("component", "Webhook router"))
+	s.routers[port] = r
+
+	srv := httpsrv.New(log.WithField("component", "Webhook server"), fmt.Sprintf(":%d", port), r)
+	go func() {
+		if err := srv.Serve(context.Background()); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	return r
+}
+
+// Metadata returns metadata of incoming webhook configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Incoming webhook",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"port": {
+			  "title": "Port",
+			  "description": "Port on which all endpoints are exposed.",
+			  "type": "integer",
+			  "default": 2116
+			},
+			"endpoints": {
+			  "title": "Endpoints",
+			  "description": "Webhook endpoints indexed by their names.",
+			  "type": "object",
+			  "minProperties": 1,
+			  "additionalProperties": {
+				"type": "object",
+				"properties": {
+				  "path": {
+					"title": "Path",
+					"description": "HTTP path of the endpoint. Defaults to /{name}.",
+					"type": "string"
+				  },
+				  "token": {
+					"title": "Token",
+					"description": "Shared token that must be sent in the 'Authorization: Bearer {token}' header.",
+					"type": "string"
+				  },
+				  "hmac": {
+					"title": "HMAC",
+					"description": "HMAC-SHA256 signature verification of the request body.",
+					"type": "object",
+					"properties": {
+					  "secret": {
+						"title": "Secret",
+						"description": "Key used to sign the request body.",
+						"type": "string"
+					  },
+					  "header": {
+						"title": "Header",
+						"description": "Name of the header with the hex-encoded signature, optionally prefixed with 'sha256='.",
+						"type": "string",
+						"default": "X-Signature-256"
+					  }
+					}
+				  },
+				  "template": {
+					"title": "Template",
+					"description": "Go templates of the message. Templates have access to the .Endpoint, .Payload and .Headers fields. Headers exclude credentials, such as the Authorization and signature headers. If not specified, the payload is rendered as a JSON code block.",
+					"type": "object",
+					"properties": {
+					  "header": {
+						"title": "Header",
+						"type": "string"
+					  },
+					  "body": {
+						"title": "Body",
+						"type": "string"
+					  },
+					  "fields": {
+						"title": "Fields",
+						"type": "array",
+						"items": {
+						  "type": "object",
+						  "properties": {
+							"key": {
+							  "type": "string"
+							},
+							"value": {
+							  "type": "string"
+							}
+						  },
+						  "required": ["key", "value"]
+						}
+					  }
+					}
+				  }
+				}
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  },
+		  "required": ["endpoints"]
+		}`, description),
+	}
+}
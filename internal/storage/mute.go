@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/internal/mute"
+)
+
+const mutesKey = "mutes"
+
+// Mutes provides functionality to persist muted notifications.
+type Mutes struct {
+	systemConfigMapName      string
+	systemConfigMapNamespace string
+
+	k8sCli kubernetes.Interface
+}
+
+var _ mute.Persister = (*Mutes)(nil)
+
+// NewForMutes returns a new Mutes instance.
+func NewForMutes(ns, name string, k8sCli kubernetes.Interface) *Mutes {
+	return &Mutes{
+		systemConfigMapNamespace: ns,
+		systemConfigMapName:      name,
+		k8sCli:                   k8sCli,
+	}
+}
+
+// GetMutes returns persisted mutes.
+func (m *Mutes) GetMutes(ctx context.Context) ([]mute.Entry, error) {
+	obj, err := m.k8sCli.CoreV1().ConfigMaps(m.systemConfigMapNamespace).Get(ctx, m.systemConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("while getting the Config Map: %w", err)
+	}
+
+	data, found := obj.Data[mutesKey]
+	if !found {
+		return nil, nil
+	}
+
+	var out []mute.Entry
+	if err := json.Unmarshal([]byte(data), &out); err != nil {
+		return nil, fmt.Errorf("while unmarshaling the mutes data: %w", err)
+	}
+	return out, nil
+}
+
+// SaveMutes persists given mutes. Previously persisted mutes are overridden.
+func (m *Mutes) SaveMutes(ctx context.Context, entries []mute.Entry) error {
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("while marshaling mutes: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.systemConfigMapName,
+			Namespace: m.systemConfigMapNamespace,
+		},
+		Data: map[string]string{
+			mutesKey: string(raw),
+		},
+	}
+
+	_, err = m.k8sCli.CoreV1().ConfigMaps(m.systemConfigMapNamespace).Create(ctx, cm, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsAlreadyExists(err):
+		old, err := m.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("while getting already existing ConfigMap: %w", err)
+		}
+
+		newCM := old.DeepCopy()
+		if newCM.Data == nil {
+			newCM.Data = map[string]string{}
+		}
+		newCM.Data[mutesKey] = string(raw)
+
+		_, err = m.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, newCM, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("while updating the ConfigMap with mutes: %w", err)
+		}
+	default:
+		return fmt.Errorf("while creating the ConfigMap with mutes: %w", err)
+	}
+
+	return nil
+}
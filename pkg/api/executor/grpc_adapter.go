@@ -45,6 +45,17 @@ type (
 		// This is an alpha feature and may change in the future.
 		// Most likely, it will be generalized to support all communication platforms.
 		SlackState *slack.BlockActionStates
+
+		// User holds details about the user who executed the command.
+		User UserInput
+	}
+
+	// UserInput holds details about the user who executed the command.
+	UserInput struct {
+		// Mention is the platform-specific mention of the user, such as "<@U123>" on Slack.
+		Mention string
+		// DisplayName is the user display name.
+		DisplayName string
 	}
 
 	// ExecuteOutput holds the output of the Execute function.
@@ -119,6 +130,10 @@ func (p *grpcClient) Execute(ctx context.Context, in ExecuteInput) (ExecuteOutpu
 		Context: &ExecuteContext{
 			IsInteractivitySupported: in.Context.IsInteractivitySupported,
 			KubeConfig:               in.Context.KubeConfig,
+			User: &UserContext{
+				Mention:     in.Context.User.Mention,
+				DisplayName: in.Context.User.DisplayName,
+			},
 		},
 	}
 
@@ -197,6 +212,10 @@ func (p *grpcServer) Execute(ctx context.Context, request *ExecuteRequest) (*Exe
 			SlackState:               &slackState,
 			IsInteractivitySupported: request.Context.IsInteractivitySupported,
 			KubeConfig:               request.Context.KubeConfig,
+			User: UserInput{
+				Mention:     request.Context.GetUser().GetMention(),
+				DisplayName: request.Context.GetUser().GetDisplayName(),
+			},
 		},
 	})
 	if err != nil {
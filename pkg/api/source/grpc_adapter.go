@@ -39,6 +39,9 @@ type (
 
 		// ClusterName is the name of underlying Kubernetes cluster which is provided by end user.
 		ClusterName string
+
+		// SourceName is the name of the source configuration which the stream is started for.
+		SourceName string
 	}
 
 	// StreamOutput holds the output of the Stream function.
@@ -128,6 +131,7 @@ func (p *grpcClient) Stream(ctx context.Context, in StreamInput) (StreamOutput,
 			IsInteractivitySupported: in.Context.IsInteractivitySupported,
 			KubeConfig:               in.Context.KubeConfig,
 			ClusterName:              in.Context.ClusterName,
+			SourceName:               in.Context.SourceName,
 		},
 	}
 	stream, err := p.client.Stream(ctx, request)
@@ -220,6 +224,7 @@ func (p *grpcServer) Stream(req *StreamRequest, gstream Source_StreamServer) err
 			IsInteractivitySupported: req.Context.IsInteractivitySupported,
 			KubeConfig:               req.Context.KubeConfig,
 			ClusterName:              req.Context.ClusterName,
+			SourceName:               req.Context.SourceName,
 		},
 	})
 	if err != nil {
@@ -0,0 +1,13 @@
+package bot
+
+import (
+	"github.com/kubeshop/botkube/internal/ack"
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// AckStore keeps track of sent notifications and their acknowledgements.
+type AckStore interface {
+	Track(channel mute.Channel, target mute.Target, messageID string, msg interactive.CoreMessage)
+	GetAcknowledged(channel mute.Channel, target mute.Target) (ack.Entry, bool)
+}
@@ -33,6 +33,11 @@ const (
 
 	// discordMaxMessageSize max size before a message should be uploaded as a file.
 	discordMaxMessageSize = 2000
+
+	// discordThreadArchiveDuration defines after how many minutes of inactivity the notification thread is archived.
+	discordThreadArchiveDuration = 1440
+	// discordMaxThreadNameLength is the max length of the thread name allowed by Discord.
+	discordMaxThreadNameLength = 100
 )
 
 // Discord listens for user's message, execute commands and sends back the response.
@@ -48,6 +53,8 @@ type Discord struct {
 	botMentionRegex *regexp.Regexp
 	commGroupName   string
 	renderer        *DiscordRenderer
+	mutes           channelMutes
+	threads         *threadStore
 }
 
 // discordMessage contains message details to execute command and send back the result.
@@ -56,7 +63,7 @@ type discordMessage struct {
 }
 
 // NewDiscord creates a new Discord instance.
-func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Discord, error) {
+func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, reporter AnalyticsReporter, mutes MuteStore) (*Discord, error) {
 	botMentionRegex, err := discordBotMentionRegex(cfg.BotID)
 	if err != nil {
 		return nil, err
@@ -74,6 +81,8 @@ func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord
 
 	return &Discord{
 		log:             log,
+		mutes:           newChannelMutes(mutes, commGroupName, config.DiscordCommPlatformIntegration),
+		threads:         newThreadStore(cfg.Threads),
 		reporter:        reporter,
 		executorFactory: executorFactory,
 		api:             api,
@@ -112,6 +121,10 @@ func (b *Discord) Start(ctx context.Context) error {
 
 	b.log.Info("Botkube connected to Discord!")
 
+	go b.mutes.WatchExpired(ctx, b.log, func(_ context.Context, channelID string, msg interactive.CoreMessage) error {
+		return b.send(channelID, msg)
+	})
+
 	<-ctx.Done()
 	b.log.Info("Shutdown requested. Finishing...")
 	err = b.api.Close()
@@ -127,7 +140,11 @@ func (b *Discord) Start(ctx context.Context) error {
 func (b *Discord) SendMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
-		err := b.send(channelID, msg)
+		if b.mutes.IsMuted(channelID, msg) {
+			b.log.Debugf("Skipping notification for channel %q as %s is muted.", channelID, msg.Object.DisplayName())
+			continue
+		}
+		err := b.sendNotification(channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
 			continue
@@ -260,19 +277,70 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 }
 
 func (b *Discord) send(channelID string, resp interactive.CoreMessage) error {
+	_, err := b.post(channelID, resp)
+	return err
+}
+
+// post sends a given message and returns its ID.
+func (b *Discord) post(channelID string, resp interactive.CoreMessage) (string, error) {
 	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
 
 	discordMsg, err := b.formatMessage(resp)
 	if err != nil {
-		return fmt.Errorf("while formatting message: %w", err)
+		return "", fmt.Errorf("while formatting message: %w", err)
 	}
-	if _, err := b.api.ChannelMessageSendComplex(channelID, discordMsg); err != nil {
-		return fmt.Errorf("while sending message: %w", err)
+	sent, err := b.api.ChannelMessageSendComplex(channelID, discordMsg)
+	if err != nil {
+		return "", fmt.Errorf("while sending message: %w", err)
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", channelID)
+	return sent.ID, nil
+}
+
+// sendNotification sends a given notification. If thread grouping is enabled, follow-up notifications about the same object
+// are sent to a thread started from the first one, which is updated to show the latest state.
+func (b *Discord) sendNotification(channelID string, msg interactive.CoreMessage) error {
+	thread, found := b.threads.Get(channelID, msg.Object)
+	if !found {
+		msgID, err := b.post(channelID, msg)
+		if err != nil {
+			return err
+		}
+		b.threads.Put(channelID, msg.Object, messageThread{ParentID: msgID})
+		return nil
+	}
+
+	if thread.ID == "" {
+		name := msg.Object.DisplayName()
+		if len(name) > discordMaxThreadNameLength {
+			name = name[:discordMaxThreadNameLength]
+		}
+		ch, err := b.api.MessageThreadStart(channelID, thread.ParentID, name, discordThreadArchiveDuration)
+		if err != nil {
+			return fmt.Errorf("while starting thread: %w", err)
+		}
+		thread.ID = ch.ID
+		b.threads.Put(channelID, msg.Object, thread)
+	}
+
+	if err := b.send(thread.ID, msg); err != nil {
+		return fmt.Errorf("while sending thread reply: %w", err)
+	}
+
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	discordMsg, err := b.formatMessage(msg)
+	if err != nil {
+		return fmt.Errorf("while formatting message: %w", err)
+	}
+	edit := discordgo.NewMessageEdit(channelID, thread.ParentID).SetContent(discordMsg.Content)
+	// empty embeds clear the previous state
+	edit.Embeds = append([]*discordgo.MessageEmbed{}, discordMsg.Embeds...)
+	if _, err := b.api.ChannelMessageEditComplex(edit); err != nil {
+		return fmt.Errorf("while updating parent message: %w", err)
+	}
 	return nil
 }
 
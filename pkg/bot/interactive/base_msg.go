@@ -1,6 +1,7 @@
 package interactive
 
 import (
+	"github.com/kubeshop/botkube/internal/mute"
 	"github.com/kubeshop/botkube/pkg/api"
 )
 
@@ -9,5 +10,7 @@ type CoreMessage struct {
 	Header      string
 	Description string
 	Metadata    any
+	// Object identifies the object the message is about, if any. It's used to suppress notifications for muted objects.
+	Object *mute.Target
 	api.Message
 }
@@ -63,6 +63,8 @@ type Mattermost struct {
 	botMentionRegex *regexp.Regexp
 	renderer        *MattermostRenderer
 	userNamesForID  map[string]string
+	mutes           channelMutes
+	threads         *threadStore
 }
 
 // mattermostMessage contains message details to execute command and send back the result
@@ -72,7 +74,7 @@ type mattermostMessage struct {
 }
 
 // NewMattermost creates a new Mattermost instance.
-func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Mattermost, error) {
+func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, reporter AnalyticsReporter, mutes MuteStore) (*Mattermost, error) {
 	botMentionRegex, err := mattermostBotMentionRegex(cfg.BotName)
 	if err != nil {
 		return nil, err
@@ -118,6 +120,8 @@ func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Matt
 
 	return &Mattermost{
 		log:             log,
+		mutes:           newChannelMutes(mutes, commGroupName, config.MattermostCommPlatformIntegration),
+		threads:         newThreadStore(cfg.Threads),
 		executorFactory: executorFactory,
 		reporter:        reporter,
 		serverURL:       cfg.URL,
@@ -153,6 +157,9 @@ func (b *Mattermost) Start(ctx context.Context) error {
 	// For now, we are adding retry logic to reconnect to the server
 	// https://github.com/kubeshop/botkube/issues/201
 	b.log.Info("Botkube connected to Mattermost!")
+	go b.mutes.WatchExpired(ctx, b.log, func(_ context.Context, channelID string, msg interactive.CoreMessage) error {
+		return b.send(channelID, msg)
+	})
 	for {
 		select {
 		case <-ctx.Done():
@@ -278,19 +285,66 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm *mattermostMessage) e
 
 // Send messages to Mattermost
 func (b *Mattermost) send(channelID string, resp interactive.CoreMessage) error {
+	_, err := b.post(channelID, "", resp)
+	return err
+}
+
+// post sends a given message, optionally as a reply to a given root post, and returns the created post ID.
+// The ID is empty if the post wasn't created.
+func (b *Mattermost) post(channelID, rootID string, resp interactive.CoreMessage) (string, error) {
 	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
 	post, err := b.formatMessage(resp, channelID)
 	if err != nil {
-		return fmt.Errorf("while formatting message: %w", err)
+		return "", fmt.Errorf("while formatting message: %w", err)
 	}
+	post.RootId = rootID
 
-	if _, _, err := b.apiClient.CreatePost(post); err != nil {
+	created, _, err := b.apiClient.CreatePost(post)
+	if err != nil {
 		b.log.Error("Failed to send message. Error: ", err)
+		return "", nil
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", channelID)
+	return created.Id, nil
+}
+
+// sendNotification sends a given notification. If thread grouping is enabled, follow-up notifications about the same object
+// are sent as replies to the first one, which is updated to show the latest state.
+func (b *Mattermost) sendNotification(channelID string, msg interactive.CoreMessage) error {
+	thread, found := b.threads.Get(channelID, msg.Object)
+	if !found {
+		postID, err := b.post(channelID, "", msg)
+		if err != nil {
+			return err
+		}
+		b.threads.Put(channelID, msg.Object, messageThread{ParentID: postID})
+		return nil
+	}
+
+	if _, err := b.post(channelID, thread.ParentID, msg); err != nil {
+		return fmt.Errorf("while sending thread reply: %w", err)
+	}
+
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	post, err := b.formatMessage(msg, channelID)
+	if err != nil {
+		return fmt.Errorf("while formatting message: %w", err)
+	}
+	// empty props clear attachments of the previous state
+	props := post.GetProps()
+	if props == nil {
+		props = model.StringInterface{}
+	}
+	if _, _, err := b.apiClient.PatchPost(thread.ParentID, &model.PostPatch{
+		Message: &post.Message,
+		Props:   &props,
+		FileIds: &post.FileIds,
+	}); err != nil {
+		return fmt.Errorf("while updating parent message: %w", err)
+	}
 	return nil
 }
 
@@ -415,7 +469,11 @@ func (b *Mattermost) getChannelsToNotify(eventSources []string) []string {
 func (b *Mattermost) SendMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
-		err := b.send(channelID, msg)
+		if b.mutes.IsMuted(channelID, msg) {
+			b.log.Debugf("Skipping notification for channel %q as %s is muted.", channelID, msg.Object.DisplayName())
+			continue
+		}
+		err := b.sendNotification(channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
 			continue
@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const muteExpiryCheckInterval = time.Minute
+
+// MuteStore holds notification mutes for all channels.
+type MuteStore interface {
+	ShouldSuppress(channel mute.Channel, target *mute.Target) bool
+	PopExpired(ctx context.Context, commGroup string, platform config.CommPlatformIntegration) ([]mute.Entry, error)
+}
+
+// channelMutes suppresses notifications for muted objects in channels of a given bot.
+type channelMutes struct {
+	store     MuteStore
+	commGroup string
+	platform  config.CommPlatformIntegration
+}
+
+func newChannelMutes(store MuteStore, commGroup string, platform config.CommPlatformIntegration) channelMutes {
+	return channelMutes{
+		store:     store,
+		commGroup: commGroup,
+		platform:  platform,
+	}
+}
+
+// IsMuted returns true if a given message shouldn't be sent to a given channel.
+func (m channelMutes) IsMuted(channelID string, msg interactive.CoreMessage) bool {
+	if m.store == nil || msg.Object == nil {
+		return false
+	}
+
+	return m.store.ShouldSuppress(mute.Channel{
+		CommGroup: m.commGroup,
+		Platform:  m.platform,
+		ID:        channelID,
+	}, msg.Object)
+}
+
+// WatchExpired periodically removes expired mutes and notifies the channels about them.
+func (m channelMutes) WatchExpired(ctx context.Context, log logrus.FieldLogger, send func(ctx context.Context, channelID string, msg interactive.CoreMessage) error) {
+	if m.store == nil {
+		return
+	}
+
+	ticker := time.NewTicker(muteExpiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := m.store.PopExpired(ctx, m.commGroup, m.platform)
+			if err != nil {
+				log.Errorf("while removing expired mutes: %s", err.Error())
+				continue
+			}
+
+			for _, entry := range expired {
+				if err := send(ctx, entry.Channel.ID, expiredMuteMessage(entry)); err != nil {
+					log.Errorf("while sending expired mute message to channel %q: %s", entry.Channel.ID, err.Error())
+				}
+			}
+		}
+	}
+}
+
+func expiredMuteMessage(entry mute.Entry) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.Message{
+			BaseBody: api.Body{
+				Plaintext: fmt.Sprintf("🔔 Notifications for %s are unmuted. %d notification(s) were suppressed while muted.", entry.Target.DisplayName(), entry.Suppressed),
+			},
+		},
+	}
+}
@@ -48,6 +48,7 @@ type Slack struct {
 	botMentionRegex *regexp.Regexp
 	commGroupName   string
 	renderer        *SlackRenderer
+	mutes           channelMutes
 }
 
 // slackMessage contains message details to execute command and send back the result
@@ -59,7 +60,7 @@ type slackMessage struct {
 }
 
 // NewSlack creates a new Slack instance.
-func NewSlack(log logrus.FieldLogger, commGroupName string, cfg config.Slack, executorFactory ExecutorFactory, reporter FatalErrorAnalyticsReporter) (*Slack, error) {
+func NewSlack(log logrus.FieldLogger, commGroupName string, cfg config.Slack, executorFactory ExecutorFactory, reporter FatalErrorAnalyticsReporter, mutes MuteStore) (*Slack, error) {
 	client := slack.New(cfg.Token)
 
 	authResp, err := client.AuthTest()
@@ -80,6 +81,7 @@ func NewSlack(log logrus.FieldLogger, commGroupName string, cfg config.Slack, ex
 
 	return &Slack{
 		log:             log,
+		mutes:           newChannelMutes(mutes, commGroupName, config.SlackCommPlatformIntegration),
 		executorFactory: executorFactory,
 		reporter:        reporter,
 		botID:           botID,
@@ -100,6 +102,12 @@ func (b *Slack) Start(ctx context.Context) error {
 		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
 		rtm.ManageConnection()
 	}()
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.mutes.WatchExpired(ctx, b.log, func(ctx context.Context, channelID string, msg interactive.CoreMessage) error {
+			return b.send(ctx, slackMessage{Channel: channelID}, msg, false)
+		})
+	}()
 
 	for {
 		select {
@@ -314,6 +322,10 @@ func (b *Slack) getChannelsToNotify(sourceBindings []string) []string {
 func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+		if b.mutes.IsMuted(channelName, msg) {
+			b.log.Debugf("Skipping notification for channel %q as %s is muted.", channelName, msg.Object.DisplayName())
+			continue
+		}
 		msgMetadata := slackMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
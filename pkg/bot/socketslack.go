@@ -14,7 +14,9 @@ import (
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
 
+	"github.com/kubeshop/botkube/internal/ack"
 	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/mute"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
@@ -45,19 +47,24 @@ type SocketSlack struct {
 	botMentionRegex *regexp.Regexp
 	commGroupName   string
 	renderer        *SlackRenderer
+	mutes           channelMutes
+	acks            AckStore
+	threads         *threadStore
 }
 
 type socketSlackMessage struct {
 	Text            string
 	Channel         string
 	ThreadTimeStamp string
-	UserID          string
-	UserName        string
-	TriggerID       string
-	CommandOrigin   command.Origin
-	State           *slack.BlockActionStates
-	ResponseURL     string
-	BlockID         string
+	// MessageTimeStamp is a timestamp of the message with the clicked button.
+	MessageTimeStamp string
+	UserID           string
+	UserName         string
+	TriggerID        string
+	CommandOrigin    command.Origin
+	State            *slack.BlockActionStates
+	ResponseURL      string
+	BlockID          string
 }
 
 // socketSlackAnalyticsReporter defines a reporter that collects analytics data.
@@ -67,7 +74,7 @@ type socketSlackAnalyticsReporter interface {
 }
 
 // NewSocketSlack creates a new SocketSlack instance.
-func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, executorFactory ExecutorFactory, reporter socketSlackAnalyticsReporter) (*SocketSlack, error) {
+func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, executorFactory ExecutorFactory, reporter socketSlackAnalyticsReporter, mutes MuteStore, acks AckStore) (*SocketSlack, error) {
 	client := slack.New(cfg.BotToken, slack.OptionAppLevelToken(cfg.AppToken))
 
 	authResp, err := client.AuthTest()
@@ -88,6 +95,9 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.Soc
 
 	return &SocketSlack{
 		log:             log,
+		mutes:           newChannelMutes(mutes, commGroupName, config.SocketSlackCommPlatformIntegration),
+		acks:            acks,
+		threads:         newThreadStore(cfg.Threads),
 		executorFactory: executorFactory,
 		reporter:        reporter,
 		botID:           botID,
@@ -115,6 +125,12 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 			}
 		}
 	}()
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.mutes.WatchExpired(ctx, b.log, func(ctx context.Context, channelID string, msg interactive.CoreMessage) error {
+			return b.send(ctx, socketSlackMessage{Channel: channelID, BlockID: uuid.New().String()}, msg)
+		})
+	}()
 
 	for {
 		select {
@@ -212,16 +228,17 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 					state := removeBotNameFromIDs(b.BotName(), callback.BlockActionState)
 
 					msg := socketSlackMessage{
-						Text:            cmd,
-						Channel:         channelID,
-						ThreadTimeStamp: threadTs,
-						TriggerID:       callback.TriggerID,
-						UserID:          callback.User.ID,
-						UserName:        callback.User.RealName,
-						CommandOrigin:   cmdOrigin,
-						State:           state,
-						ResponseURL:     callback.ResponseURL,
-						BlockID:         act.BlockID,
+						Text:             cmd,
+						Channel:          channelID,
+						ThreadTimeStamp:  threadTs,
+						MessageTimeStamp: callback.MessageTs,
+						TriggerID:        callback.TriggerID,
+						UserID:           callback.User.ID,
+						UserName:         callback.User.RealName,
+						CommandOrigin:    cmdOrigin,
+						State:            state,
+						ResponseURL:      callback.ResponseURL,
+						BlockID:          act.BlockID,
 					}
 					if err := b.handleMessage(ctx, msg); err != nil {
 						b.log.Errorf("Message handling error: %s", err.Error())
@@ -358,6 +375,7 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event socketSlackMessag
 			IsAuthenticated:  isAuthChannel,
 			CommandOrigin:    event.CommandOrigin,
 			SlackState:       event.State,
+			MessageID:        event.MessageTimeStamp,
 		},
 		Message: request,
 		User: execute.UserInput{
@@ -374,14 +392,90 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event socketSlackMessag
 	return nil
 }
 
+// sendNotification sends a notification with acknowledgement buttons if it's about a given object.
+// Notifications for already acknowledged objects are sent to the thread of the acknowledged message instead.
+// If thread grouping is enabled, follow-up notifications are sent to the thread of the first one, which is updated to show the latest state.
+func (b *SocketSlack) sendNotification(ctx context.Context, event socketSlackMessage, msg interactive.CoreMessage) error {
+	if msg.Object == nil {
+		return b.send(ctx, event, msg)
+	}
+
+	channel := mute.Channel{
+		CommGroup: b.commGroupName,
+		Platform:  b.IntegrationName(),
+		ID:        event.Channel,
+	}
+	if b.acks != nil {
+		if entry, found := b.acks.GetAcknowledged(channel, *msg.Object); found {
+			event.ThreadTimeStamp = entry.MessageID
+			return b.send(ctx, event, ack.RecurringMessage(msg, entry))
+		}
+	}
+
+	parentMsg := msg
+	if b.acks != nil {
+		parentMsg.Sections = append(append([]api.Section{}, msg.Sections...), ack.ButtonsSection(*msg.Object))
+	}
+
+	if thread, found := b.threads.Get(event.Channel, msg.Object); found {
+		reply := event
+		reply.ThreadTimeStamp = thread.ParentID
+		if err := b.send(ctx, reply, msg); err != nil {
+			return fmt.Errorf("while sending thread reply: %w", err)
+		}
+		if err := b.update(ctx, thread.ChannelID, thread.ParentID, parentMsg); err != nil {
+			return fmt.Errorf("while updating parent message: %w", err)
+		}
+		b.track(channel, *msg.Object, thread.ParentID, msg)
+		return nil
+	}
+
+	channelID, ts, err := b.post(ctx, event, parentMsg)
+	if err != nil {
+		return err
+	}
+
+	b.threads.Put(event.Channel, msg.Object, messageThread{ParentID: ts, ChannelID: channelID})
+	b.track(channel, *msg.Object, ts, msg)
+	return nil
+}
+
+func (b *SocketSlack) track(channel mute.Channel, target mute.Target, messageID string, msg interactive.CoreMessage) {
+	if b.acks == nil {
+		return
+	}
+	b.acks.Track(channel, target, messageID, msg)
+}
+
+// update replaces a given message with a new content.
+func (b *SocketSlack) update(ctx context.Context, channelID, ts string, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	if len(b.renderer.MessageToMarkdown(msg)) >= slackMaxMessageSize {
+		b.log.Debugf("Skipping update of message %q in channel %q as the new content is too long.", ts, channelID)
+		return nil
+	}
+
+	if _, _, _, err := b.client.UpdateMessageContext(ctx, channelID, ts, b.renderer.RenderInteractiveMessage(msg)); err != nil {
+		return fmt.Errorf("while updating Slack message: %w", err)
+	}
+	return nil
+}
+
 func (b *SocketSlack) send(ctx context.Context, event socketSlackMessage, resp interactive.CoreMessage) error {
+	_, _, err := b.post(ctx, event, resp)
+	return err
+}
+
+// post sends a given message and returns ID of the channel it was sent to together with its timestamp.
+// Both are empty if the message was sent as ephemeral or modal.
+func (b *SocketSlack) post(ctx context.Context, event socketSlackMessage, resp interactive.CoreMessage) (string, string, error) {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
 	markdown := b.renderer.MessageToMarkdown(resp)
 
 	if len(markdown) == 0 {
-		return errors.New("while reading Slack response: empty response")
+		return "", "", errors.New("while reading Slack response: empty response")
 	}
 
 	// Upload message as a file if too long
@@ -390,7 +484,7 @@ func (b *SocketSlack) send(ctx context.Context, event socketSlackMessage, resp i
 	if len(markdown) >= slackMaxMessageSize {
 		file, err = uploadFileToSlack(ctx, event.Channel, resp, b.client, event.ThreadTimeStamp)
 		if err != nil {
-			return err
+			return "", "", err
 		}
 		resp = interactive.CoreMessage{
 			Message: api.Message{
@@ -405,9 +499,9 @@ func (b *SocketSlack) send(ctx context.Context, event socketSlackMessage, resp i
 		modalView.PrivateMetadata = event.Channel
 		_, err := b.client.OpenViewContext(ctx, event.TriggerID, modalView)
 		if err != nil {
-			return fmt.Errorf("while opening modal: %w", err)
+			return "", "", fmt.Errorf("while opening modal: %w", err)
 		}
-		return nil
+		return "", "", nil
 	}
 
 	options := []slack.MsgOption{
@@ -422,18 +516,20 @@ func (b *SocketSlack) send(ctx context.Context, event socketSlackMessage, resp i
 		options = append(options, slack.MsgOptionReplaceOriginal(event.ResponseURL))
 	}
 
+	var channelID, ts string
 	if resp.OnlyVisibleForYou {
 		if _, err := b.client.PostEphemeralContext(ctx, event.Channel, event.UserID, options...); err != nil {
-			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
+			return "", "", fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
-			return fmt.Errorf("while posting Slack message: %w", err)
+		channelID, ts, err = b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
+			return "", "", fmt.Errorf("while posting Slack message: %w", err)
 		}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
-	return nil
+	return channelID, ts, nil
 }
 
 func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
@@ -457,13 +553,17 @@ func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
 func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+		if b.mutes.IsMuted(channelName, msg) {
+			b.log.Debugf("Skipping notification for channel %q as %s is muted.", channelName, msg.Object.DisplayName())
+			continue
+		}
 		msgMetadata := socketSlackMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
 			BlockID:         uuid.New().String(),
 			CommandOrigin:   command.AutomationOrigin,
 		}
-		err := b.send(ctx, msgMetadata, msg)
+		err := b.sendNotification(ctx, msgMetadata, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
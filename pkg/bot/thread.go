@@ -0,0 +1,135 @@
+package bot
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	defaultThreadWindow = time.Hour
+	// maxThreadStoreSize limits the number of tracked threads. The oldest threads are evicted first.
+	maxThreadStoreSize = 1000
+)
+
+// messageThread describes a parent message for notifications about a given object.
+type messageThread struct {
+	// ParentID is a platform-specific ID of the parent message.
+	ParentID string
+	// ChannelID is a platform-specific ID of the channel with the parent message.
+	// It's set only if the platform requires it instead of the channel name to update the message.
+	ChannelID string
+	// ID is a platform-specific ID of the thread. It's empty if the platform identifies threads by the parent message,
+	// or the thread wasn't started yet.
+	ID string
+
+	createdAt time.Time
+}
+
+type threadKey struct {
+	channelID string
+	kind      string
+	namespace string
+	name      string
+}
+
+// threadStore maps objects to threads in which notifications about them are grouped.
+// Threads expire after a given time window counted from the parent message. A nil store disables grouping.
+type threadStore struct {
+	window  time.Duration
+	maxSize int
+	now     func() time.Time
+
+	mu      sync.Mutex
+	threads map[threadKey]messageThread
+}
+
+// newThreadStore returns a new threadStore instance, or nil if the thread grouping is disabled.
+func newThreadStore(cfg config.Threads) *threadStore {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultThreadWindow
+	}
+	return &threadStore{
+		window:  window,
+		maxSize: maxThreadStoreSize,
+		now:     time.Now,
+		threads: map[threadKey]messageThread{},
+	}
+}
+
+// Get returns an active thread for a given object in a given channel.
+func (s *threadStore) Get(channelID string, target *mute.Target) (messageThread, bool) {
+	if s == nil || target == nil {
+		return messageThread{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	thread, found := s.threads[threadKeyFor(channelID, *target)]
+	if !found || s.isExpired(thread) {
+		return messageThread{}, false
+	}
+	return thread, true
+}
+
+// Put stores a thread for a given object in a given channel. Threads returned by Get keep their original expiry.
+func (s *threadStore) Put(channelID string, target *mute.Target, thread messageThread) {
+	if s == nil || target == nil || thread.ParentID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := threadKeyFor(channelID, *target)
+	if _, found := s.threads[key]; !found {
+		s.makeRoom()
+	}
+	if thread.createdAt.IsZero() {
+		thread.createdAt = s.now()
+	}
+	s.threads[key] = thread
+}
+
+func (s *threadStore) makeRoom() {
+	for key, thread := range s.threads {
+		if s.isExpired(thread) {
+			delete(s.threads, key)
+		}
+	}
+
+	for len(s.threads) >= s.maxSize {
+		var (
+			oldestKey threadKey
+			oldest    time.Time
+		)
+		for key, thread := range s.threads {
+			if oldest.IsZero() || thread.createdAt.Before(oldest) {
+				oldestKey, oldest = key, thread.createdAt
+			}
+		}
+		delete(s.threads, oldestKey)
+	}
+}
+
+func (s *threadStore) isExpired(thread messageThread) bool {
+	return s.now().Sub(thread.createdAt) >= s.window
+}
+
+func threadKeyFor(channelID string, target mute.Target) threadKey {
+	return threadKey{
+		channelID: channelID,
+		kind:      strings.ToLower(target.Kind),
+		namespace: target.Namespace,
+		name:      target.Name,
+	}
+}
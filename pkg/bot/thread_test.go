@@ -0,0 +1,92 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestThreadStore(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := newThreadStore(config.Threads{Enabled: true, Window: 30 * time.Minute})
+	store.now = func() time.Time { return now }
+	deploy := &mute.Target{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+
+	// when
+	store.Put("alerts", deploy, messageThread{ParentID: "parent-1"})
+
+	// then
+	thread, found := store.Get("alerts", &mute.Target{Kind: "deployment", Namespace: "default", Name: "nginx"})
+	require.True(t, found)
+	assert.Equal(t, "parent-1", thread.ParentID)
+
+	_, found = store.Get("general", deploy)
+	assert.False(t, found)
+	_, found = store.Get("alerts", nil)
+	assert.False(t, found)
+
+	// when
+	now = now.Add(20 * time.Minute)
+	thread.ID = "thread-1"
+	store.Put("alerts", deploy, thread)
+
+	// then
+	thread, found = store.Get("alerts", deploy)
+	require.True(t, found)
+	assert.Equal(t, "thread-1", thread.ID)
+
+	// when
+	now = now.Add(10 * time.Minute)
+
+	// then
+	_, found = store.Get("alerts", deploy)
+	assert.False(t, found, "thread should expire after the window counted from the parent message")
+}
+
+func TestThreadStore_Bounded(t *testing.T) {
+	// given
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := newThreadStore(config.Threads{Enabled: true})
+	store.now = func() time.Time { return now }
+	store.maxSize = 2
+
+	first := &mute.Target{Kind: "Pod", Namespace: "default", Name: "first"}
+	second := &mute.Target{Kind: "Pod", Namespace: "default", Name: "second"}
+	third := &mute.Target{Kind: "Pod", Namespace: "default", Name: "third"}
+
+	// when
+	store.Put("alerts", first, messageThread{ParentID: "1"})
+	now = now.Add(time.Minute)
+	store.Put("alerts", second, messageThread{ParentID: "2"})
+	now = now.Add(time.Minute)
+	store.Put("alerts", third, messageThread{ParentID: "3"})
+
+	// then
+	assert.Len(t, store.threads, 2)
+	_, found := store.Get("alerts", first)
+	assert.False(t, found, "the oldest thread should be evicted")
+	_, found = store.Get("alerts", second)
+	assert.True(t, found)
+	_, found = store.Get("alerts", third)
+	assert.True(t, found)
+}
+
+func TestThreadStore_Disabled(t *testing.T) {
+	// given
+	store := newThreadStore(config.Threads{Enabled: false})
+	target := &mute.Target{Kind: "Node", Name: "node-1"}
+
+	// when
+	store.Put("alerts", target, messageThread{ParentID: "1"})
+	_, found := store.Get("alerts", target)
+
+	// then
+	assert.Nil(t, store)
+	assert.False(t, found)
+}
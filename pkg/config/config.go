@@ -404,6 +404,14 @@ type SocketSlack struct {
 	Channels IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,dive,omitempty,min=1"`
 	BotToken string                                 `yaml:"botToken,omitempty"`
 	AppToken string                                 `yaml:"appToken,omitempty"`
+	Threads  Threads                                `yaml:"threads"`
+}
+
+// Threads contains configuration for grouping notifications about the same object in threads.
+type Threads struct {
+	Enabled bool `yaml:"enabled"`
+	// Window defines how long follow-up notifications are sent as replies to the first notification about a given object.
+	Window time.Duration `yaml:"window"`
 }
 
 // Elasticsearch config auth settings
@@ -442,6 +450,7 @@ type Mattermost struct {
 	Token    string                                 `yaml:"token"`
 	Team     string                                 `yaml:"team"`
 	Channels IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,dive,omitempty,min=1"`
+	Threads  Threads                                `yaml:"threads"`
 }
 
 // Teams creds for authentication with MS Teams
@@ -463,6 +472,7 @@ type Discord struct {
 	Token    string                               `yaml:"token"`
 	BotID    string                               `yaml:"botID"`
 	Channels IdentifiableMap[ChannelBindingsByID] `yaml:"channels"  validate:"required_if=Enabled true,dive,omitempty,min=1"`
+	Threads  Threads                              `yaml:"threads"`
 }
 
 // Webhook configuration to send notifications
@@ -0,0 +1,99 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/ack"
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+const (
+	ackNotSupportedMsgFmt = "Acknowledging notifications is not supported on the %q platform."
+	ackUsageMsgFmt        = "Usage: %s object {kind}/{namespace}/{name}"
+	ackedMsgFmt           = ":white_check_mark: %s: %s"
+)
+
+// AckStore tracks acknowledgements of sent notifications.
+type AckStore interface {
+	Acknowledge(channel mute.Channel, target mute.Target, user string, assign bool) ack.Entry
+}
+
+// AckExecutor executes commands that acknowledge notifications for a given object.
+// The acknowledgement is recorded in the audit reporter as any other executed command.
+type AckExecutor struct {
+	log   logrus.FieldLogger
+	store AckStore
+}
+
+// NewAckExecutor returns a new AckExecutor instance.
+func NewAckExecutor(log logrus.FieldLogger, store AckStore) *AckExecutor {
+	return &AckExecutor{
+		log:   log,
+		store: store,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *AckExecutor) FeatureName() FeatureName {
+	return objectFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *AckExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.AckVerb:    e.Acknowledge,
+		command.AssignVerb: e.Assign,
+	}
+}
+
+// Acknowledge marks notification for a given object as acknowledged by the user.
+func (e *AckExecutor) Acknowledge(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	return e.acknowledge(cmdCtx, command.AckVerb, false)
+}
+
+// Assign marks notification for a given object as assigned to the user.
+func (e *AckExecutor) Assign(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	return e.acknowledge(cmdCtx, command.AssignVerb, true)
+}
+
+func (e *AckExecutor) acknowledge(cmdCtx CommandContext, verb command.Verb, assign bool) (interactive.CoreMessage, error) {
+	if e.store == nil || !cmdCtx.Platform.IsInteractive() {
+		return respond(fmt.Sprintf(ackNotSupportedMsgFmt, cmdCtx.Platform), cmdCtx), nil
+	}
+
+	// {ack|assign} object {target}
+	if len(cmdCtx.Args) != 3 {
+		return interactive.CoreMessage{}, NewExecutionCommandError(ackUsageMsgFmt, verb)
+	}
+
+	target, err := mute.ParseTarget(cmdCtx.Args[2])
+	if err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError(invalidMuteObjectErrFmt, err.Error())
+	}
+
+	entry := e.store.Acknowledge(muteChannel(cmdCtx), target, ackUser(cmdCtx.User), assign)
+	e.log.WithFields(logrus.Fields{
+		"object": target.String(),
+		"user":   cmdCtx.User.DisplayName,
+	}).Infof("Notification %s", ack.Note(entry))
+
+	if len(entry.Message.Sections) == 0 || entry.MessageID != cmdCtx.Conversation.MessageID {
+		// the clicked notification isn't tracked, e.g. after restart, or it's not the latest one sent for the object,
+		// so it cannot be re-rendered
+		return respond(fmt.Sprintf(ackedMsgFmt, target.DisplayName(), ack.Note(entry)), cmdCtx), nil
+	}
+
+	return ack.AcknowledgedMessage(entry), nil
+}
+
+func ackUser(user UserInput) string {
+	if user.Mention != "" {
+		return user.Mention
+	}
+	return user.DisplayName
+}
@@ -0,0 +1,145 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/ack"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+func TestAckExecutor(t *testing.T) {
+	const clickedMsgID = "1677672000.000100"
+	trackedMsg := interactive.CoreMessage{
+		Message: api.Message{
+			Sections: []api.Section{{Base: api.Base{Header: "Deployment updated"}}},
+		},
+	}
+
+	testCases := []struct {
+		Name             string
+		Args             []string
+		Platform         config.CommPlatformIntegration
+		TrackedMsg       interactive.CoreMessage
+		TrackedMsgID     string
+		ExpectedResult   string
+		ExpectedNote     string
+		ExpectedError    string
+		ExpectedAssigned bool
+	}{
+		{
+			Name:         "Acknowledge tracked notification",
+			Args:         []string{"ack", "object", "Deployment/default/nginx"},
+			Platform:     config.SocketSlackCommPlatformIntegration,
+			TrackedMsg:   trackedMsg,
+			TrackedMsgID: clickedMsgID,
+			ExpectedNote: "✅ Acknowledged by <@U123> at 2023-03-01 12:00:00 UTC",
+		},
+		{
+			Name:             "Assign tracked notification",
+			Args:             []string{"assign", "object", "Deployment/default/nginx"},
+			Platform:         config.SocketSlackCommPlatformIntegration,
+			TrackedMsg:       trackedMsg,
+			TrackedMsgID:     clickedMsgID,
+			ExpectedNote:     "✅ Assigned to <@U123> at 2023-03-01 12:00:00 UTC",
+			ExpectedAssigned: true,
+		},
+		{
+			Name:           "Acknowledge not tracked notification",
+			Args:           []string{"ack", "object", "Node/node-1"},
+			Platform:       config.SocketSlackCommPlatformIntegration,
+			ExpectedResult: ":white_check_mark: Node node-1: Acknowledged by <@U123> at 2023-03-01 12:00:00 UTC",
+		},
+		{
+			Name:           "Acknowledge notification other than the tracked one",
+			Args:           []string{"ack", "object", "Deployment/default/nginx"},
+			Platform:       config.SocketSlackCommPlatformIntegration,
+			TrackedMsg:     trackedMsg,
+			TrackedMsgID:   "1677672000.000200",
+			ExpectedResult: ":white_check_mark: Deployment default/nginx: Acknowledged by <@U123> at 2023-03-01 12:00:00 UTC",
+		},
+		{
+			Name:          "Invalid object",
+			Args:          []string{"ack", "object", "nginx"},
+			Platform:      config.SocketSlackCommPlatformIntegration,
+			ExpectedError: `Invalid object: invalid object "nginx": expected {kind}/{namespace}/{name} or {kind}/{name} format`,
+		},
+		{
+			Name:          "Missing object",
+			Args:          []string{"assign", "object"},
+			Platform:      config.SocketSlackCommPlatformIntegration,
+			ExpectedError: "Usage: assign object {kind}/{namespace}/{name}",
+		},
+		{
+			Name:           "Not interactive platform",
+			Args:           []string{"ack", "object", "Node/node-1"},
+			Platform:       config.DiscordCommPlatformIntegration,
+			ExpectedResult: `Acknowledging notifications is not supported on the "discord" platform.`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// given
+			store := &fakeAckStore{
+				now:   time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+				msg:   tc.TrackedMsg,
+				msgID: tc.TrackedMsgID,
+			}
+			e := NewAckExecutor(loggerx.NewNoop(), store)
+			cmdCtx := fixMuteCmdCtx(tc.Args, tc.Platform)
+			cmdCtx.User = UserInput{Mention: "<@U123>", DisplayName: "Jane Doe"}
+			cmdCtx.Conversation.MessageID = clickedMsgID
+
+			// when
+			fn := e.Commands()[command.Verb(tc.Args[0])]
+			msg, err := fn(context.Background(), cmdCtx)
+
+			// then
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.ExpectedResult != "" {
+				assert.Equal(t, tc.ExpectedResult, msg.BaseBody.CodeBlock)
+				assert.False(t, msg.ReplaceOriginal)
+				return
+			}
+
+			assert.True(t, msg.ReplaceOriginal)
+			assert.Equal(t, tc.ExpectedAssigned, store.assigned)
+			require.Len(t, msg.Sections, 2)
+			assert.Equal(t, "Deployment updated", msg.Sections[0].Header)
+			assert.Equal(t, api.ContextItems{{Text: tc.ExpectedNote}}, msg.Sections[1].Context)
+		})
+	}
+}
+
+type fakeAckStore struct {
+	now      time.Time
+	msg      interactive.CoreMessage
+	msgID    string
+	assigned bool
+}
+
+func (f *fakeAckStore) Acknowledge(channel mute.Channel, target mute.Target, user string, assign bool) ack.Entry {
+	f.assigned = assign
+	return ack.Entry{
+		Channel:   channel,
+		Target:    target,
+		MessageID: f.msgID,
+		Message:   f.msg,
+		AckedBy:   user,
+		AckedAt:   f.now,
+		Assigned:  assign,
+	}
+}
@@ -14,6 +14,10 @@ const (
 	EditVerb     Verb = "edit"
 	StatusVerb   Verb = "status"
 	ShowVerb     Verb = "show"
+	MuteVerb     Verb = "mute"
+	UnmuteVerb   Verb = "unmute"
+	AckVerb      Verb = "ack"
+	AssignVerb   Verb = "assign"
 )
 
 func AllVerbs() []Verb {
@@ -28,5 +32,9 @@ func AllVerbs() []Verb {
 		EditVerb,
 		StatusVerb,
 		ShowVerb,
+		MuteVerb,
+		UnmuteVerb,
+		AckVerb,
+		AssignVerb,
 	}
 }
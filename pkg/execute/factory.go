@@ -46,6 +46,8 @@ type DefaultExecutorFactoryParams struct {
 	RestCfg           *rest.Config
 	BotKubeVersion    string
 	AuditReporter     audit.AuditReporter
+	MuteStore         MuteStore
+	AckStore          AckStore
 }
 
 // Executor is an interface for processes to execute commands
@@ -113,6 +115,18 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		params.Log.WithField("component", "Alias Executor"),
 		params.Cfg,
 	)
+	muteExecutor := NewMuteExecutor(
+		params.Log.WithField("component", "Mute Executor"),
+		params.MuteStore,
+	)
+	muteListExecutor := NewMuteListExecutor(
+		params.Log.WithField("component", "Mute List Executor"),
+		params.MuteStore,
+	)
+	ackExecutor := NewAckExecutor(
+		params.Log.WithField("component", "Ack Executor"),
+		params.AckStore,
+	)
 
 	executors := []CommandExecutor{
 		actionExecutor,
@@ -126,6 +140,9 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		execExecutor,
 		sourceExecutor,
 		aliasExecutor,
+		muteExecutor,
+		muteListExecutor,
+		ackExecutor,
 	}
 	mappings, err := NewCmdsMapping(executors)
 	if err != nil {
@@ -166,6 +183,8 @@ type Conversation struct {
 	IsAuthenticated  bool
 	CommandOrigin    command.Origin
 	SlackState       *slack.BlockActionStates
+	// MessageID is a platform-specific ID of the message with the clicked button, such as Slack message timestamp.
+	MessageID string
 }
 
 // NewDefaultInput an input for NewDefault
@@ -0,0 +1,218 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+const (
+	muteForever = "forever"
+
+	mutedMsgFmt             = ":mute: Notifications for %s are muted here %s."
+	unmutedMsgFmt           = ":bell: Notifications for %s are unmuted here. %d notification(s) were suppressed while muted."
+	notMutedMsgFmt          = "Notifications for %s are not muted here."
+	noMutesMsg              = "There are no muted objects here."
+	muteNotSupportedMsgFmt  = "Muting notifications is not supported on the %q platform."
+	muteUsageMsgFmt         = "Usage: %s object {kind}/{namespace}/{name} [duration|forever], for example: %s object deployment/default/nginx 1h"
+	unmuteUsageMsgFmt       = "Usage: %s object {kind}/{namespace}/{name}"
+	invalidMuteDurationFmt  = "Invalid mute duration %q. Use a duration such as 30m, 1h or 24h, or %q."
+	invalidMuteObjectErrFmt = "Invalid object: %s"
+)
+
+var (
+	// objectFeatureName is shared by executors which manage notifications for a given object.
+	objectFeatureName = FeatureName{
+		Name:    "object",
+		Aliases: []string{"objects", "obj"},
+	}
+	muteListFeatureName = FeatureName{
+		Name: "list",
+	}
+	mutePlatforms = map[config.CommPlatformIntegration]struct{}{
+		config.SlackCommPlatformIntegration:       {},
+		config.SocketSlackCommPlatformIntegration: {},
+		config.DiscordCommPlatformIntegration:     {},
+		config.MattermostCommPlatformIntegration:  {},
+	}
+)
+
+// MuteStore manages muted notifications for communication platform channels.
+type MuteStore interface {
+	Mute(ctx context.Context, channel mute.Channel, target mute.Target, duration time.Duration) (mute.Entry, error)
+	Unmute(ctx context.Context, channel mute.Channel, target mute.Target) (mute.Entry, bool, error)
+	List(channel mute.Channel) []mute.Entry
+}
+
+// MuteExecutor executes commands that mute and unmute notifications for a given object.
+type MuteExecutor struct {
+	log   logrus.FieldLogger
+	store MuteStore
+}
+
+// NewMuteExecutor returns a new MuteExecutor instance.
+func NewMuteExecutor(log logrus.FieldLogger, store MuteStore) *MuteExecutor {
+	return &MuteExecutor{
+		log:   log,
+		store: store,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *MuteExecutor) FeatureName() FeatureName {
+	return objectFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *MuteExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.MuteVerb:   e.Mute,
+		command.UnmuteVerb: e.Unmute,
+	}
+}
+
+// Mute mutes notifications for a given object in the current channel.
+func (e *MuteExecutor) Mute(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if !isMuteSupported(e.store, cmdCtx.Platform) {
+		return respond(fmt.Sprintf(muteNotSupportedMsgFmt, cmdCtx.Platform), cmdCtx), nil
+	}
+
+	// mute object {target} [duration]
+	if len(cmdCtx.Args) < 3 || len(cmdCtx.Args) > 4 {
+		return interactive.CoreMessage{}, NewExecutionCommandError(muteUsageMsgFmt, command.MuteVerb, command.MuteVerb)
+	}
+
+	target, err := mute.ParseTarget(cmdCtx.Args[2])
+	if err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError(invalidMuteObjectErrFmt, err.Error())
+	}
+
+	var duration time.Duration
+	if len(cmdCtx.Args) == 4 && !strings.EqualFold(cmdCtx.Args[3], muteForever) {
+		duration, err = time.ParseDuration(cmdCtx.Args[3])
+		if err != nil || duration <= 0 {
+			return interactive.CoreMessage{}, NewExecutionCommandError(invalidMuteDurationFmt, cmdCtx.Args[3], muteForever)
+		}
+	}
+
+	entry, err := e.store.Mute(ctx, muteChannel(cmdCtx), target, duration)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while muting %q: %w", target.String(), err)
+	}
+
+	msg := fmt.Sprintf(mutedMsgFmt, target.DisplayName(), muteExpiryDescription(entry))
+	return respond(msg, cmdCtx), nil
+}
+
+// Unmute unmutes notifications for a given object in the current channel.
+func (e *MuteExecutor) Unmute(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if !isMuteSupported(e.store, cmdCtx.Platform) {
+		return respond(fmt.Sprintf(muteNotSupportedMsgFmt, cmdCtx.Platform), cmdCtx), nil
+	}
+
+	// unmute object {target}
+	if len(cmdCtx.Args) != 3 {
+		return interactive.CoreMessage{}, NewExecutionCommandError(unmuteUsageMsgFmt, command.UnmuteVerb)
+	}
+
+	target, err := mute.ParseTarget(cmdCtx.Args[2])
+	if err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError(invalidMuteObjectErrFmt, err.Error())
+	}
+
+	entry, found, err := e.store.Unmute(ctx, muteChannel(cmdCtx), target)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while unmuting %q: %w", target.String(), err)
+	}
+	if !found {
+		return respond(fmt.Sprintf(notMutedMsgFmt, target.DisplayName()), cmdCtx), nil
+	}
+
+	msg := fmt.Sprintf(unmutedMsgFmt, target.DisplayName(), entry.Suppressed)
+	return respond(msg, cmdCtx), nil
+}
+
+// MuteListExecutor lists muted notifications for the current channel.
+type MuteListExecutor struct {
+	log   logrus.FieldLogger
+	store MuteStore
+}
+
+// NewMuteListExecutor returns a new MuteListExecutor instance.
+func NewMuteListExecutor(log logrus.FieldLogger, store MuteStore) *MuteListExecutor {
+	return &MuteListExecutor{
+		log:   log,
+		store: store,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *MuteListExecutor) FeatureName() FeatureName {
+	return muteListFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *MuteListExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.MuteVerb: e.List,
+	}
+}
+
+// List returns active mutes for the current channel.
+func (e *MuteListExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if !isMuteSupported(e.store, cmdCtx.Platform) {
+		return respond(fmt.Sprintf(muteNotSupportedMsgFmt, cmdCtx.Platform), cmdCtx), nil
+	}
+
+	entries := e.store.List(muteChannel(cmdCtx))
+	if len(entries) == 0 {
+		return respond(noMutesMsg, cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "OBJECT\tEXPIRES\tSUPPRESSED\n")
+	for _, entry := range entries {
+		expires := muteForever
+		if entry.ExpiresAt != nil {
+			expires = entry.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\n", entry.Target.String(), expires, entry.Suppressed)
+	}
+	w.Flush()
+
+	return respond(buf.String(), cmdCtx), nil
+}
+
+func isMuteSupported(store MuteStore, platform config.CommPlatformIntegration) bool {
+	if store == nil {
+		return false
+	}
+	_, ok := mutePlatforms[platform]
+	return ok
+}
+
+func muteChannel(cmdCtx CommandContext) mute.Channel {
+	return mute.Channel{
+		CommGroup: cmdCtx.CommGroupName,
+		Platform:  cmdCtx.Platform,
+		ID:        cmdCtx.Conversation.ID,
+	}
+}
+
+func muteExpiryDescription(entry mute.Entry) string {
+	if entry.ExpiresAt == nil {
+		return "until unmuted"
+	}
+	return fmt.Sprintf("until %s", entry.ExpiresAt.UTC().Format(time.RFC3339))
+}
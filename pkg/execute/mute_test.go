@@ -0,0 +1,173 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/mute"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestMuteExecutor_Mute(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Args             []string
+		Platform         config.CommPlatformIntegration
+		ExpectedResult   string
+		ExpectedError    string
+		ExpectedDuration time.Duration
+	}{
+		{
+			Name:             "Mute for a given duration",
+			Args:             []string{"mute", "object", "Deployment/default/nginx", "1h"},
+			Platform:         testPlatform,
+			ExpectedResult:   ":mute: Notifications for Deployment default/nginx are muted here until 2023-03-01T13:00:00Z.",
+			ExpectedDuration: time.Hour,
+		},
+		{
+			Name:           "Mute forever",
+			Args:           []string{"mute", "object", "Node/node-1", "forever"},
+			Platform:       testPlatform,
+			ExpectedResult: ":mute: Notifications for Node node-1 are muted here until unmuted.",
+		},
+		{
+			Name:           "Mute without duration",
+			Args:           []string{"mute", "obj", "Node/node-1"},
+			Platform:       testPlatform,
+			ExpectedResult: ":mute: Notifications for Node node-1 are muted here until unmuted.",
+		},
+		{
+			Name:          "Invalid duration",
+			Args:          []string{"mute", "object", "Node/node-1", "tomorrow"},
+			Platform:      testPlatform,
+			ExpectedError: `Invalid mute duration "tomorrow". Use a duration such as 30m, 1h or 24h, or "forever".`,
+		},
+		{
+			Name:          "Invalid object",
+			Args:          []string{"mute", "object", "nginx"},
+			Platform:      testPlatform,
+			ExpectedError: `Invalid object: invalid object "nginx": expected {kind}/{namespace}/{name} or {kind}/{name} format`,
+		},
+		{
+			Name:          "Missing object",
+			Args:          []string{"mute", "object"},
+			Platform:      testPlatform,
+			ExpectedError: "Usage: mute object {kind}/{namespace}/{name} [duration|forever], for example: mute object deployment/default/nginx 1h",
+		},
+		{
+			Name:           "Unsupported platform",
+			Args:           []string{"mute", "object", "Node/node-1"},
+			Platform:       config.TeamsCommPlatformIntegration,
+			ExpectedResult: `Muting notifications is not supported on the "teams" platform.`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// given
+			store := &fakeMuteStore{now: time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)}
+			e := NewMuteExecutor(loggerx.NewNoop(), store)
+			cmdCtx := fixMuteCmdCtx(tc.Args, tc.Platform)
+
+			// when
+			msg, err := e.Mute(context.Background(), cmdCtx)
+
+			// then
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedResult, msg.BaseBody.CodeBlock)
+			if tc.Platform == testPlatform {
+				assert.Equal(t, tc.ExpectedDuration, store.duration)
+			}
+		})
+	}
+}
+
+func TestMuteExecutor_UnmuteAndList(t *testing.T) {
+	// given
+	ctx := context.Background()
+	expiresAt := time.Date(2023, 3, 1, 13, 0, 0, 0, time.UTC)
+	store := &fakeMuteStore{
+		entries: []mute.Entry{
+			{Target: mute.Target{Kind: "Deployment", Namespace: "default", Name: "nginx"}, ExpiresAt: &expiresAt, Suppressed: 5},
+			{Target: mute.Target{Kind: "Node", Name: "node-1"}},
+		},
+	}
+	e := NewMuteExecutor(loggerx.NewNoop(), store)
+	listExecutor := NewMuteListExecutor(loggerx.NewNoop(), store)
+
+	// when
+	msg, err := listExecutor.List(ctx, fixMuteCmdCtx([]string{"mute", "list"}, testPlatform))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, heredocMuteList, msg.BaseBody.CodeBlock)
+
+	// when
+	msg, err = e.Unmute(ctx, fixMuteCmdCtx([]string{"unmute", "object", "deployment/default/nginx"}, testPlatform))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ":bell: Notifications for deployment default/nginx are unmuted here. 5 notification(s) were suppressed while muted.", msg.BaseBody.CodeBlock)
+
+	// when
+	msg, err = e.Unmute(ctx, fixMuteCmdCtx([]string{"unmute", "object", "deployment/default/nginx"}, testPlatform))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Notifications for deployment default/nginx are not muted here.", msg.BaseBody.CodeBlock)
+}
+
+const heredocMuteList = `OBJECT                   EXPIRES              SUPPRESSED
+Deployment/default/nginx 2023-03-01T13:00:00Z 5
+Node/node-1              forever              0
+`
+
+func fixMuteCmdCtx(args []string, platform config.CommPlatformIntegration) CommandContext {
+	return CommandContext{
+		ClusterName:    clusterName,
+		Args:           args,
+		CommGroupName:  commGroupName,
+		Platform:       platform,
+		Conversation:   Conversation{Alias: channelAlias, ID: "conv-id"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+}
+
+type fakeMuteStore struct {
+	now      time.Time
+	duration time.Duration
+	entries  []mute.Entry
+}
+
+func (f *fakeMuteStore) Mute(_ context.Context, channel mute.Channel, target mute.Target, duration time.Duration) (mute.Entry, error) {
+	f.duration = duration
+	entry := mute.Entry{Channel: channel, Target: target}
+	if duration > 0 {
+		expiresAt := f.now.Add(duration)
+		entry.ExpiresAt = &expiresAt
+	}
+	f.entries = append(f.entries, entry)
+	return entry, nil
+}
+
+func (f *fakeMuteStore) Unmute(_ context.Context, _ mute.Channel, target mute.Target) (mute.Entry, bool, error) {
+	for idx, entry := range f.entries {
+		if entry.Target.Equal(target) {
+			f.entries = append(f.entries[:idx], f.entries[idx+1:]...)
+			return entry, true, nil
+		}
+	}
+	return mute.Entry{}, false, nil
+}
+
+func (f *fakeMuteStore) List(mute.Channel) []mute.Entry {
+	return f.entries
+}
@@ -94,6 +94,10 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 			IsInteractivitySupported: cmdCtx.Platform.IsInteractive(),
 			SlackState:               slackState,
 			KubeConfig:               kubeconfig,
+			User: executor.UserInput{
+				Mention:     cmdCtx.User.Mention,
+				DisplayName: cmdCtx.User.DisplayName,
+			},
 		},
 	})
 	if err != nil {
@@ -2,6 +2,7 @@ package httpsrv
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -25,6 +26,13 @@ func New(log logrus.FieldLogger, addr string, handler http.Handler) *Server {
 	}
 }
 
+// NewTLS creates a new HTTPS server. Server certificates and client authentication are configured in a given TLS config.
+func NewTLS(log logrus.FieldLogger, addr string, handler http.Handler, tlsCfg *tls.Config) *Server {
+	srv := New(log, addr, handler)
+	srv.srv.TLSConfig = tlsCfg
+	return srv
+}
+
 // Serve starts the HTTP server and blocks unil the channel is closed or an error occurs.
 func (s *Server) Serve(ctx context.Context) error {
 	go func() {
@@ -36,9 +44,16 @@ func (s *Server) Serve(ctx context.Context) error {
 	}()
 
 	s.log.Infof("Starting server on address %q", s.srv.Addr)
-	if err := s.srv.ListenAndServe(); err != http.ErrServerClosed {
+	if err := s.listenAndServe(); err != http.ErrServerClosed {
 		return fmt.Errorf("while starting server: %w", err)
 	}
 
 	return nil
 }
+
+func (s *Server) listenAndServe() error {
+	if s.srv.TLSConfig != nil {
+		return s.srv.ListenAndServeTLS("", "")
+	}
+	return s.srv.ListenAndServe()
+}